@@ -102,6 +102,14 @@ type ExecutionStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Rollouts describes the progress of the rollouts of the deploy items.
+	// +optional
+	Rollouts []RolloutStatus `json:"rollouts,omitempty"`
+
+	// Approval describes the approval of the deploy items that require approval in the current job.
+	// +optional
+	Approval *ApprovalStatus `json:"approval,omitempty"`
 }
 
 // DeployItemTemplateList is a list of deploy item templates
@@ -143,6 +151,15 @@ type DeployItemTemplate struct {
 
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// Rollout assigns the deploy item to a rollout, which starts the deploy items of the rollout in waves.
+	// +optional
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
+
+	// RequiresApproval specifies that the deploy item is only started after the current job of the execution
+	// has been approved with the approved-by annotation.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
 }
 
 // OnDeleteConfig specifies particular setting when deleting a deploy item
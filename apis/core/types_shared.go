@@ -123,6 +123,13 @@ type ConditionStatus string
 // ConditionType is a string alias.
 type ConditionType string
 
+// DriftedCondition is the Conditions type to indicate that the deployed resources differ from their desired state.
+// It is set at deploy items with drift detection, and aggregated at their executions and installations.
+const DriftedCondition ConditionType = "Drifted"
+
+// SuspendedCondition is the Conditions type to indicate that the processing of an object is suspended.
+const SuspendedCondition ConditionType = "Suspended"
+
 const (
 	// ConditionTrue means a resource is in the condition.
 	ConditionTrue ConditionStatus = "True"
@@ -215,6 +222,16 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// DryRunOperation is the annotation to let the landscaper compute the deploy items of an installation without
+	// creating or updating any objects. The rendered deploy items and their differences to the existing deploy items
+	// are written to the status of the installation.
+	DryRunOperation Operation = "dry-run"
+
+	// RollbackOperation is the annotation to let the landscaper roll back a root installation to a succeeded revision.
+	// The revision is specified by the annotation "landscaper.gardener.cloud/rollback-revision". If not set, the
+	// installation is rolled back to the latest succeeded revision whose spec differs from the current spec.
+	RollbackOperation Operation = "rollback"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -369,3 +386,15 @@ type Optimization struct {
 	// set this on true if the installation does not export data to its siblings or has no siblings at all
 	HasNoSiblingExports bool `json:"hasNoSiblingExports,omitempty"`
 }
+
+// ApprovalStatus describes the approval of the current job of an execution or installation.
+type ApprovalStatus struct {
+	// JobID is the job that was approved.
+	JobID string `json:"jobID"`
+
+	// ApprovedBy is the identity of the approver, as specified in the approved-by annotation.
+	ApprovedBy string `json:"approvedBy"`
+
+	// ApprovalTime is the time when the approval was recorded.
+	ApprovalTime metav1.Time `json:"approvalTime"`
+}
@@ -34,6 +34,10 @@ const (
 	// CacheHelmChartsAnnotation specifies if helm charts of an installation should be cached
 	CacheHelmChartsAnnotation = LandscaperDomain + "/cache-helm-charts"
 
+	// RootInstallationAnnotation holds the name of the root installation an installation, execution or deploy item
+	// belongs to. It is propagated from the root installation down to all subobjects.
+	RootInstallationAnnotation = LandscaperDomain + "/root-installation"
+
 	// DeleteIgnoreSuccessors is the annotation that specifies that an installation is deleted even if there
 	// are dependent installations.
 	DeleteIgnoreSuccessors = LandscaperDomain + "/delete-ignore-successors"
@@ -44,11 +48,27 @@ const (
 	// ReconcileIfChangedAnnotation can be used to automatically trigger a reconcile operation if the spec has changed
 	ReconcileIfChangedAnnotation = LandscaperDomain + "/reconcile-if-changed"
 
+	// RollbackRevisionAnnotation specifies the revision to which an installation is rolled back by the rollback operation.
+	RollbackRevisionAnnotation = LandscaperDomain + "/rollback-revision"
+
+	// ApprovedByAnnotation approves the current job of an execution or installation that waits for approval.
+	// The value is the identity of the approver, which is recorded in the status.
+	ApprovedByAnnotation = LandscaperDomain + "/approved-by"
+
+	// DriftedLabel marks deploy items, executions and installations whose deployed resources differ from their
+	// desired state. It is set together with the Drifted condition, so that the drift can be aggregated with
+	// metadata-only watches.
+	DriftedLabel = LandscaperDomain + "/drifted"
+
+	// SuspendedLabel is set by the landscaper at all installations, executions and deploy items below a suspended
+	// installation. Objects with this label are not processed.
+	SuspendedLabel = LandscaperDomain + "/suspended"
+
 	// ReconcileTimestampAnnotation is used to recognize timeouts in deployitems
 	ReconcileTimestampAnnotation = LandscaperDomain + "/reconcile-time"
 
 	// IgnoreAnnotation can be used to stop reconciliation for landscaper resources.
-	// Will only have an effect if set to 'true'.
+	// Will only have an effect if set to 'true'. An object with this annotation is treated like a suspended object.
 	IgnoreAnnotation = LandscaperDomain + "/ignore"
 
 	// TouchAnnotation can be used to trigger a reconciliation event for a landscaper resource.
@@ -122,6 +142,8 @@ const (
 	PhaseStringSucceeded       string = "Succeeded"
 	PhaseStringFailed          string = "Failed"
 
+	PhaseStringWaitingForApproval string = "WaitingForApproval"
+
 	PhaseStringInitDelete    string = "InitDelete"
 	PhaseStringTriggerDelete string = "TriggerDelete"
 	PhaseStringDeleting      string = "Deleting"
@@ -5,7 +5,10 @@
 package helper
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -255,6 +258,88 @@ func DeleteCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) {
 	delete(obj.GetAnnotations(), v1alpha1.CacheHelmChartsAnnotation)
 }
 
+// GetRootInstallationName returns the name of the root installation stored in the
+// 'landscaper.gardener.cloud/root-installation' annotation, or an empty string if the annotation is not set.
+func GetRootInstallationName(obj metav1.ObjectMeta) string {
+	return obj.GetAnnotations()[v1alpha1.RootInstallationAnnotation]
+}
+
+// SetRootInstallationAnnotation sets the 'landscaper.gardener.cloud/root-installation' annotation.
+// Empty names are ignored.
+func SetRootInstallationAnnotation(obj *metav1.ObjectMeta, rootInstallationName string) {
+	if len(rootInstallationName) == 0 {
+		return
+	}
+	metav1.SetMetaDataAnnotation(obj, v1alpha1.RootInstallationAnnotation, rootInstallationName)
+}
+
+// GetSubinstallationRolloutPolicy returns the rollout policy of a subinstallation, or nil if the subinstallation
+// does not belong to a rollout.
+func GetSubinstallationRolloutPolicy(obj metav1.ObjectMeta) (*v1alpha1.RolloutPolicy, error) {
+	raw, ok := obj.GetAnnotations()[v1alpha1.SubinstallationRolloutAnnotation]
+	if !ok || len(raw) == 0 {
+		return nil, nil
+	}
+	policy := &v1alpha1.RolloutPolicy{}
+	if err := json.Unmarshal([]byte(raw), policy); err != nil {
+		return nil, fmt.Errorf("unable to parse annotation %s: %w", v1alpha1.SubinstallationRolloutAnnotation, err)
+	}
+	return policy, nil
+}
+
+// SetSubinstallationRolloutPolicy sets the rollout policy annotation and the rollout index annotation of a
+// subinstallation. The index is the position of the installation template in the rendered templates.
+// Nothing is set if the policy is nil.
+func SetSubinstallationRolloutPolicy(obj *metav1.ObjectMeta, policy *v1alpha1.RolloutPolicy, index int) error {
+	if policy == nil {
+		return nil
+	}
+	raw, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(obj, v1alpha1.SubinstallationRolloutAnnotation, string(raw))
+	metav1.SetMetaDataAnnotation(obj, v1alpha1.SubinstallationRolloutIndexAnnotation, strconv.Itoa(index))
+	return nil
+}
+
+// GetSubinstallationRolloutIndex returns the rollout index annotation of a subinstallation.
+// It returns 0 if the annotation is not set or invalid.
+func GetSubinstallationRolloutIndex(obj metav1.ObjectMeta) int {
+	index, err := strconv.Atoi(obj.GetAnnotations()[v1alpha1.SubinstallationRolloutIndexAnnotation])
+	if err != nil {
+		return 0
+	}
+	return index
+}
+
+// RequiresApproval checks if the installation has the annotation that every job requires approval.
+func RequiresApproval(obj metav1.ObjectMeta) bool {
+	return obj.GetAnnotations()[v1alpha1.RequiresApprovalAnnotation] == "true"
+}
+
+// GetApprover returns the approver from the approved-by annotation, or an empty string if the annotation is not set.
+func GetApprover(obj metav1.ObjectMeta) string {
+	return obj.GetAnnotations()[v1alpha1.ApprovedByAnnotation]
+}
+
+// IsApproved checks if the approval status belongs to the given job.
+func IsApproved(approval *v1alpha1.ApprovalStatus, jobID string) bool {
+	return approval != nil && len(jobID) != 0 && approval.JobID == jobID
+}
+
+// IsDrifted returns whether the object has the drifted label, i.e. whether its deployed resources, or the deployed
+// resources of its children, differ from their desired state.
+func IsDrifted(obj metav1.ObjectMeta) bool {
+	return obj.Labels[v1alpha1.DriftedLabel] == "true"
+}
+
+// IsSuspended returns whether the processing of the object is suspended, i.e. whether the object has the suspended
+// label or the ignore annotation.
+func IsSuspended(obj metav1.ObjectMeta) bool {
+	return obj.Labels[v1alpha1.SuspendedLabel] == "true" || HasIgnoreAnnotation(obj)
+}
+
 // SetDeployItemToFailed sets status.phase of the DeployItem to a failure phase
 // If the DeployItem has a DeletionTimestamp, 'DeleteFailed' is used, otherwise it will be set to 'Failed'.
 // Afterwards, the set phase is returned.
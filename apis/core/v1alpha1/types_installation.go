@@ -22,6 +22,18 @@ const EncompassedByLabel = "landscaper.gardener.cloud/encompassed-by"
 // todo: add conversion
 const SubinstallationNameAnnotation = "landscaper.gardener.cloud/subinstallation-name"
 
+// SubinstallationRolloutAnnotation is the annotation that contains the rollout policy of the subinstallation
+// in json format. It is set from the rollout policy of the installation template.
+const SubinstallationRolloutAnnotation = "landscaper.gardener.cloud/subinstallation-rollout"
+
+// SubinstallationRolloutIndexAnnotation is the annotation that contains the position of the installation template
+// of the subinstallation in the rendered templates. It determines the order of the subinstallations of a rollout.
+const SubinstallationRolloutIndexAnnotation = "landscaper.gardener.cloud/subinstallation-rollout-index"
+
+// RequiresApprovalAnnotation is the annotation that specifies that every job of an installation waits until it has
+// been approved. It is set at subinstallations whose installation template requires approval.
+const RequiresApprovalAnnotation = "landscaper.gardener.cloud/requires-approval"
+
 // todo: keep only subinstallations?
 const KeepChildrenAnnotation = "landscaper.gardener.cloud/keep-children"
 
@@ -92,22 +104,24 @@ var (
 		Completing,
 		Succeeded,
 		Failed,
+		WaitingForApproval,
 		InitDelete,
 		TriggerDelete,
 		Deleting,
 		DeleteFailed InstallationPhase
 	}{
-		Init:            InstallationPhase(PhaseStringInit),
-		CleanupOrphaned: InstallationPhase(PhaseStringCleanupOrphaned),
-		ObjectsCreated:  InstallationPhase(PhaseStringObjectsCreated),
-		Progressing:     InstallationPhase(PhaseStringProgressing),
-		Completing:      InstallationPhase(PhaseStringCompleting),
-		Succeeded:       InstallationPhase(PhaseStringSucceeded),
-		Failed:          InstallationPhase(PhaseStringFailed),
-		InitDelete:      InstallationPhase(PhaseStringInitDelete),
-		TriggerDelete:   InstallationPhase(PhaseStringTriggerDelete),
-		Deleting:        InstallationPhase(PhaseStringDeleting),
-		DeleteFailed:    InstallationPhase(PhaseStringDeleteFailed),
+		Init:               InstallationPhase(PhaseStringInit),
+		CleanupOrphaned:    InstallationPhase(PhaseStringCleanupOrphaned),
+		ObjectsCreated:     InstallationPhase(PhaseStringObjectsCreated),
+		Progressing:        InstallationPhase(PhaseStringProgressing),
+		Completing:         InstallationPhase(PhaseStringCompleting),
+		Succeeded:          InstallationPhase(PhaseStringSucceeded),
+		Failed:             InstallationPhase(PhaseStringFailed),
+		WaitingForApproval: InstallationPhase(PhaseStringWaitingForApproval),
+		InitDelete:         InstallationPhase(PhaseStringInitDelete),
+		TriggerDelete:      InstallationPhase(PhaseStringTriggerDelete),
+		Deleting:           InstallationPhase(PhaseStringDeleting),
+		DeleteFailed:       InstallationPhase(PhaseStringDeleteFailed),
 	}
 )
 
@@ -192,6 +206,16 @@ type InstallationSpec struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// RevisionHistoryLimit is the maximal number of succeeded revisions that are kept for a root installation.
+	// The revisions can be used to roll back the installation. If not set, a default of 5 is used.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Suspended stops the processing of the installation and of all installations, executions and deploy items
+	// below it. The processing is continued when the flag is removed.
+	// +optional
+	Suspended bool `json:"suspended,omitempty"`
 }
 
 // Verification defines the necessary data to verify the signature of the refered component
@@ -211,8 +235,22 @@ type AutomaticReconcile struct {
 	// If not set, no such automatically repeated reconciliations are triggered.
 	// +optional
 	FailedReconcile *FailedReconcile `json:"failedReconcile,omitempty"`
+
+	// OnFailure defines the behavior if a root installation fails.
+	// If set to "rollback", the installation is automatically rolled back to the latest succeeded revision whose
+	// spec differs from the failed spec. An installation that fails after a rollback is not rolled back again.
+	// +optional
+	OnFailure OnFailurePolicy `json:"onFailure,omitempty"`
 }
 
+// OnFailurePolicy defines the behavior if an installation fails.
+type OnFailurePolicy string
+
+const (
+	// OnFailureRollback rolls back a failed installation to its latest succeeded revision.
+	OnFailureRollback OnFailurePolicy = "rollback"
+)
+
 // SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations
 type SucceededReconcile struct {
 	// Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of
@@ -287,6 +325,75 @@ type InstallationStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// DryRun contains the result of the last dry-run of the installation.
+	// A dry-run is triggered by the operation annotation "landscaper.gardener.cloud/operation: dry-run".
+	// +optional
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+
+	// Revisions lists the succeeded revisions of a root installation, the latest revision last.
+	// The number of revisions is bounded by the revision history limit of the installation.
+	// +optional
+	Revisions []InstallationRevision `json:"revisions,omitempty"`
+
+	// Rollback describes the last rollback of the installation.
+	// +optional
+	Rollback *RollbackStatus `json:"rollback,omitempty"`
+
+	// Rollouts describes the progress of the rollouts of the subinstallations.
+	// +optional
+	Rollouts []RolloutStatus `json:"rollouts,omitempty"`
+
+	// Approval describes the approval of the current job of an installation that requires approval.
+	// +optional
+	Approval *ApprovalStatus `json:"approval,omitempty"`
+}
+
+// InstallationRevision describes a succeeded revision of an installation.
+type InstallationRevision struct {
+	// Revision is the number of the revision. It is increased for every new revision.
+	Revision int64 `json:"revision"`
+
+	// Generation is the generation of the installation that succeeded.
+	Generation int64 `json:"generation"`
+
+	// ComponentVersion is the resolved version of the component of the installation.
+	// +optional
+	ComponentVersion string `json:"componentVersion,omitempty"`
+
+	// ImportsHash is the hash of the import data of the installation.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// SucceededTime is the time when the revision succeeded.
+	SucceededTime metav1.Time `json:"succeededTime"`
+
+	// DataRef is the name of the secret in the namespace of the installation that contains the spec of the
+	// installation and the rendered deploy items of the revision.
+	DataRef string `json:"dataRef"`
+}
+
+// RollbackStatus describes a rollback of an installation.
+type RollbackStatus struct {
+	// Revision is the revision to which the installation was rolled back.
+	Revision int64 `json:"revision"`
+
+	// FromGeneration is the generation of the installation before the rollback.
+	FromGeneration int64 `json:"fromGeneration"`
+
+	// Generation is the generation of the installation that was created by the rollback.
+	Generation int64 `json:"generation"`
+
+	// Time is the time of the rollback.
+	Time metav1.Time `json:"time"`
+
+	// Automatic is true if the rollback was triggered by the onFailure policy of the installation.
+	// +optional
+	Automatic bool `json:"automatic,omitempty"`
+
+	// LastError describes the error that prevented the rollback.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
 }
 
 type DependentToTrigger struct {
@@ -294,6 +401,74 @@ type DependentToTrigger struct {
 	Name string `json:"name,omitempty"`
 }
 
+// DryRunStatus describes the result of a dry-run of an installation.
+type DryRunStatus struct {
+	// ObservedGeneration is the generation of the installation for which the dry-run was computed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// LastRunTime is the time when the dry-run was computed.
+	LastRunTime metav1.Time `json:"lastRunTime"`
+
+	// DeployItems contains the planned changes of the deploy items of the installation.
+	// +optional
+	DeployItems []DeployItemDryRunResult `json:"deployItems,omitempty"`
+
+	// LastError describes the error that prevented the dry-run from being computed.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+}
+
+// DryRunAction describes how a deploy item would be changed by a reconcile of the installation.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means that the deploy item does not exist yet and would be created.
+	DryRunActionCreate DryRunAction = "Create"
+	// DryRunActionUpdate means that the deploy item exists and its specification would be changed.
+	DryRunActionUpdate DryRunAction = "Update"
+	// DryRunActionDelete means that the deploy item exists but is no longer rendered and would be deleted.
+	DryRunActionDelete DryRunAction = "Delete"
+	// DryRunActionNone means that the deploy item exists and its specification would not be changed.
+	DryRunActionNone DryRunAction = "None"
+)
+
+// DeployItemDryRunResult describes the planned change of a deploy item.
+type DeployItemDryRunResult struct {
+	// Name is the name of the deploy item as defined in the deploy executions of the blueprint.
+	Name string `json:"name"`
+
+	// Action describes how the deploy item would be changed.
+	Action DryRunAction `json:"action"`
+
+	// DeployItemReference is the reference to the existing deploy item.
+	// It is not set if the deploy item would be created.
+	// +optional
+	DeployItemReference *ObjectReference `json:"deployItemRef,omitempty"`
+
+	// Specification is the rendered deploy item template.
+	// It is not set if the deploy item would be deleted.
+	// +optional
+	Specification *DeployItemTemplate `json:"specification,omitempty"`
+
+	// ConfigDiff contains the JSON patch operations (RFC 6902) that transform the configuration of the existing
+	// deploy item into the rendered configuration.
+	// +optional
+	ConfigDiff []JSONPatchOperation `json:"configDiff,omitempty"`
+}
+
+// JSONPatchOperation describes a JSON patch operation (RFC 6902).
+type JSONPatchOperation struct {
+	// Operation is the patch operation, i.e. one of "add", "remove" or "replace".
+	Operation string `json:"op"`
+
+	// Path is the JSON pointer to the changed value.
+	Path string `json:"path"`
+
+	// Value is the new value.
+	// +optional
+	Value *AnyJSON `json:"value,omitempty"`
+}
+
 // AutomaticReconcileStatus describes the status of automatically triggered reconciles.
 type AutomaticReconcileStatus struct {
 	// Generation describes the generation of the installation for which the status holds.
@@ -53,6 +53,15 @@ type InstallationTemplate struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// Rollout assigns the subinstallation to a rollout, which starts the subinstallations of the rollout in waves.
+	// +optional
+	Rollout *RolloutPolicy `json:"rollout,omitempty"`
+
+	// RequiresApproval specifies that every job of the subinstallation waits until it has been approved with the
+	// approved-by annotation.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
 }
 
 // InstallationTemplateList is a list of installation templates.
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RolloutPolicy defines how a group of deploy items or subinstallations is rolled out in waves.
+// All deploy items of an execution, or all subinstallations of an installation, with the same rollout group form
+// one rollout. The items of a rollout are ordered by name and divided into waves. A wave is only started if all
+// items of the previous wave have finished.
+// The policy of a rollout is taken from its first item, the policies of all items of a rollout should be equal.
+type RolloutPolicy struct {
+	// Group is the name of the rollout to which the item belongs.
+	Group string `json:"group"`
+
+	// MaxParallel is the maximal number of items of a wave.
+	// +optional
+	MaxParallel *int32 `json:"maxParallel,omitempty"`
+
+	// MaxParallelPercentage is the maximal number of items of a wave as a percentage of the number of items
+	// of the rollout. The number is rounded up, so that a wave contains at least one item.
+	// If both maxParallel and maxParallelPercentage are set, the smaller number applies.
+	// If none of them is set, all items are rolled out in one wave.
+	// +optional
+	MaxParallelPercentage *int32 `json:"maxParallelPercentage,omitempty"`
+
+	// PauseBetweenWaves is the time to wait after a wave has finished before the next wave is started.
+	// +optional
+	PauseBetweenWaves *Duration `json:"pauseBetweenWaves,omitempty"`
+
+	// FailureBudget is the number of items of the rollout that may fail. Failed items within the budget do not
+	// prevent the start of further waves. If more items fail, no further items of the rollout are started.
+	// Defaults to 0.
+	// +optional
+	FailureBudget *int32 `json:"failureBudget,omitempty"`
+}
+
+// RolloutStatus describes the progress of a rollout.
+type RolloutStatus struct {
+	// Group is the name of the rollout.
+	Group string `json:"group"`
+
+	// Total is the number of items of the rollout.
+	Total int32 `json:"total"`
+
+	// Waves is the number of waves of the rollout.
+	Waves int32 `json:"waves"`
+
+	// CurrentWave is the number of the wave that is currently rolled out, starting with 1.
+	CurrentWave int32 `json:"currentWave"`
+
+	// Succeeded is the number of succeeded items of the rollout.
+	Succeeded int32 `json:"succeeded"`
+
+	// Failed is the number of failed items of the rollout.
+	Failed int32 `json:"failed"`
+
+	// NextWaveTime is the time when the current wave is started, if it waits for the pause between waves.
+	// +optional
+	NextWaveTime *metav1.Time `json:"nextWaveTime,omitempty"`
+
+	// Stopped is true if the failure budget of the rollout is exceeded, so that no further items are started.
+	// +optional
+	Stopped bool `json:"stopped,omitempty"`
+}
@@ -40,6 +40,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ApprovalStatus)(nil), (*core.ApprovalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApprovalStatus_To_core_ApprovalStatus(a.(*ApprovalStatus), b.(*core.ApprovalStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ApprovalStatus)(nil), (*ApprovalStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ApprovalStatus_To_v1alpha1_ApprovalStatus(a.(*core.ApprovalStatus), b.(*ApprovalStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AutomaticReconcile)(nil), (*core.AutomaticReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(a.(*AutomaticReconcile), b.(*core.AutomaticReconcile), scope)
 	}); err != nil {
@@ -340,6 +350,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemDryRunResult)(nil), (*core.DeployItemDryRunResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemDryRunResult_To_core_DeployItemDryRunResult(a.(*DeployItemDryRunResult), b.(*core.DeployItemDryRunResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemDryRunResult)(nil), (*DeployItemDryRunResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemDryRunResult_To_v1alpha1_DeployItemDryRunResult(a.(*core.DeployItemDryRunResult), b.(*DeployItemDryRunResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemList)(nil), (*core.DeployItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemList_To_core_DeployItemList(a.(*DeployItemList), b.(*core.DeployItemList), scope)
 	}); err != nil {
@@ -400,6 +420,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DryRunStatus)(nil), (*core.DryRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DryRunStatus_To_core_DryRunStatus(a.(*DryRunStatus), b.(*core.DryRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DryRunStatus)(nil), (*DryRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DryRunStatus_To_v1alpha1_DryRunStatus(a.(*core.DryRunStatus), b.(*DryRunStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Duration)(nil), (*core.Duration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Duration_To_core_Duration(a.(*Duration), b.(*core.Duration), scope)
 	}); err != nil {
@@ -540,6 +570,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevision)(nil), (*core.InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(a.(*InstallationRevision), b.(*core.InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevision)(nil), (*InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(a.(*core.InstallationRevision), b.(*InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -580,6 +620,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JSONPatchOperation)(nil), (*core.JSONPatchOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JSONPatchOperation_To_core_JSONPatchOperation(a.(*JSONPatchOperation), b.(*core.JSONPatchOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.JSONPatchOperation)(nil), (*JSONPatchOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_JSONPatchOperation_To_v1alpha1_JSONPatchOperation(a.(*core.JSONPatchOperation), b.(*JSONPatchOperation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*JSONSchemaDefinition)(nil), (*core.JSONSchemaDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_JSONSchemaDefinition_To_core_JSONSchemaDefinition(a.(*JSONSchemaDefinition), b.(*core.JSONSchemaDefinition), scope)
 	}); err != nil {
@@ -710,6 +760,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollbackStatus)(nil), (*core.RollbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus(a.(*RollbackStatus), b.(*core.RollbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RollbackStatus)(nil), (*RollbackStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus(a.(*core.RollbackStatus), b.(*RollbackStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutPolicy)(nil), (*core.RolloutPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(a.(*RolloutPolicy), b.(*core.RolloutPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutPolicy)(nil), (*RolloutPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(a.(*core.RolloutPolicy), b.(*RolloutPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*core.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(a.(*RolloutStatus), b.(*core.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*core.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -1073,9 +1153,34 @@ func Convert_core_AnyJSON_To_v1alpha1_AnyJSON(in *core.AnyJSON, out *AnyJSON, s
 	return autoConvert_core_AnyJSON_To_v1alpha1_AnyJSON(in, out, s)
 }
 
+func autoConvert_v1alpha1_ApprovalStatus_To_core_ApprovalStatus(in *ApprovalStatus, out *core.ApprovalStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	return nil
+}
+
+// Convert_v1alpha1_ApprovalStatus_To_core_ApprovalStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ApprovalStatus_To_core_ApprovalStatus(in *ApprovalStatus, out *core.ApprovalStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApprovalStatus_To_core_ApprovalStatus(in, out, s)
+}
+
+func autoConvert_core_ApprovalStatus_To_v1alpha1_ApprovalStatus(in *core.ApprovalStatus, out *ApprovalStatus, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	return nil
+}
+
+// Convert_core_ApprovalStatus_To_v1alpha1_ApprovalStatus is an autogenerated conversion function.
+func Convert_core_ApprovalStatus_To_v1alpha1_ApprovalStatus(in *core.ApprovalStatus, out *ApprovalStatus, s conversion.Scope) error {
+	return autoConvert_core_ApprovalStatus_To_v1alpha1_ApprovalStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *AutomaticReconcile, out *core.AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*core.SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*core.FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
+	out.OnFailure = core.OnFailurePolicy(in.OnFailure)
 	return nil
 }
 
@@ -1087,6 +1192,7 @@ func Convert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *Automati
 func autoConvert_core_AutomaticReconcile_To_v1alpha1_AutomaticReconcile(in *core.AutomaticReconcile, out *AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
+	out.OnFailure = OnFailurePolicy(in.OnFailure)
 	return nil
 }
 
@@ -1819,6 +1925,34 @@ func Convert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in *core.DeployIte
 	return autoConvert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemDryRunResult_To_core_DeployItemDryRunResult(in *DeployItemDryRunResult, out *core.DeployItemDryRunResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = core.DryRunAction(in.Action)
+	out.DeployItemReference = (*core.ObjectReference)(unsafe.Pointer(in.DeployItemReference))
+	out.Specification = (*core.DeployItemTemplate)(unsafe.Pointer(in.Specification))
+	out.ConfigDiff = *(*[]core.JSONPatchOperation)(unsafe.Pointer(&in.ConfigDiff))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemDryRunResult_To_core_DeployItemDryRunResult is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemDryRunResult_To_core_DeployItemDryRunResult(in *DeployItemDryRunResult, out *core.DeployItemDryRunResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemDryRunResult_To_core_DeployItemDryRunResult(in, out, s)
+}
+
+func autoConvert_core_DeployItemDryRunResult_To_v1alpha1_DeployItemDryRunResult(in *core.DeployItemDryRunResult, out *DeployItemDryRunResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = DryRunAction(in.Action)
+	out.DeployItemReference = (*ObjectReference)(unsafe.Pointer(in.DeployItemReference))
+	out.Specification = (*DeployItemTemplate)(unsafe.Pointer(in.Specification))
+	out.ConfigDiff = *(*[]JSONPatchOperation)(unsafe.Pointer(&in.ConfigDiff))
+	return nil
+}
+
+// Convert_core_DeployItemDryRunResult_To_v1alpha1_DeployItemDryRunResult is an autogenerated conversion function.
+func Convert_core_DeployItemDryRunResult_To_v1alpha1_DeployItemDryRunResult(in *core.DeployItemDryRunResult, out *DeployItemDryRunResult, s conversion.Scope) error {
+	return autoConvert_core_DeployItemDryRunResult_To_v1alpha1_DeployItemDryRunResult(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemList_To_core_DeployItemList(in *DeployItemList, out *core.DeployItemList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DeployItem)(unsafe.Pointer(&in.Items))
@@ -1935,6 +2069,8 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.Rollout = (*core.RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequiresApproval = in.RequiresApproval
 	return nil
 }
 
@@ -1953,6 +2089,8 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.Rollout = (*RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequiresApproval = in.RequiresApproval
 	return nil
 }
 
@@ -2007,6 +2145,32 @@ func Convert_core_DiNamePair_To_v1alpha1_DiNamePair(in *core.DiNamePair, out *Di
 	return autoConvert_core_DiNamePair_To_v1alpha1_DiNamePair(in, out, s)
 }
 
+func autoConvert_v1alpha1_DryRunStatus_To_core_DryRunStatus(in *DryRunStatus, out *core.DryRunStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastRunTime = in.LastRunTime
+	out.DeployItems = *(*[]core.DeployItemDryRunResult)(unsafe.Pointer(&in.DeployItems))
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_DryRunStatus_To_core_DryRunStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DryRunStatus_To_core_DryRunStatus(in *DryRunStatus, out *core.DryRunStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DryRunStatus_To_core_DryRunStatus(in, out, s)
+}
+
+func autoConvert_core_DryRunStatus_To_v1alpha1_DryRunStatus(in *core.DryRunStatus, out *DryRunStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastRunTime = in.LastRunTime
+	out.DeployItems = *(*[]DeployItemDryRunResult)(unsafe.Pointer(&in.DeployItems))
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_core_DryRunStatus_To_v1alpha1_DryRunStatus is an autogenerated conversion function.
+func Convert_core_DryRunStatus_To_v1alpha1_DryRunStatus(in *core.DryRunStatus, out *DryRunStatus, s conversion.Scope) error {
+	return autoConvert_core_DryRunStatus_To_v1alpha1_DryRunStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Duration_To_core_Duration(in *Duration, out *core.Duration, s conversion.Scope) error {
 	out.Duration = time.Duration(in.Duration)
 	return nil
@@ -2156,6 +2320,8 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.ExecutionPhase = core.ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollouts = *(*[]core.RolloutStatus)(unsafe.Pointer(&in.Rollouts))
+	out.Approval = (*core.ApprovalStatus)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2175,6 +2341,8 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.ExecutionPhase = ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Rollouts = *(*[]RolloutStatus)(unsafe.Pointer(&in.Rollouts))
+	out.Approval = (*ApprovalStatus)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2415,6 +2583,36 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Generation = in.Generation
+	out.ComponentVersion = in.ComponentVersion
+	out.ImportsHash = in.ImportsHash
+	out.SucceededTime = in.SucceededTime
+	out.DataRef = in.DataRef
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in, out, s)
+}
+
+func autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Generation = in.Generation
+	out.ComponentVersion = in.ComponentVersion
+	out.ImportsHash = in.ImportsHash
+	out.SucceededTime = in.SucceededTime
+	out.DataRef = in.DataRef
+	return nil
+}
+
+// Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision is an autogenerated conversion function.
+func Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.Verification = (*core.Verification)(unsafe.Pointer(in.Verification))
@@ -2432,6 +2630,8 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Suspended = in.Suspended
 	return nil
 }
 
@@ -2457,6 +2657,8 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Suspended = in.Suspended
 	return nil
 }
 
@@ -2479,6 +2681,11 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.DryRun = (*core.DryRunStatus)(unsafe.Pointer(in.DryRun))
+	out.Revisions = *(*[]core.InstallationRevision)(unsafe.Pointer(&in.Revisions))
+	out.Rollback = (*core.RollbackStatus)(unsafe.Pointer(in.Rollback))
+	out.Rollouts = *(*[]core.RolloutStatus)(unsafe.Pointer(&in.Rollouts))
+	out.Approval = (*core.ApprovalStatus)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2501,6 +2708,11 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.DryRun = (*DryRunStatus)(unsafe.Pointer(in.DryRun))
+	out.Revisions = *(*[]InstallationRevision)(unsafe.Pointer(&in.Revisions))
+	out.Rollback = (*RollbackStatus)(unsafe.Pointer(in.Rollback))
+	out.Rollouts = *(*[]RolloutStatus)(unsafe.Pointer(&in.Rollouts))
+	out.Approval = (*ApprovalStatus)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2523,6 +2735,8 @@ func autoConvert_v1alpha1_InstallationTemplate_To_core_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.Rollout = (*core.RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequiresApproval = in.RequiresApproval
 	return nil
 }
 
@@ -2545,6 +2759,8 @@ func autoConvert_core_InstallationTemplate_To_v1alpha1_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.Rollout = (*RolloutPolicy)(unsafe.Pointer(in.Rollout))
+	out.RequiresApproval = in.RequiresApproval
 	return nil
 }
 
@@ -2579,6 +2795,30 @@ func Convert_core_InstallationTemplateBlueprintDefinition_To_v1alpha1_Installati
 	return autoConvert_core_InstallationTemplateBlueprintDefinition_To_v1alpha1_InstallationTemplateBlueprintDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_JSONPatchOperation_To_core_JSONPatchOperation(in *JSONPatchOperation, out *core.JSONPatchOperation, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.Path = in.Path
+	out.Value = (*core.AnyJSON)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_v1alpha1_JSONPatchOperation_To_core_JSONPatchOperation is an autogenerated conversion function.
+func Convert_v1alpha1_JSONPatchOperation_To_core_JSONPatchOperation(in *JSONPatchOperation, out *core.JSONPatchOperation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JSONPatchOperation_To_core_JSONPatchOperation(in, out, s)
+}
+
+func autoConvert_core_JSONPatchOperation_To_v1alpha1_JSONPatchOperation(in *core.JSONPatchOperation, out *JSONPatchOperation, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.Path = in.Path
+	out.Value = (*AnyJSON)(unsafe.Pointer(in.Value))
+	return nil
+}
+
+// Convert_core_JSONPatchOperation_To_v1alpha1_JSONPatchOperation is an autogenerated conversion function.
+func Convert_core_JSONPatchOperation_To_v1alpha1_JSONPatchOperation(in *core.JSONPatchOperation, out *JSONPatchOperation, s conversion.Scope) error {
+	return autoConvert_core_JSONPatchOperation_To_v1alpha1_JSONPatchOperation(in, out, s)
+}
+
 func autoConvert_v1alpha1_JSONSchemaDefinition_To_core_JSONSchemaDefinition(in *JSONSchemaDefinition, out *core.JSONSchemaDefinition, s conversion.Scope) error {
 	out.RawMessage = *(*json.RawMessage)(unsafe.Pointer(&in.RawMessage))
 	return nil
@@ -2869,6 +3109,98 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in *RollbackStatus, out *core.RollbackStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.FromGeneration = in.FromGeneration
+	out.Generation = in.Generation
+	out.Time = in.Time
+	out.Automatic = in.Automatic
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in *RollbackStatus, out *core.RollbackStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackStatus_To_core_RollbackStatus(in, out, s)
+}
+
+func autoConvert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in *core.RollbackStatus, out *RollbackStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.FromGeneration = in.FromGeneration
+	out.Generation = in.Generation
+	out.Time = in.Time
+	out.Automatic = in.Automatic
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus is an autogenerated conversion function.
+func Convert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in *core.RollbackStatus, out *RollbackStatus, s conversion.Scope) error {
+	return autoConvert_core_RollbackStatus_To_v1alpha1_RollbackStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in *RolloutPolicy, out *core.RolloutPolicy, s conversion.Scope) error {
+	out.Group = in.Group
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
+	out.MaxParallelPercentage = (*int32)(unsafe.Pointer(in.MaxParallelPercentage))
+	out.PauseBetweenWaves = (*core.Duration)(unsafe.Pointer(in.PauseBetweenWaves))
+	out.FailureBudget = (*int32)(unsafe.Pointer(in.FailureBudget))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in *RolloutPolicy, out *core.RolloutPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutPolicy_To_core_RolloutPolicy(in, out, s)
+}
+
+func autoConvert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in *core.RolloutPolicy, out *RolloutPolicy, s conversion.Scope) error {
+	out.Group = in.Group
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
+	out.MaxParallelPercentage = (*int32)(unsafe.Pointer(in.MaxParallelPercentage))
+	out.PauseBetweenWaves = (*Duration)(unsafe.Pointer(in.PauseBetweenWaves))
+	out.FailureBudget = (*int32)(unsafe.Pointer(in.FailureBudget))
+	return nil
+}
+
+// Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy is an autogenerated conversion function.
+func Convert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in *core.RolloutPolicy, out *RolloutPolicy, s conversion.Scope) error {
+	return autoConvert_core_RolloutPolicy_To_v1alpha1_RolloutPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Total = in.Total
+	out.Waves = in.Waves
+	out.CurrentWave = in.CurrentWave
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.NextWaveTime = (*metav1.Time)(unsafe.Pointer(in.NextWaveTime))
+	out.Stopped = in.Stopped
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Total = in.Total
+	out.Waves = in.Waves
+	out.CurrentWave = in.CurrentWave
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.NextWaveTime = (*metav1.Time)(unsafe.Pointer(in.NextWaveTime))
+	out.Stopped = in.Stopped
+	return nil
+}
+
+// Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
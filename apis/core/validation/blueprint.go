@@ -458,6 +458,7 @@ func ValidateInstallationTemplate(fldPath *field.Path, template *core.Installati
 
 	allErrs = append(allErrs, ValidateInstallationTemplateImports(template.Imports, fldPath.Child("imports"))...)
 	allErrs = append(allErrs, ValidateInstallationExports(template.Exports, fldPath.Child("exports"))...)
+	allErrs = append(allErrs, ValidateRolloutPolicy(fldPath.Child("rollout"), template.Rollout)...)
 
 	return allErrs
 }
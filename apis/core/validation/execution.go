@@ -173,5 +173,7 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	allErrs = append(allErrs, ValidateRolloutPolicy(fldPath.Child("rollout"), tmpl.Rollout)...)
+
 	return allErrs
 }
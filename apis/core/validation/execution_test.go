@@ -45,6 +45,34 @@ var _ = Describe("Execution", func() {
 				"Field": Equal("b.type"),
 			}))))
 		})
+
+		It("should fail if the rollout policy of a DeployItemTemplate is invalid", func() {
+			maxParallel := int32(0)
+			percentage := int32(150)
+			tmpl := core.DeployItemTemplate{}
+			tmpl.Name = "my-import"
+			tmpl.Type = "mytype"
+			tmpl.Rollout = &core.RolloutPolicy{
+				MaxParallel:           &maxParallel,
+				MaxParallelPercentage: &percentage,
+			}
+
+			allErrs := validation.ValidateDeployItemTemplate(field.NewPath("b"), tmpl)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("b.rollout.group"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.rollout.maxParallel"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.rollout.maxParallelPercentage"),
+				})),
+			))
+		})
 	})
 
 	Context("ValidateDeployItemTemplateList", func() {
@@ -61,6 +61,11 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 
 	allErrs = append(allErrs, ValidateInstallationAutomaticReconcile(spec.AutomaticReconcile, fldPath.Child("automaticReconcile"))...)
 
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit,
+			"must be greater than 0"))
+	}
+
 	return allErrs
 }
 
@@ -92,6 +97,11 @@ func ValidateInstallationAutomaticReconcile(automaticReconcile *core.AutomaticRe
 	if automaticReconcile != nil {
 		allErrs = append(allErrs, ValidateInstallationSucceededReconcile(automaticReconcile.SucceededReconcile, fldPath.Child("succeededReconcile"))...)
 		allErrs = append(allErrs, ValidateInstallationFailedReconcile(automaticReconcile.FailedReconcile, fldPath.Child("failedReconcile"))...)
+
+		if len(automaticReconcile.OnFailure) != 0 && automaticReconcile.OnFailure != core.OnFailureRollback {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("onFailure"), automaticReconcile.OnFailure,
+				[]string{string(core.OnFailureRollback)}))
+		}
 	}
 
 	return allErrs
@@ -509,4 +509,27 @@ var _ = Describe("Installation", func() {
 			}))))
 		})
 	})
+
+	Context("InstallationAutomaticReconcile", func() {
+		It("should accept the rollback policy on failure", func() {
+			automaticReconcile := &core.AutomaticReconcile{
+				OnFailure: core.OnFailureRollback,
+			}
+
+			allErrs := validation.ValidateInstallationAutomaticReconcile(automaticReconcile, field.NewPath("automaticReconcile"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject an unknown policy on failure", func() {
+			automaticReconcile := &core.AutomaticReconcile{
+				OnFailure: "unknown",
+			}
+
+			allErrs := validation.ValidateInstallationAutomaticReconcile(automaticReconcile, field.NewPath("automaticReconcile"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("automaticReconcile.onFailure"),
+			}))))
+		})
+	})
 })
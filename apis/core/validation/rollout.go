@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateRolloutPolicy validates the rollout policy of a deploy item or installation template.
+func ValidateRolloutPolicy(fldPath *field.Path, policy *core.RolloutPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	if len(policy.Group) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("group"), "group must not be empty"))
+	}
+
+	if policy.MaxParallel != nil && *policy.MaxParallel < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallel"), *policy.MaxParallel, "must be greater than 0"))
+	}
+
+	if policy.MaxParallelPercentage != nil && (*policy.MaxParallelPercentage < 1 || *policy.MaxParallelPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelPercentage"), *policy.MaxParallelPercentage,
+			"must be between 1 and 100"))
+	}
+
+	if policy.PauseBetweenWaves != nil && policy.PauseBetweenWaves.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pauseBetweenWaves"), policy.PauseBetweenWaves.Duration.String(),
+			"must not be negative"))
+	}
+
+	if policy.FailureBudget != nil && *policy.FailureBudget < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureBudget"), *policy.FailureBudget, "must not be negative"))
+	}
+
+	return allErrs
+}
@@ -37,6 +37,23 @@ func (in *AnyJSON) DeepCopy() *AnyJSON {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
+	*out = *in
+	in.ApprovalTime.DeepCopyInto(&out.ApprovalTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutomaticReconcile) DeepCopyInto(out *AutomaticReconcile) {
 	*out = *in
@@ -854,6 +871,39 @@ func (in *DeployItemCache) DeepCopy() *DeployItemCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemDryRunResult) DeepCopyInto(out *DeployItemDryRunResult) {
+	*out = *in
+	if in.DeployItemReference != nil {
+		in, out := &in.DeployItemReference, &out.DeployItemReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Specification != nil {
+		in, out := &in.Specification, &out.Specification
+		*out = new(DeployItemTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigDiff != nil {
+		in, out := &in.ConfigDiff, &out.ConfigDiff
+		*out = make([]JSONPatchOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemDryRunResult.
+func (in *DeployItemDryRunResult) DeepCopy() *DeployItemDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemList) DeepCopyInto(out *DeployItemList) {
 	*out = *in
@@ -1031,6 +1081,11 @@ func (in *DeployItemTemplate) DeepCopyInto(out *DeployItemTemplate) {
 		*out = new(OnDeleteConfig)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1098,6 +1153,35 @@ func (in *DiNamePair) DeepCopy() *DiNamePair {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunStatus) DeepCopyInto(out *DryRunStatus) {
+	*out = *in
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]DeployItemDryRunResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunStatus.
+func (in *DryRunStatus) DeepCopy() *DryRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Duration) DeepCopyInto(out *Duration) {
 	*out = *in
@@ -1260,6 +1344,18 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]RolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1545,6 +1641,23 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevision) DeepCopyInto(out *InstallationRevision) {
+	*out = *in
+	in.SucceededTime.DeepCopyInto(&out.SucceededTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevision.
+func (in *InstallationRevision) DeepCopy() *InstallationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1585,6 +1698,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1642,6 +1760,35 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]InstallationRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]RolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1681,6 +1828,11 @@ func (in *InstallationTemplate) DeepCopyInto(out *InstallationTemplate) {
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1745,6 +1897,27 @@ func (in InstallationTemplateList) DeepCopy() InstallationTemplateList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPatchOperation.
+func (in *JSONPatchOperation) DeepCopy() *JSONPatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONSchemaDefinition) DeepCopyInto(out *JSONSchemaDefinition) {
 	*out = *in
@@ -1997,6 +2170,84 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackStatus) DeepCopyInto(out *RollbackStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackStatus.
+func (in *RollbackStatus) DeepCopy() *RollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicy) DeepCopyInto(out *RolloutPolicy) {
+	*out = *in
+	if in.MaxParallel != nil {
+		in, out := &in.MaxParallel, &out.MaxParallel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxParallelPercentage != nil {
+		in, out := &in.MaxParallelPercentage, &out.MaxParallelPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseBetweenWaves != nil {
+		in, out := &in.PauseBetweenWaves, &out.PauseBetweenWaves
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.FailureBudget != nil {
+		in, out := &in.FailureBudget, &out.FailureBudget
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicy.
+func (in *RolloutPolicy) DeepCopy() *RolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.NextWaveTime != nil {
+		in, out := &in.NextWaveTime, &out.NextWaveTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretLabelSelectorRef) DeepCopyInto(out *SecretLabelSelectorRef) {
 	*out = *in
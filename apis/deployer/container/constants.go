@@ -26,6 +26,12 @@ const ContainerDeployerNameLabel = "container.deployer.landscaper.gardener.cloud
 // ContainerDeployerTypeLabel is a label that is used to identify secrets that contain the state of a container.
 const ContainerDeployerTypeLabel = "container.deployer.landscaper.gardener.cloud/type"
 
+// ContainerDeployerTypeState is the value of the type label of secrets that contain the state of a container.
+const ContainerDeployerTypeState = "state"
+
+// ContainerDeployerTypeLogs is the value of the type label of secrets that contain the logs of a container.
+const ContainerDeployerTypeLogs = "logs"
+
 // ContainerDeployerDeployItemNameLabel is the name of the label that is used to identify the deploy item of a pod.
 const ContainerDeployerDeployItemNameLabel = "deployitem.container.deployer.landscaper.gardener.cloud/name"
 
@@ -59,6 +65,20 @@ const BasePath = "/data/ls"
 // SharedBasePath is the base path inside the container that is shared between the main and ls containers
 var SharedBasePath = filepath.Join(BasePath, "shared")
 
+// ReservedVolumeNames are the names of the volumes that the container deployer adds to the pod.
+// They can not be used for additional volumes of a deploy item.
+var ReservedVolumeNames = []string{
+	"serviceaccount-init",
+	"serviceaccount-wait",
+	"shared-volume",
+	"configuration",
+	"ocm-configuration",
+	"target",
+	"blueprint-pull-secret",
+	"cd-pull-secret",
+	"state-backend",
+}
+
 // ImportsPathName is the name of the env var that points to the imports file.
 const ImportsPathName = "IMPORTS_PATH"
 
@@ -74,6 +94,17 @@ const ExportsPathName = "EXPORTS_PATH"
 // ExportsPath is the path to the export file.
 var ExportsPath = filepath.Join(SharedBasePath, "exports", "values")
 
+// SensitiveExportsPathName is the name of the env var that points to the sensitive exports file.
+const SensitiveExportsPathName = "SENSITIVE_EXPORTS_PATH"
+
+// SensitiveExportsPath is the path to the sensitive exports file.
+// Sensitive exports are only stored in secrets and are not part of the exports of the execution,
+// so they cannot be used in the export templates of installations.
+var SensitiveExportsPath = filepath.Join(SharedBasePath, "exports", "sensitive-values")
+
+// SensitiveExportsDataKey is the key of the sensitive exports in the export secrets.
+const SensitiveExportsDataKey = "sensitiveConfig"
+
 // ComponentDescriptorPathName is the name of the env var that points to the component descriptor.
 const ComponentDescriptorPathName = "COMPONENT_DESCRIPTOR_PATH"
 
@@ -104,6 +135,13 @@ const StatePathName = "STATE_PATH"
 // StatePath is the path to the state directory.
 var StatePath = filepath.Join(SharedBasePath, "state")
 
+// StateConfigurationName is the name of the env var that contains the json encoded state configuration
+// of the container deployer. The state is stored in secrets if the env var is not set.
+const StateConfigurationName = "STATE_CONFIGURATION"
+
+// StateBackendPath is the path where the volume of the pvc state backend is mounted in the init and wait containers.
+var StateBackendPath = filepath.Join(BasePath, "state-backend")
+
 // ConfigurationPathName is the name of the env var that points to the provider configuration file.
 const ConfigurationPathName = "CONFIGURATION_PATH"
 
@@ -157,6 +195,20 @@ const ContainerDeployerStateUUIDAnnotation = "container.deployer.landscaper.gard
 // that are stored in the secrets.
 const ContainerDeployerStateNumAnnotation = "container.deployer.landscaper.gardener.cloud/num"
 
+// ContainerDeployerPodNameAnnotation is a annotation that is used to group the chunks of the logs
+// of a pod that are stored in the secrets.
+const ContainerDeployerPodNameAnnotation = "container.deployer.landscaper.gardener.cloud/pod-name"
+
+// LogsTailLinesName is the name of the env var that contains the number of lines of the logs of the main container
+// that are persisted by the wait container. The logs are not persisted if the env var is not set.
+const LogsTailLinesName = "LOGS_TAIL_LINES"
+
+// LogsLimitBytesName is the name of the env var that contains the maximum size of the persisted logs in bytes.
+const LogsLimitBytesName = "LOGS_LIMIT_BYTES"
+
+// LogsRetentionName is the name of the env var that contains the number of runs whose logs are kept.
+const LogsRetentionName = "LOGS_RETENTION"
+
 var (
 	DefaultEnvVars = []corev1.EnvVar{
 		{
@@ -167,6 +219,10 @@ var (
 			Name:  ExportsPathName,
 			Value: ExportsPath,
 		},
+		{
+			Name:  SensitiveExportsPathName,
+			Value: SensitiveExportsPath,
+		},
 		{
 			Name:  ComponentDescriptorPathName,
 			Value: ComponentDescriptorPath,
@@ -58,6 +58,23 @@ type Configuration struct {
 
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+
+	// Pod configures defaults and restrictions for the pods that execute the deploy items.
+	// +optional
+	Pod PodConfiguration `json:"pod,omitempty"`
+
+	// Logs configures the persistence of the logs of the main container.
+	// +optional
+	Logs LogsConfiguration `json:"logs,omitempty"`
+
+	// Job configures the container deployer to run the operations of deploy items as kubernetes jobs
+	// instead of bare pods.
+	// +optional
+	Job *JobConfiguration `json:"job,omitempty"`
+
+	// State configures the backend that stores the state of deploy items.
+	// +optional
+	State StateConfiguration `json:"state,omitempty"`
 }
 
 // ContainerSpec defines a container specification
@@ -95,6 +112,155 @@ type ContainerSpec struct {
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
 }
 
+// PodConfiguration configures defaults and restrictions for the pods that execute the deploy items.
+// The defaults are only used if a deploy item does not define the respective value.
+type PodConfiguration struct {
+	// DefaultResources are the compute resources of the main container.
+	// +optional
+	DefaultResources *corev1.ResourceRequirements `json:"defaultResources,omitempty"`
+	// MaxResources are the upper bounds for the resource requests and limits of the main container.
+	// +optional
+	MaxResources corev1.ResourceList `json:"maxResources,omitempty"`
+	// DefaultNodeSelector is the node selector of the pods.
+	// +optional
+	DefaultNodeSelector map[string]string `json:"defaultNodeSelector,omitempty"`
+	// AllowedNodeSelectorKeys restricts the keys that deploy items can use in their node selector.
+	// If empty, all keys are allowed.
+	// +optional
+	AllowedNodeSelectorKeys []string `json:"allowedNodeSelectorKeys,omitempty"`
+	// DefaultTolerations are the tolerations of the pods.
+	// +optional
+	DefaultTolerations []corev1.Toleration `json:"defaultTolerations,omitempty"`
+	// AllowedTolerationKeys restricts the keys that deploy items can tolerate.
+	// If empty, all keys are allowed.
+	// +optional
+	AllowedTolerationKeys []string `json:"allowedTolerationKeys,omitempty"`
+	// DefaultSecurityContext is the security context of the main container.
+	// It is merged onto a security context that complies with the restricted pod security standard,
+	// so that unset fields keep their restricted values.
+	// +optional
+	DefaultSecurityContext *corev1.SecurityContext `json:"defaultSecurityContext,omitempty"`
+	// AllowPrivileged allows deploy items to run the main container privileged, as root,
+	// or with privilege escalation.
+	// +optional
+	AllowPrivileged bool `json:"allowPrivileged,omitempty"`
+	// AllowedCapabilities restricts the capabilities that deploy items can add to the main container.
+	// +optional
+	AllowedCapabilities []corev1.Capability `json:"allowedCapabilities,omitempty"`
+	// AllowedVolumeTypes restricts the types of the additional volumes of deploy items,
+	// e.g. "configMap", "secret" or "emptyDir".
+	// Defaults to configMap, secret, emptyDir, projected and downwardAPI.
+	// +optional
+	AllowedVolumeTypes []string `json:"allowedVolumeTypes,omitempty"`
+}
+
+// LogsConfiguration configures the persistence of the logs of the main container.
+// The wait container stores the tail of the logs of every run in secrets in the host cluster,
+// which are referenced in the pod status of the deploy item.
+type LogsConfiguration struct {
+	// Disable disables the persistence of the logs.
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+	// TailLines is the number of lines from the end of the logs that are persisted.
+	// Defaults to 1000.
+	// +optional
+	TailLines int64 `json:"tailLines,omitempty"`
+	// LimitBytes is the maximum size of the persisted logs in bytes.
+	// Logs that exceed the size of a secret are split into several secrets.
+	// Defaults to 262144 (256Ki).
+	// +optional
+	LimitBytes int64 `json:"limitBytes,omitempty"`
+	// Retention is the number of runs of a deploy item whose logs are kept.
+	// Defaults to 3.
+	// +optional
+	Retention int `json:"retention,omitempty"`
+}
+
+// JobConfiguration configures the jobs that execute the operations of deploy items.
+// The active deadline of a job is derived from the remaining time until the timeout of the deploy item.
+type JobConfiguration struct {
+	// BackoffLimit is the number of retries before a job is considered as failed.
+	// Defaults to 2.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// TTLSecondsAfterFinished is the duration after which a finished job is deleted by kubernetes.
+	// If not set, a finished job is deleted by the container deployer as soon as its status has been processed.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// StateBackendType defines the type of a backend that stores the state of deploy items.
+type StateBackendType string
+
+const (
+	// StateBackendSecret stores the state in chunked secrets in the host namespace.
+	StateBackendSecret StateBackendType = "secret"
+	// StateBackendPVC stores the state on a persistent volume.
+	StateBackendPVC StateBackendType = "pvc"
+	// StateBackendObjectStore stores the state in a s3 compatible object store.
+	StateBackendObjectStore StateBackendType = "objectStore"
+)
+
+// StateConfiguration configures the backend that stores the state of deploy items.
+// The state directory of a deploy item is archived by the wait container after every run
+// and restored by the init container before the next run.
+type StateConfiguration struct {
+	// Backend is the type of the state backend.
+	// One of "secret", "pvc" or "objectStore".
+	// Defaults to "secret".
+	// +optional
+	Backend StateBackendType `json:"backend,omitempty"`
+	// PVC configures the pvc state backend.
+	// +optional
+	PVC *PVCStateBackend `json:"pvc,omitempty"`
+	// ObjectStore configures the object store state backend.
+	// +optional
+	ObjectStore *ObjectStoreStateBackend `json:"objectStore,omitempty"`
+	// Encryption configures the encryption of the state.
+	// If not set, the state is stored unencrypted.
+	// +optional
+	Encryption *StateEncryption `json:"encryption,omitempty"`
+}
+
+// PVCStateBackend configures a state backend that stores the state on a persistent volume.
+// The volume is mounted into the init and wait containers of all pods,
+// so it has to support the access mode ReadWriteMany if the pods run on different nodes.
+type PVCStateBackend struct {
+	// ClaimName is the name of the persistent volume claim in the host namespace.
+	ClaimName string `json:"claimName"`
+}
+
+// ObjectStoreStateBackend configures a state backend that stores the state in a s3 compatible object store.
+type ObjectStoreStateBackend struct {
+	// Endpoint is the url of the object store.
+	// If not set, AWS S3 is used.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region of the bucket.
+	// Defaults to us-east-1.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// Bucket is the name of the bucket that stores the state.
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the keys of the state objects.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// UsePathStyle addresses the bucket in the path of the url instead of the host name,
+	// which is required by most s3 compatible object stores.
+	// +optional
+	UsePathStyle bool `json:"usePathStyle,omitempty"`
+	// CredentialsSecretRef references a secret in the host namespace that contains
+	// the access key in "accessKeyID" and the secret key in "secretAccessKey".
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+}
+
+// StateEncryption configures the encryption of the state with AES-GCM.
+type StateEncryption struct {
+	// KeySecretRef references the key of a secret in the host namespace that contains the encryption key.
+	// The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+	KeySecretRef lsv1alpha1.LocalSecretReference `json:"keySecretRef"`
+}
+
 // GarbageCollection defines the container deployer garbage collection configuration.
 type GarbageCollection struct {
 	// Disable disables the garbage collector and the resources clean-up.
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -22,6 +23,12 @@ func SetDefaults_Configuration(obj *Configuration) {
 		obj.DefaultImage.Image = "ubuntu:18.04"
 	}
 	SetDefaults_GarbageCollection(&obj.GarbageCollection)
+	SetDefaults_PodConfiguration(&obj.Pod)
+	SetDefaults_LogsConfiguration(&obj.Logs)
+	if obj.Job != nil {
+		SetDefaults_JobConfiguration(obj.Job)
+	}
+	SetDefaults_StateConfiguration(&obj.State)
 }
 
 // SetDefaults_GarbageCollection sets the defaults for the container deployer configuration.
@@ -33,3 +40,40 @@ func SetDefaults_GarbageCollection(obj *GarbageCollection) {
 		obj.RequeueTimeSeconds = 60 * 60
 	}
 }
+
+// SetDefaults_PodConfiguration sets the defaults for the pod configuration of the container deployer.
+func SetDefaults_PodConfiguration(obj *PodConfiguration) {
+	if obj.AllowedVolumeTypes == nil {
+		obj.AllowedVolumeTypes = []string{"configMap", "secret", "emptyDir", "projected", "downwardAPI"}
+	}
+}
+
+// SetDefaults_LogsConfiguration sets the defaults for the logs configuration of the container deployer.
+func SetDefaults_LogsConfiguration(obj *LogsConfiguration) {
+	if obj.TailLines <= 0 {
+		obj.TailLines = 1000
+	}
+	if obj.LimitBytes <= 0 {
+		obj.LimitBytes = 256 * 1024
+	}
+	if obj.Retention <= 0 {
+		obj.Retention = 3
+	}
+}
+
+// SetDefaults_JobConfiguration sets the defaults for the job configuration of the container deployer.
+func SetDefaults_JobConfiguration(obj *JobConfiguration) {
+	if obj.BackoffLimit == nil {
+		obj.BackoffLimit = ptr.To[int32](2)
+	}
+}
+
+// SetDefaults_StateConfiguration sets the defaults for the state configuration of the container deployer.
+func SetDefaults_StateConfiguration(obj *StateConfiguration) {
+	if len(obj.Backend) == 0 {
+		obj.Backend = StateBackendSecret
+	}
+	if obj.ObjectStore != nil && len(obj.ObjectStore.Region) == 0 {
+		obj.ObjectStore.Region = "us-east-1"
+	}
+}
@@ -45,9 +45,46 @@ type ProviderConfiguration struct {
 	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
 	// +optional
 	Args []string `json:"args,omitempty"`
+	// Env defines additional environment variables of the main container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources defines the compute resources of the main container.
+	// Defaults to the default resources of the container deployer configuration.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// SecurityContext defines the security context of the main container.
+	// Its fields overwrite the fields of the default security context of the container deployer configuration,
+	// unset fields keep their default values.
+	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// NodeSelector defines the node selector of the pod.
+	// Defaults to the default node selector of the container deployer configuration.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations defines the tolerations of the pod.
+	// Defaults to the default tolerations of the container deployer configuration.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Volumes defines additional volumes of the pod that can be mounted into the main container.
+	// The allowed volume types are restricted by the container deployer configuration.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts defines additional volume mounts of the main container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 	// ImportValues contains the import values for the container.
 	// +optional
 	ImportValues json.RawMessage `json:"importValues,omitempty"`
+	// ExportSchema is a jsonschema that the exports and sensitive exports of the container have to match.
+	// The exports of a successful run are validated before they are synced to the deploy item,
+	// and the deploy item fails if they do not match the schema.
+	// +optional
+	ExportSchema *lsv1alpha1.JSONSchemaDefinition `json:"exportSchema,omitempty"`
+	// ExportSchemaLocalTypes defines jsonschemas that can be referenced in the export schema with "local://<name>".
+	// +optional
+	ExportSchemaLocalTypes map[string]lsv1alpha1.JSONSchemaDefinition `json:"exportSchemaLocalTypes,omitempty"`
 	// Blueprint is the resolved reference to the Blueprint definition
 	// +optional
 	Blueprint *lsv1alpha1.BlueprintDefinition `json:"blueprint,omitempty"`
@@ -81,6 +118,10 @@ type ProviderStatus struct {
 type PodStatus struct {
 	// PodName is the name of the created pod.
 	PodName string `json:"podName"`
+	// JobName is the name of the job that created the pod,
+	// if the container deployer runs the operations of deploy items as jobs.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
 	// LastRun is the time when the pod was executed the last time.
 	LastRun *metav1.Time `json:"lastRun,omitempty"`
 	// LastSuccessfulJobID is set to the current JobID when the pod successfully finished.
@@ -93,6 +134,10 @@ type PodStatus struct {
 	InitContainerStatus ContainerStatus `json:"initContainerStatus"`
 	// WaitContainerStatus contains the status of the wait container.
 	WaitContainerStatus ContainerStatus `json:"waitContainerStatus"`
+	// LogSecrets reference the secrets in the host cluster that contain the tail of the logs of the main container.
+	// The logs are split into several secrets in the given order if they exceed the size of a secret.
+	// +optional
+	LogSecrets []lsv1alpha1.ObjectReference `json:"logSecrets,omitempty"`
 }
 
 // ContainerStatus describes the status of a pod with its init, wait and main container.
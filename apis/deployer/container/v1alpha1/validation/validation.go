@@ -5,11 +5,19 @@
 package validation
 
 import (
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 )
@@ -26,5 +34,190 @@ func ValidateProviderConfiguration(config *containerv1alpha1.ProviderConfigurati
 	}
 
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, validateEnv(field.NewPath("env"), config.Env)...)
+	allErrs = append(allErrs, validateVolumes(field.NewPath("volumes"), field.NewPath("volumeMounts"), config.Volumes, config.VolumeMounts)...)
 	return allErrs.ToAggregate()
 }
+
+// validateEnv validates the additional environment variables of the main container.
+func validateEnv(fldPath *field.Path, env []corev1.EnvVar) field.ErrorList {
+	var allErrs field.ErrorList
+	reserved := sets.New(container.OperationName)
+	for _, envVar := range container.DefaultEnvVars {
+		reserved.Insert(envVar.Name)
+	}
+	for i, envVar := range env {
+		if len(envVar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("name"), "must not be empty"))
+		} else if reserved.Has(envVar.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("name"), "is set by the container deployer"))
+		}
+	}
+	return allErrs
+}
+
+// validateVolumes validates the additional volumes and volume mounts of the main container.
+func validateVolumes(volumesPath, mountsPath *field.Path, volumes []corev1.Volume, mounts []corev1.VolumeMount) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i, volume := range volumes {
+		fldPath := volumesPath.Index(i).Child("name")
+		if len(volume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath, "must not be empty"))
+		} else if slices.Contains(container.ReservedVolumeNames, volume.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "is used by the container deployer"))
+		} else if names.Has(volume.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, volume.Name))
+		}
+		names.Insert(volume.Name)
+	}
+
+	for i, mount := range mounts {
+		fldPath := mountsPath.Index(i)
+		if !names.Has(mount.Name) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("name"), mount.Name))
+		}
+		if !path.IsAbs(mount.MountPath) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mountPath"), mount.MountPath, "must be an absolute path"))
+		} else if mountPath := path.Clean(mount.MountPath); mountPath == container.BasePath || strings.HasPrefix(mountPath, container.BasePath+"/") {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("mountPath"), "must not be inside of "+container.BasePath))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePodSettings validates the pod settings of a container deployer configuration
+// against the restrictions of the pod configuration of the container deployer.
+func ValidatePodSettings(config *containerv1alpha1.ProviderConfiguration, podConfig *containerv1alpha1.PodConfiguration) error {
+	var allErrs field.ErrorList
+
+	if config.Resources != nil {
+		fldPath := field.NewPath("resources")
+		allErrs = append(allErrs, validateMaxResources(fldPath.Child("requests"), config.Resources.Requests, podConfig.MaxResources)...)
+		allErrs = append(allErrs, validateMaxResources(fldPath.Child("limits"), config.Resources.Limits, podConfig.MaxResources)...)
+	}
+
+	if len(podConfig.AllowedNodeSelectorKeys) != 0 {
+		for key := range config.NodeSelector {
+			if !slices.Contains(podConfig.AllowedNodeSelectorKeys, key) {
+				allErrs = append(allErrs, field.NotSupported(field.NewPath("nodeSelector").Key(key), key, podConfig.AllowedNodeSelectorKeys))
+			}
+		}
+	}
+
+	if len(podConfig.AllowedTolerationKeys) != 0 {
+		for i, toleration := range config.Tolerations {
+			if !slices.Contains(podConfig.AllowedTolerationKeys, toleration.Key) {
+				allErrs = append(allErrs, field.NotSupported(field.NewPath("tolerations").Index(i).Child("key"), toleration.Key, podConfig.AllowedTolerationKeys))
+			}
+		}
+	}
+
+	if config.SecurityContext != nil {
+		allErrs = append(allErrs, validateSecurityContext(field.NewPath("securityContext"), config.SecurityContext, podConfig)...)
+	}
+
+	for i, volume := range config.Volumes {
+		volumeType := VolumeSourceType(volume.VolumeSource)
+		if !slices.Contains(podConfig.AllowedVolumeTypes, volumeType) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("volumes").Index(i), volumeType, podConfig.AllowedVolumeTypes))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// ValidateStateConfiguration validates the state configuration of the container deployer.
+func ValidateStateConfiguration(fldPath *field.Path, config *containerv1alpha1.StateConfiguration) error {
+	var allErrs field.ErrorList
+
+	switch config.Backend {
+	case "", containerv1alpha1.StateBackendSecret:
+	case containerv1alpha1.StateBackendPVC:
+		if config.PVC == nil || len(config.PVC.ClaimName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("pvc", "claimName"), "is required for the pvc state backend"))
+		}
+	case containerv1alpha1.StateBackendObjectStore:
+		if config.ObjectStore == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("objectStore"), "is required for the object store state backend"))
+			break
+		}
+		if len(config.ObjectStore.Bucket) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("objectStore", "bucket"), "must not be empty"))
+		}
+		if len(config.ObjectStore.CredentialsSecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("objectStore", "credentialsSecretRef", "name"), "must not be empty"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), config.Backend, []containerv1alpha1.StateBackendType{
+			containerv1alpha1.StateBackendSecret, containerv1alpha1.StateBackendPVC, containerv1alpha1.StateBackendObjectStore}))
+	}
+
+	if config.Encryption != nil {
+		keyPath := fldPath.Child("encryption", "keySecretRef")
+		if len(config.Encryption.KeySecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(keyPath.Child("name"), "must not be empty"))
+		}
+		if len(config.Encryption.KeySecretRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(keyPath.Child("key"), "must not be empty"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateMaxResources validates that the given resources do not exceed the maximum resources.
+func validateMaxResources(fldPath *field.Path, resources, maxResources corev1.ResourceList) field.ErrorList {
+	var allErrs field.ErrorList
+	for name, quantity := range resources {
+		maxQuantity, ok := maxResources[name]
+		if ok && quantity.Cmp(maxQuantity) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(string(name)), quantity.String(), "must not exceed "+maxQuantity.String()))
+		}
+	}
+	return allErrs
+}
+
+// validateSecurityContext validates that a security context only requests the allowed privileges.
+// Unset fields keep the values of the restricted security context, which the container deployer merges the security context onto.
+func validateSecurityContext(fldPath *field.Path, sc *corev1.SecurityContext, podConfig *containerv1alpha1.PodConfiguration) field.ErrorList {
+	var allErrs field.ErrorList
+	if !podConfig.AllowPrivileged {
+		if sc.Privileged != nil && *sc.Privileged {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("privileged"), "privileged containers are not allowed"))
+		}
+		if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowPrivilegeEscalation"), "privilege escalation is not allowed"))
+		}
+		if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsNonRoot"), "containers must not run as root"))
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsUser"), "containers must not run as root"))
+		}
+		if sc.Capabilities != nil && len(sc.Capabilities.Drop) != 0 && !slices.Contains(sc.Capabilities.Drop, "ALL") {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("capabilities", "drop"), "containers must drop all capabilities"))
+		}
+	}
+	if sc.Capabilities != nil {
+		for i, capability := range sc.Capabilities.Add {
+			if !slices.Contains(podConfig.AllowedCapabilities, capability) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("capabilities", "add").Index(i), "capability "+string(capability)+" is not allowed"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// VolumeSourceType returns the type of a volume source, which is the json name of its defined source, e.g. "configMap".
+func VolumeSourceType(source corev1.VolumeSource) string {
+	val := reflect.ValueOf(source)
+	for i := 0; i < val.NumField(); i++ {
+		if val.Field(i).IsNil() {
+			continue
+		}
+		name, _, _ := strings.Cut(val.Type().Field(i).Tag.Get("json"), ",")
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container/v1alpha1/validation"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("ProviderConfiguration", func() {
+		It("should accept additional env vars, volumes and volume mounts", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				Env: []corev1.EnvVar{{Name: "MY_VAR", Value: "abc"}},
+				Volumes: []corev1.Volume{{
+					Name:         "my-config",
+					VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}},
+				}},
+				VolumeMounts: []corev1.VolumeMount{{Name: "my-config", MountPath: "/etc/config"}},
+			}
+			Expect(validation.ValidateProviderConfiguration(config)).To(Succeed())
+		})
+
+		It("should deny env vars that are set by the container deployer", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				Env: []corev1.EnvVar{{Name: "OPERATION", Value: "abc"}},
+			}
+			err := validation.ValidateProviderConfiguration(config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("env[0].name"))
+		})
+
+		It("should deny invalid volumes and volume mounts", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				Volumes: []corev1.Volume{
+					{Name: "target", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "unknown", MountPath: "/data"},
+					{Name: "data", MountPath: "/data/ls/shared"},
+				},
+			}
+			err := validation.ValidateProviderConfiguration(config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("volumes[0].name"))
+			Expect(err.Error()).To(ContainSubstring("volumeMounts[0].name"))
+			Expect(err.Error()).To(ContainSubstring("volumeMounts[1].mountPath"))
+		})
+	})
+
+	Context("PodSettings", func() {
+		var podConfig *containerv1alpha1.PodConfiguration
+
+		BeforeEach(func() {
+			podConfig = &containerv1alpha1.PodConfiguration{}
+			containerv1alpha1.SetDefaults_PodConfiguration(podConfig)
+		})
+
+		It("should accept settings within the restrictions", func() {
+			podConfig.MaxResources = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			podConfig.AllowedNodeSelectorKeys = []string{"worker.gardener.cloud/pool"}
+			podConfig.AllowedTolerationKeys = []string{"dedicated"}
+			config := &containerv1alpha1.ProviderConfiguration{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+				NodeSelector: map[string]string{"worker.gardener.cloud/pool": "jobs"},
+				Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+				SecurityContext: &corev1.SecurityContext{
+					RunAsNonRoot:             ptr.To(true),
+					AllowPrivilegeEscalation: ptr.To(false),
+				},
+				Volumes: []corev1.Volume{{
+					Name:         "my-secret",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "abc"}},
+				}},
+			}
+			Expect(validation.ValidatePodSettings(config, podConfig)).To(Succeed())
+		})
+
+		It("should deny resources that exceed the maximum", func() {
+			podConfig.MaxResources = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+			config := &containerv1alpha1.ProviderConfiguration{
+				Resources: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m")},
+				},
+			}
+			err := validation.ValidatePodSettings(config, podConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("resources.limits[cpu]"))
+		})
+
+		It("should deny node selectors and tolerations that are not allowed", func() {
+			podConfig.AllowedNodeSelectorKeys = []string{"worker.gardener.cloud/pool"}
+			podConfig.AllowedTolerationKeys = []string{"dedicated"}
+			config := &containerv1alpha1.ProviderConfiguration{
+				NodeSelector: map[string]string{"kubernetes.io/hostname": "node-a"},
+				Tolerations:  []corev1.Toleration{{Key: "node-role.kubernetes.io/control-plane"}},
+			}
+			err := validation.ValidatePodSettings(config, podConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("nodeSelector[kubernetes.io/hostname]"))
+			Expect(err.Error()).To(ContainSubstring("tolerations[0].key"))
+		})
+
+		It("should deny privileged security contexts unless they are allowed", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				SecurityContext: &corev1.SecurityContext{
+					Privileged: ptr.To(true),
+					RunAsUser:  ptr.To[int64](0),
+					Capabilities: &corev1.Capabilities{
+						Add: []corev1.Capability{"NET_ADMIN"},
+					},
+				},
+			}
+			err := validation.ValidatePodSettings(config, podConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("securityContext.privileged"))
+			Expect(err.Error()).To(ContainSubstring("securityContext.runAsUser"))
+			Expect(err.Error()).To(ContainSubstring("securityContext.capabilities.add[0]"))
+
+			podConfig.AllowPrivileged = true
+			podConfig.AllowedCapabilities = []corev1.Capability{"NET_ADMIN"}
+			Expect(validation.ValidatePodSettings(config, podConfig)).To(Succeed())
+		})
+
+		It("should accept an empty security context as it keeps the restricted values", func() {
+			config := &containerv1alpha1.ProviderConfiguration{SecurityContext: &corev1.SecurityContext{}}
+			Expect(validation.ValidatePodSettings(config, podConfig)).To(Succeed())
+		})
+
+		It("should deny security contexts that do not drop all capabilities unless privileges are allowed", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				SecurityContext: &corev1.SecurityContext{
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"NET_RAW"},
+					},
+				},
+			}
+			err := validation.ValidatePodSettings(config, podConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("securityContext.capabilities.drop"))
+
+			podConfig.AllowPrivileged = true
+			Expect(validation.ValidatePodSettings(config, podConfig)).To(Succeed())
+		})
+
+		It("should deny volume types that are not allowed", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				Volumes: []corev1.Volume{{
+					Name:         "host",
+					VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+				}},
+			}
+			err := validation.ValidatePodSettings(config, podConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("hostPath"))
+		})
+	})
+
+	Context("StateConfiguration", func() {
+		fldPath := field.NewPath("state")
+
+		It("should accept the default secret backend", func() {
+			Expect(validation.ValidateStateConfiguration(fldPath, &containerv1alpha1.StateConfiguration{})).To(Succeed())
+			Expect(validation.ValidateStateConfiguration(fldPath, &containerv1alpha1.StateConfiguration{
+				Backend: containerv1alpha1.StateBackendSecret,
+			})).To(Succeed())
+		})
+
+		It("should accept a complete object store backend with encryption", func() {
+			config := &containerv1alpha1.StateConfiguration{
+				Backend: containerv1alpha1.StateBackendObjectStore,
+				ObjectStore: &containerv1alpha1.ObjectStoreStateBackend{
+					Endpoint:             "http://minio.minio:9000",
+					Bucket:               "states",
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "minio-credentials"},
+				},
+				Encryption: &containerv1alpha1.StateEncryption{
+					KeySecretRef: lsv1alpha1.LocalSecretReference{Name: "state-key", Key: "key"},
+				},
+			}
+			Expect(validation.ValidateStateConfiguration(fldPath, config)).To(Succeed())
+		})
+
+		It("should deny incomplete backend configurations", func() {
+			err := validation.ValidateStateConfiguration(fldPath, &containerv1alpha1.StateConfiguration{
+				Backend: containerv1alpha1.StateBackendPVC,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("state.pvc.claimName"))
+
+			err = validation.ValidateStateConfiguration(fldPath, &containerv1alpha1.StateConfiguration{
+				Backend:     containerv1alpha1.StateBackendObjectStore,
+				ObjectStore: &containerv1alpha1.ObjectStoreStateBackend{},
+				Encryption:  &containerv1alpha1.StateEncryption{},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("state.objectStore.bucket"))
+			Expect(err.Error()).To(ContainSubstring("state.objectStore.credentialsSecretRef.name"))
+			Expect(err.Error()).To(ContainSubstring("state.encryption.keySecretRef.key"))
+		})
+
+		It("should deny unknown backends", func() {
+			err := validation.ValidateStateConfiguration(fldPath, &containerv1alpha1.StateConfiguration{Backend: "configMap"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("state.backend"))
+		})
+	})
+
+	Context("VolumeSourceType", func() {
+		It("should return the json name of the volume source", func() {
+			Expect(validation.VolumeSourceType(corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}})).To(Equal("emptyDir"))
+			Expect(validation.VolumeSourceType(corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{}})).To(Equal("downwardAPI"))
+			Expect(validation.VolumeSourceType(corev1.VolumeSource{})).To(BeEmpty())
+		})
+	})
+})
@@ -100,6 +100,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JobConfiguration)(nil), (*container.JobConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration(a.(*JobConfiguration), b.(*container.JobConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.JobConfiguration)(nil), (*JobConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration(a.(*container.JobConfiguration), b.(*JobConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogsConfiguration)(nil), (*container.LogsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration(a.(*LogsConfiguration), b.(*container.LogsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.LogsConfiguration)(nil), (*LogsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration(a.(*container.LogsConfiguration), b.(*LogsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObjectStoreStateBackend)(nil), (*container.ObjectStoreStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectStoreStateBackend_To_container_ObjectStoreStateBackend(a.(*ObjectStoreStateBackend), b.(*container.ObjectStoreStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.ObjectStoreStateBackend)(nil), (*ObjectStoreStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_ObjectStoreStateBackend_To_v1alpha1_ObjectStoreStateBackend(a.(*container.ObjectStoreStateBackend), b.(*ObjectStoreStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PVCStateBackend)(nil), (*container.PVCStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PVCStateBackend_To_container_PVCStateBackend(a.(*PVCStateBackend), b.(*container.PVCStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.PVCStateBackend)(nil), (*PVCStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_PVCStateBackend_To_v1alpha1_PVCStateBackend(a.(*container.PVCStateBackend), b.(*PVCStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodConfiguration)(nil), (*container.PodConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration(a.(*PodConfiguration), b.(*container.PodConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.PodConfiguration)(nil), (*PodConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration(a.(*container.PodConfiguration), b.(*PodConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodStatus)(nil), (*container.PodStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodStatus_To_container_PodStatus(a.(*PodStatus), b.(*container.PodStatus), scope)
 	}); err != nil {
@@ -130,6 +180,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StateConfiguration)(nil), (*container.StateConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration(a.(*StateConfiguration), b.(*container.StateConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.StateConfiguration)(nil), (*StateConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration(a.(*container.StateConfiguration), b.(*StateConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StateEncryption)(nil), (*container.StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StateEncryption_To_container_StateEncryption(a.(*StateEncryption), b.(*container.StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.StateEncryption)(nil), (*StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_StateEncryption_To_v1alpha1_StateEncryption(a.(*container.StateEncryption), b.(*StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -155,6 +225,16 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration(&in.Pod, &out.Pod, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	out.Job = (*container.JobConfiguration)(unsafe.Pointer(in.Job))
+	if err := Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration(&in.State, &out.State, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -185,6 +265,16 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	if err := Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration(&in.Pod, &out.Pod, s); err != nil {
+		return err
+	}
+	if err := Convert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration(&in.Logs, &out.Logs, s); err != nil {
+		return err
+	}
+	out.Job = (*JobConfiguration)(unsafe.Pointer(in.Job))
+	if err := Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration(&in.State, &out.State, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -333,8 +423,145 @@ func Convert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in *contain
 	return autoConvert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in *JobConfiguration, out *container.JobConfiguration, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in *JobConfiguration, out *container.JobConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in, out, s)
+}
+
+func autoConvert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in *container.JobConfiguration, out *JobConfiguration, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration is an autogenerated conversion function.
+func Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in *container.JobConfiguration, out *JobConfiguration, s conversion.Scope) error {
+	return autoConvert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration(in *LogsConfiguration, out *container.LogsConfiguration, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.TailLines = in.TailLines
+	out.LimitBytes = in.LimitBytes
+	out.Retention = in.Retention
+	return nil
+}
+
+// Convert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration(in *LogsConfiguration, out *container.LogsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogsConfiguration_To_container_LogsConfiguration(in, out, s)
+}
+
+func autoConvert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration(in *container.LogsConfiguration, out *LogsConfiguration, s conversion.Scope) error {
+	out.Disable = in.Disable
+	out.TailLines = in.TailLines
+	out.LimitBytes = in.LimitBytes
+	out.Retention = in.Retention
+	return nil
+}
+
+// Convert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration is an autogenerated conversion function.
+func Convert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration(in *container.LogsConfiguration, out *LogsConfiguration, s conversion.Scope) error {
+	return autoConvert_container_LogsConfiguration_To_v1alpha1_LogsConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ObjectStoreStateBackend_To_container_ObjectStoreStateBackend(in *ObjectStoreStateBackend, out *container.ObjectStoreStateBackend, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.UsePathStyle = in.UsePathStyle
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return nil
+}
+
+// Convert_v1alpha1_ObjectStoreStateBackend_To_container_ObjectStoreStateBackend is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectStoreStateBackend_To_container_ObjectStoreStateBackend(in *ObjectStoreStateBackend, out *container.ObjectStoreStateBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectStoreStateBackend_To_container_ObjectStoreStateBackend(in, out, s)
+}
+
+func autoConvert_container_ObjectStoreStateBackend_To_v1alpha1_ObjectStoreStateBackend(in *container.ObjectStoreStateBackend, out *ObjectStoreStateBackend, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.UsePathStyle = in.UsePathStyle
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return nil
+}
+
+// Convert_container_ObjectStoreStateBackend_To_v1alpha1_ObjectStoreStateBackend is an autogenerated conversion function.
+func Convert_container_ObjectStoreStateBackend_To_v1alpha1_ObjectStoreStateBackend(in *container.ObjectStoreStateBackend, out *ObjectStoreStateBackend, s conversion.Scope) error {
+	return autoConvert_container_ObjectStoreStateBackend_To_v1alpha1_ObjectStoreStateBackend(in, out, s)
+}
+
+func autoConvert_v1alpha1_PVCStateBackend_To_container_PVCStateBackend(in *PVCStateBackend, out *container.PVCStateBackend, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_v1alpha1_PVCStateBackend_To_container_PVCStateBackend is an autogenerated conversion function.
+func Convert_v1alpha1_PVCStateBackend_To_container_PVCStateBackend(in *PVCStateBackend, out *container.PVCStateBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PVCStateBackend_To_container_PVCStateBackend(in, out, s)
+}
+
+func autoConvert_container_PVCStateBackend_To_v1alpha1_PVCStateBackend(in *container.PVCStateBackend, out *PVCStateBackend, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_container_PVCStateBackend_To_v1alpha1_PVCStateBackend is an autogenerated conversion function.
+func Convert_container_PVCStateBackend_To_v1alpha1_PVCStateBackend(in *container.PVCStateBackend, out *PVCStateBackend, s conversion.Scope) error {
+	return autoConvert_container_PVCStateBackend_To_v1alpha1_PVCStateBackend(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in *PodConfiguration, out *container.PodConfiguration, s conversion.Scope) error {
+	out.DefaultResources = (*v1.ResourceRequirements)(unsafe.Pointer(in.DefaultResources))
+	out.MaxResources = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxResources))
+	out.DefaultNodeSelector = *(*map[string]string)(unsafe.Pointer(&in.DefaultNodeSelector))
+	out.AllowedNodeSelectorKeys = *(*[]string)(unsafe.Pointer(&in.AllowedNodeSelectorKeys))
+	out.DefaultTolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.DefaultTolerations))
+	out.AllowedTolerationKeys = *(*[]string)(unsafe.Pointer(&in.AllowedTolerationKeys))
+	out.DefaultSecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.DefaultSecurityContext))
+	out.AllowPrivileged = in.AllowPrivileged
+	out.AllowedCapabilities = *(*[]v1.Capability)(unsafe.Pointer(&in.AllowedCapabilities))
+	out.AllowedVolumeTypes = *(*[]string)(unsafe.Pointer(&in.AllowedVolumeTypes))
+	return nil
+}
+
+// Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in *PodConfiguration, out *container.PodConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in, out, s)
+}
+
+func autoConvert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in *container.PodConfiguration, out *PodConfiguration, s conversion.Scope) error {
+	out.DefaultResources = (*v1.ResourceRequirements)(unsafe.Pointer(in.DefaultResources))
+	out.MaxResources = *(*v1.ResourceList)(unsafe.Pointer(&in.MaxResources))
+	out.DefaultNodeSelector = *(*map[string]string)(unsafe.Pointer(&in.DefaultNodeSelector))
+	out.AllowedNodeSelectorKeys = *(*[]string)(unsafe.Pointer(&in.AllowedNodeSelectorKeys))
+	out.DefaultTolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.DefaultTolerations))
+	out.AllowedTolerationKeys = *(*[]string)(unsafe.Pointer(&in.AllowedTolerationKeys))
+	out.DefaultSecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.DefaultSecurityContext))
+	out.AllowPrivileged = in.AllowPrivileged
+	out.AllowedCapabilities = *(*[]v1.Capability)(unsafe.Pointer(&in.AllowedCapabilities))
+	out.AllowedVolumeTypes = *(*[]string)(unsafe.Pointer(&in.AllowedVolumeTypes))
+	return nil
+}
+
+// Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration is an autogenerated conversion function.
+func Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in *container.PodConfiguration, out *PodConfiguration, s conversion.Scope) error {
+	return autoConvert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *container.PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
+	out.JobName = in.JobName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
 	out.LastSuccessfulJobID = (*string)(unsafe.Pointer(in.LastSuccessfulJobID))
 	if err := Convert_v1alpha1_ContainerStatus_To_container_ContainerStatus(&in.ContainerStatus, &out.ContainerStatus, s); err != nil {
@@ -346,6 +573,7 @@ func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *c
 	if err := Convert_v1alpha1_ContainerStatus_To_container_ContainerStatus(&in.WaitContainerStatus, &out.WaitContainerStatus, s); err != nil {
 		return err
 	}
+	out.LogSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.LogSecrets))
 	return nil
 }
 
@@ -356,6 +584,7 @@ func Convert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *conta
 
 func autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStatus, out *PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
+	out.JobName = in.JobName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
 	out.LastSuccessfulJobID = (*string)(unsafe.Pointer(in.LastSuccessfulJobID))
 	if err := Convert_container_ContainerStatus_To_v1alpha1_ContainerStatus(&in.ContainerStatus, &out.ContainerStatus, s); err != nil {
@@ -367,6 +596,7 @@ func autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStat
 	if err := Convert_container_ContainerStatus_To_v1alpha1_ContainerStatus(&in.WaitContainerStatus, &out.WaitContainerStatus, s); err != nil {
 		return err
 	}
+	out.LogSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.LogSecrets))
 	return nil
 }
 
@@ -379,7 +609,16 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
+	out.ExportSchema = (*corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(in.ExportSchema))
+	out.ExportSchemaLocalTypes = *(*map[string]corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(&in.ExportSchemaLocalTypes))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
@@ -396,7 +635,16 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
+	out.ExportSchema = (*corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(in.ExportSchema))
+	out.ExportSchemaLocalTypes = *(*map[string]corev1alpha1.JSONSchemaDefinition)(unsafe.Pointer(&in.ExportSchemaLocalTypes))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
 	out.RegistryPullSecrets = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
@@ -430,3 +678,49 @@ func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *contain
 func Convert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	return autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in *StateConfiguration, out *container.StateConfiguration, s conversion.Scope) error {
+	out.Backend = container.StateBackendType(in.Backend)
+	out.PVC = (*container.PVCStateBackend)(unsafe.Pointer(in.PVC))
+	out.ObjectStore = (*container.ObjectStoreStateBackend)(unsafe.Pointer(in.ObjectStore))
+	out.Encryption = (*container.StateEncryption)(unsafe.Pointer(in.Encryption))
+	return nil
+}
+
+// Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in *StateConfiguration, out *container.StateConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in, out, s)
+}
+
+func autoConvert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in *container.StateConfiguration, out *StateConfiguration, s conversion.Scope) error {
+	out.Backend = StateBackendType(in.Backend)
+	out.PVC = (*PVCStateBackend)(unsafe.Pointer(in.PVC))
+	out.ObjectStore = (*ObjectStoreStateBackend)(unsafe.Pointer(in.ObjectStore))
+	out.Encryption = (*StateEncryption)(unsafe.Pointer(in.Encryption))
+	return nil
+}
+
+// Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration is an autogenerated conversion function.
+func Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in *container.StateConfiguration, out *StateConfiguration, s conversion.Scope) error {
+	return autoConvert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	out.KeySecretRef = in.KeySecretRef
+	return nil
+}
+
+// Convert_v1alpha1_StateEncryption_To_container_StateEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in, out, s)
+}
+
+func autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	out.KeySecretRef = in.KeySecretRef
+	return nil
+}
+
+// Convert_container_StateEncryption_To_v1alpha1_StateEncryption is an autogenerated conversion function.
+func Convert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	return autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in, out, s)
+}
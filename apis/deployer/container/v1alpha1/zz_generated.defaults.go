@@ -9,6 +9,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
@@ -19,6 +20,7 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Configuration{}, func(obj interface{}) { SetObjectDefaults_Configuration(obj.(*Configuration)) })
+	scheme.AddTypeDefaultingFunc(&ProviderConfiguration{}, func(obj interface{}) { SetObjectDefaults_ProviderConfiguration(obj.(*ProviderConfiguration)) })
 	return nil
 }
 
@@ -26,4 +28,58 @@ func SetObjectDefaults_Configuration(in *Configuration) {
 	SetDefaults_Configuration(in)
 	SetDefaults_GarbageCollection(&in.GarbageCollection)
 	configv1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
+	SetDefaults_PodConfiguration(&in.Pod)
+	SetDefaults_LogsConfiguration(&in.Logs)
+	if in.Job != nil {
+		SetDefaults_JobConfiguration(in.Job)
+	}
+	SetDefaults_StateConfiguration(&in.State)
+}
+
+func SetObjectDefaults_ProviderConfiguration(in *ProviderConfiguration) {
+	for i := range in.Volumes {
+		a := &in.Volumes[i]
+		if a.VolumeSource.ISCSI != nil {
+			if a.VolumeSource.ISCSI.ISCSIInterface == "" {
+				a.VolumeSource.ISCSI.ISCSIInterface = "default"
+			}
+		}
+		if a.VolumeSource.RBD != nil {
+			if a.VolumeSource.RBD.RBDPool == "" {
+				a.VolumeSource.RBD.RBDPool = "rbd"
+			}
+			if a.VolumeSource.RBD.RadosUser == "" {
+				a.VolumeSource.RBD.RadosUser = "admin"
+			}
+			if a.VolumeSource.RBD.Keyring == "" {
+				a.VolumeSource.RBD.Keyring = "/etc/ceph/keyring"
+			}
+		}
+		if a.VolumeSource.AzureDisk != nil {
+			if a.VolumeSource.AzureDisk.CachingMode == nil {
+				ptrVar1 := v1.AzureDataDiskCachingMode(v1.AzureDataDiskCachingReadWrite)
+				a.VolumeSource.AzureDisk.CachingMode = &ptrVar1
+			}
+			if a.VolumeSource.AzureDisk.FSType == nil {
+				var ptrVar1 string = "ext4"
+				a.VolumeSource.AzureDisk.FSType = &ptrVar1
+			}
+			if a.VolumeSource.AzureDisk.ReadOnly == nil {
+				var ptrVar1 bool = false
+				a.VolumeSource.AzureDisk.ReadOnly = &ptrVar1
+			}
+			if a.VolumeSource.AzureDisk.Kind == nil {
+				ptrVar1 := v1.AzureDataDiskKind(v1.AzureSharedBlobDisk)
+				a.VolumeSource.AzureDisk.Kind = &ptrVar1
+			}
+		}
+		if a.VolumeSource.ScaleIO != nil {
+			if a.VolumeSource.ScaleIO.StorageMode == "" {
+				a.VolumeSource.ScaleIO.StorageMode = "ThinProvisioned"
+			}
+			if a.VolumeSource.ScaleIO.FSType == "" {
+				a.VolumeSource.ScaleIO.FSType = "xfs"
+			}
+		}
+	}
 }
@@ -11,6 +11,7 @@ package container
 import (
 	json "encoding/json"
 
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
@@ -49,6 +50,14 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	in.Pod.DeepCopyInto(&out.Pod)
+	out.Logs = in.Logs
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	in.State.DeepCopyInto(&out.State)
 	return
 }
 
@@ -183,6 +192,148 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfiguration) DeepCopyInto(out *JobConfiguration) {
+	*out = *in
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfiguration.
+func (in *JobConfiguration) DeepCopy() *JobConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogsConfiguration) DeepCopyInto(out *LogsConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogsConfiguration.
+func (in *LogsConfiguration) DeepCopy() *LogsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LogsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreStateBackend) DeepCopyInto(out *ObjectStoreStateBackend) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreStateBackend.
+func (in *ObjectStoreStateBackend) DeepCopy() *ObjectStoreStateBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreStateBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCStateBackend) DeepCopyInto(out *PVCStateBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCStateBackend.
+func (in *PVCStateBackend) DeepCopy() *PVCStateBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(PVCStateBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodConfiguration) DeepCopyInto(out *PodConfiguration) {
+	*out = *in
+	if in.DefaultResources != nil {
+		in, out := &in.DefaultResources, &out.DefaultResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxResources != nil {
+		in, out := &in.MaxResources, &out.MaxResources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DefaultNodeSelector != nil {
+		in, out := &in.DefaultNodeSelector, &out.DefaultNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowedNodeSelectorKeys != nil {
+		in, out := &in.AllowedNodeSelectorKeys, &out.AllowedNodeSelectorKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultTolerations != nil {
+		in, out := &in.DefaultTolerations, &out.DefaultTolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedTolerationKeys != nil {
+		in, out := &in.AllowedTolerationKeys, &out.AllowedTolerationKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultSecurityContext != nil {
+		in, out := &in.DefaultSecurityContext, &out.DefaultSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedCapabilities != nil {
+		in, out := &in.AllowedCapabilities, &out.AllowedCapabilities
+		*out = make([]v1.Capability, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedVolumeTypes != nil {
+		in, out := &in.AllowedVolumeTypes, &out.AllowedVolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodConfiguration.
+func (in *PodConfiguration) DeepCopy() *PodConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PodConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	*out = *in
@@ -198,6 +349,11 @@ func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	in.ContainerStatus.DeepCopyInto(&out.ContainerStatus)
 	in.InitContainerStatus.DeepCopyInto(&out.InitContainerStatus)
 	in.WaitContainerStatus.DeepCopyInto(&out.WaitContainerStatus)
+	if in.LogSecrets != nil {
+		in, out := &in.LogSecrets, &out.LogSecrets
+		*out = make([]v1alpha1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -225,11 +381,68 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ImportValues != nil {
 		in, out := &in.ImportValues, &out.ImportValues
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExportSchema != nil {
+		in, out := &in.ExportSchema, &out.ExportSchema
+		*out = new(v1alpha1.JSONSchemaDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportSchemaLocalTypes != nil {
+		in, out := &in.ExportSchemaLocalTypes, &out.ExportSchemaLocalTypes
+		*out = make(map[string]v1alpha1.JSONSchemaDefinition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Blueprint != nil {
 		in, out := &in.Blueprint, &out.Blueprint
 		*out = new(v1alpha1.BlueprintDefinition)
@@ -300,3 +513,51 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateConfiguration) DeepCopyInto(out *StateConfiguration) {
+	*out = *in
+	if in.PVC != nil {
+		in, out := &in.PVC, &out.PVC
+		*out = new(PVCStateBackend)
+		**out = **in
+	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(ObjectStoreStateBackend)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(StateEncryption)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateConfiguration.
+func (in *StateConfiguration) DeepCopy() *StateConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StateConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateEncryption) DeepCopyInto(out *StateEncryption) {
+	*out = *in
+	out.KeySecretRef = in.KeySecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateEncryption.
+func (in *StateEncryption) DeepCopy() *StateEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StateEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -31,6 +31,12 @@ type Configuration struct {
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+	// ChartCache configures a cache of helm charts on the filesystem in addition to the in-memory cache.
+	// +optional
+	ChartCache *ChartCacheConfiguration `json:"chartCache,omitempty"`
+	// ChartVerification configures the verification of the provenance of helm charts.
+	// +optional
+	ChartVerification *ChartVerificationConfiguration `json:"chartVerification,omitempty"`
 }
 
 // ExportConfiguration defines the export configuration for the deployer.
@@ -49,3 +55,53 @@ type HPAConfiguration struct {
 type Controller struct {
 	lsconfigv1alpha1.CommonControllerConfig
 }
+
+// ChartCacheConfiguration configures the cache of helm charts on the filesystem.
+// The path can be located on a persistent volume that is shared between the replicas of the deployer,
+// so that cached charts survive restarts and are available to new replicas.
+// The garbage collection determines the size of the cache from the files in the directory,
+// so that the size limit applies to the files of all replicas.
+type ChartCacheConfiguration struct {
+	// Path is the root directory of the cache.
+	Path string `json:"path"`
+	// Size is the maximum size of the files in the cache.
+	// If the value is empty or 0, the cache is not limited and no garbage collection happens.
+	// See the kubernetes quantity docs for a detailed description of the format
+	// https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go
+	// +optional
+	Size string `json:"size,omitempty"`
+	// GCHighThreshold defines the percent of the size which triggers the garbage collection.
+	// Defaults to 0.85.
+	// +optional
+	GCHighThreshold float64 `json:"gcHighThreshold,omitempty"`
+	// GCLowThreshold defines the percent of the size to which the garbage collection attempts to free.
+	// Defaults to 0.80.
+	// +optional
+	GCLowThreshold float64 `json:"gcLowThreshold,omitempty"`
+}
+
+// ChartVerificationConfiguration configures the verification of the provenance of helm charts.
+type ChartVerificationConfiguration struct {
+	// EnforcementPolicy defines whether the provenance of helm charts must be verified.
+	// +optional
+	EnforcementPolicy ChartVerificationEnforcementPolicy `json:"enforcementPolicy,omitempty"`
+	// KeyringFile is the path to a file with the public keys that are trusted to sign helm charts.
+	// It is used for all deploy items that do not reference a keyring.
+	// +optional
+	KeyringFile string `json:"keyringFile,omitempty"`
+}
+
+// ChartVerificationEnforcementPolicy describes the policy for the verification of the provenance of helm charts.
+// +enum
+type ChartVerificationEnforcementPolicy string
+
+const (
+	// ChartVerificationEnforce enforces the verification of all charts that are referenced by an oci reference,
+	// a helm chart repository, or a remote archive. Deploy items that do not configure a verification are verified
+	// with the keyring of the deployer configuration. Charts from other sources, which cannot be verified, are rejected.
+	ChartVerificationEnforce ChartVerificationEnforcementPolicy = "Enforce"
+	// ChartVerificationDoNotEnforce verifies only the charts of deploy items that configure a verification. [DEFAULT]
+	ChartVerificationDoNotEnforce ChartVerificationEnforcementPolicy = "DoNotEnforce"
+	// ChartVerificationDisabled disables the verification, even for deploy items that configure a verification.
+	ChartVerificationDisabled ChartVerificationEnforcementPolicy = "Disabled"
+)
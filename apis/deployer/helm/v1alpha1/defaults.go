@@ -18,3 +18,20 @@ func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
 		obj.UpdateStrategy = UpdateStrategyUpdate
 	}
 }
+
+// SetDefaults_ChartVerificationConfiguration sets the defaults for the chart verification configuration.
+func SetDefaults_ChartVerificationConfiguration(obj *ChartVerificationConfiguration) {
+	if len(obj.EnforcementPolicy) == 0 {
+		obj.EnforcementPolicy = ChartVerificationDoNotEnforce
+	}
+}
+
+// SetDefaults_ChartCacheConfiguration sets the defaults for the chart cache configuration.
+func SetDefaults_ChartCacheConfiguration(obj *ChartCacheConfiguration) {
+	if obj.GCHighThreshold == 0 {
+		obj.GCHighThreshold = 0.85
+	}
+	if obj.GCLowThreshold == 0 {
+		obj.GCLowThreshold = 0.80
+	}
+}
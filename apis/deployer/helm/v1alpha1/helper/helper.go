@@ -45,3 +45,12 @@ func ProviderConfigurationToRawExtension(config *helmv1alpha1.ProviderConfigurat
 	raw.Raw = data
 	return raw, nil
 }
+
+// IsChartVerifiable returns whether the provenance of a chart can be verified.
+// This is the case for charts that are referenced by an oci reference, a helm chart repository, or a remote archive.
+func IsChartVerifiable(chart *helmv1alpha1.Chart) bool {
+	if chart.Archive != nil {
+		return len(chart.Archive.Raw) == 0 && chart.Archive.Remote != nil
+	}
+	return len(chart.Ref) != 0 || chart.HelmChartRepo != nil
+}
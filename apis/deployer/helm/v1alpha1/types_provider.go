@@ -7,12 +7,15 @@ package v1alpha1
 import (
 	"encoding/json"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
 )
 
@@ -29,6 +32,11 @@ type ProviderConfiguration struct {
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
 
+	// ServerSideApply configures the server-side apply update strategy.
+	// Only relevant if the update strategy is "serverSideApply" and HelmDeployment is false.
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readinessChecks,omitempty"`
@@ -49,6 +57,12 @@ type ProviderConfiguration struct {
 	// Values are the values that are used for templating.
 	Values json.RawMessage `json:"values,omitempty"`
 
+	// ValuesFrom lists sources from which values are read when the chart is deployed.
+	// The values of the sources are merged in the given order on top of the inline values,
+	// so that values of later sources overwrite values of earlier sources.
+	// +optional
+	ValuesFrom []ValuesFromSource `json:"valuesFrom,omitempty"`
+
 	// ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	// DEPRECATED
@@ -62,6 +76,10 @@ type ProviderConfiguration struct {
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 
+	// DriftDetection configures the periodic comparison of the deployed resources with their desired state.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+
 	// HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating.
 	// Default is true.
 	// +optional
@@ -71,6 +89,16 @@ type ProviderConfiguration struct {
 	// +optional
 	HelmDeploymentConfig *HelmDeploymentConfiguration `json:"helmDeploymentConfig,omitempty"`
 
+	// Diff configures a preview of the changes of a helm upgrade. The manifests of the deployed release are compared
+	// with the newly rendered chart, and the differences are summarized in the provider status.
+	// Only relevant if HelmDeployment is true.
+	// +optional
+	Diff *DiffConfiguration `json:"diff,omitempty"`
+
+	// PostRenderer configures patches that are applied to the rendered manifests of the chart before they are deployed.
+	// +optional
+	PostRenderer *PostRendererConfiguration `json:"postRenderer,omitempty"`
+
 	// DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.
 	// +optional
 	DeletionGroups []managedresource.DeletionGroupDefinition `json:"deletionGroups,omitempty"`
@@ -86,6 +114,9 @@ type UpdateStrategy string
 const (
 	UpdateStrategyUpdate UpdateStrategy = "update"
 	UpdateStrategyPatch  UpdateStrategy = "patch"
+	// UpdateStrategyServerSideApply applies the resources with server-side apply using a field manager per deploy item.
+	// Only supported if HelmDeployment is false.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // Chart defines the helm chart to render and apply.
@@ -108,6 +139,20 @@ type Chart struct {
 	// defined in the blueprint
 	// +optional
 	ResourceRef string `json:"resourceRef,omitempty"`
+	// Verification configures the verification of the provenance of the chart.
+	// It is supported for charts that are referenced by Ref, HelmChartRepo or Archive.Remote.
+	// +optional
+	Verification *ChartVerification `json:"verification,omitempty"`
+}
+
+// ChartVerification configures the verification of the provenance file of a helm chart.
+type ChartVerification struct {
+	// KeyringSecretRef references a secret in the namespace of the deploy item that contains the public keys
+	// which are trusted to sign the chart. If no key is specified, the key "keyring" is used.
+	// If not set, the keyring of the deployer configuration is used.
+	// It must not be set if the deployer enforces the verification.
+	// +optional
+	KeyringSecretRef *lsv1alpha1.LocalSecretReference `json:"keyringSecretRef,omitempty"`
 }
 
 type ResourceRef struct {
@@ -128,6 +173,55 @@ type RemoteChartReference struct {
 	ResourceName string `json:"resourceName"`
 }
 
+// ValuesFromSource defines a source of helm values.
+// Exactly one of SecretRef, ConfigMapRef and ResourceRef must be set.
+type ValuesFromSource struct {
+	// SecretRef reads the values from a key of a secret.
+	// +optional
+	SecretRef *ValuesFromObjectReference `json:"secretRef,omitempty"`
+
+	// ConfigMapRef reads the values from a key of a config map.
+	// +optional
+	ConfigMapRef *ValuesFromObjectReference `json:"configMapRef,omitempty"`
+
+	// ResourceRef reads the values from a resource of a component version.
+	// +optional
+	ResourceRef *ValuesFromResourceReference `json:"resourceRef,omitempty"`
+
+	// JSONPath is the path in the helm values at which the content of the source is inserted, e.g. ".database.password".
+	// If not set, the content must be a yaml or json object, which is merged into the top level of the helm values.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+}
+
+// ValuesFromObjectReference references a key of a secret or config map.
+type ValuesFromObjectReference struct {
+	// Name is the name of the secret or config map.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the secret or config map. It is required if TargetName is set, and must not be set otherwise,
+	// because objects in the landscaper cluster are always read from the namespace of the deploy item.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key is the key of the data entry that contains the values.
+	Key string `json:"key"`
+
+	// TargetName is the name of a target in the namespace of the deploy item. If set, the secret or config map is read
+	// from the cluster of this target instead of the landscaper cluster.
+	// The value typically comes from a target import parameter, for example: {{.imports.myCluster.metadata.name}}.
+	// +optional
+	TargetName *string `json:"targetName,omitempty"`
+}
+
+// ValuesFromResourceReference references a resource of a component version that contains helm values.
+// The resource must be of type "landscaper.gardener.cloud/helm-values".
+type ValuesFromResourceReference struct {
+	lsv1alpha1.ComponentDescriptorDefinition `json:",inline"`
+	// ResourceName is the name of the resource as defined by the component descriptor.
+	ResourceName string `json:"resourceName"`
+}
+
 // ArchiveAccess defines the access for a helm chart as compressed archive.
 type ArchiveAccess struct {
 	// Raw defines a compressed tarred helm chart as base64 encoded string.
@@ -153,6 +247,51 @@ type HelmDeploymentConfiguration struct {
 	Upgrade map[string]lsv1alpha1.AnyJSON `json:"upgrade,omitempty"`
 	// +kubebuilder:validation:Schemaless
 	Uninstall map[string]lsv1alpha1.AnyJSON `json:"uninstall,omitempty"`
+
+	// RunTests specifies that the test hooks of the chart are run after a successful install or upgrade.
+	// The deploy item fails if a test fails.
+	// +optional
+	RunTests bool `json:"runTests,omitempty"`
+}
+
+// PostRendererConfiguration configures kustomize-style modifications of the rendered manifests of a chart.
+type PostRendererConfiguration struct {
+	// Patches are strategic merge patches or JSON6902 patches that are applied to the rendered resources.
+	// +optional
+	Patches []kustomize.Patch `json:"patches,omitempty"`
+
+	// Images rewrites the names, tags and digests of container images.
+	// +optional
+	Images []ImageRewrite `json:"images,omitempty"`
+
+	// Labels are added to the metadata of all rendered resources. Selectors and pod templates are not changed.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ImageRewrite rewrites the container images with a given name.
+type ImageRewrite struct {
+	// Name is the name of the image that is rewritten, without tag or digest.
+	Name string `json:"name"`
+
+	// NewName replaces the name of the image, e.g. to use another registry.
+	// +optional
+	NewName string `json:"newName,omitempty"`
+
+	// NewTag replaces the tag of the image.
+	// +optional
+	NewTag string `json:"newTag,omitempty"`
+
+	// Digest replaces the tag of the image with a digest.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// DiffConfiguration configures the comparison of a deployed helm release with the newly rendered chart.
+type DiffConfiguration struct {
+	// DiffOnly specifies that the release is neither installed nor upgraded. Only the diff is computed.
+	// +optional
+	DiffOnly bool `json:"diffOnly,omitempty"`
 }
 
 // HelmInstallConfiguration defines settings for a helm install operation.
@@ -183,6 +322,54 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+
+	// Diff contains the differences between the deployed release and the rendered chart of the last reconciliation.
+	// Only set if a diff is configured.
+	// +optional
+	Diff *ReleaseDiff `json:"diff,omitempty"`
+
+	// TestedRevision is the revision of the release whose tests have succeeded last.
+	// The tests are not run again for this revision or for upgrades of it that do not change the release.
+	// +optional
+	TestedRevision int `json:"testedRevision,omitempty"`
+}
+
+// ReleaseDiff describes the differences between the manifests of a deployed helm release and a newly rendered chart.
+type ReleaseDiff struct {
+	// Revision is the revision of the deployed release. It is 0 if the release is not yet installed.
+	Revision int `json:"revision"`
+
+	// Summary is a short summary of the differences.
+	Summary string `json:"summary"`
+
+	// Resources contains the resources that are added, changed or removed.
+	// +optional
+	Resources []ResourceDiff `json:"resources,omitempty"`
+}
+
+// ResourceChange is the kind of change of a resource in a release diff.
+type ResourceChange string
+
+const (
+	// ResourceAdded is the change of a resource that is not part of the deployed release.
+	ResourceAdded ResourceChange = "added"
+	// ResourceChanged is the change of a resource whose manifest differs from the deployed release.
+	ResourceChanged ResourceChange = "changed"
+	// ResourceRemoved is the change of a resource that is no longer part of the rendered chart.
+	ResourceRemoved ResourceChange = "removed"
+)
+
+// ResourceDiff describes the change of a resource in a release diff.
+type ResourceDiff struct {
+	// Resource is the added, changed or removed resource.
+	Resource corev1.ObjectReference `json:"resource"`
+
+	// Change is the kind of change.
+	Change ResourceChange `json:"change"`
+
+	// Fields are the paths of the changed fields. Only set for changed resources.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
@@ -7,12 +7,15 @@ package validation
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/helper"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
@@ -30,7 +33,25 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateChart(field.NewPath("chart"), config.Chart)...)
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, ValidatePostRendererConfiguration(field.NewPath("postRenderer"), config.PostRenderer)...)
+	allErrs = append(allErrs, ValidateValuesFrom(field.NewPath("valuesFrom"), config.ValuesFrom)...)
+
+	if config.Diff != nil && config.HelmDeployment != nil && !*config.HelmDeployment {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("diff"), "is only supported if helmDeployment is true"))
+	}
+	if config.HelmDeploymentConfig != nil && config.HelmDeploymentConfig.RunTests && config.HelmDeployment != nil && !*config.HelmDeployment {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("helmDeploymentConfig", "runTests"), "is only supported if helmDeployment is true"))
+	}
+	if config.HelmDeployment == nil || *config.HelmDeployment {
+		if config.UpdateStrategy == helmv1alpha1.UpdateStrategyServerSideApply {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("updateStrategy"), "serverSideApply is only supported if helmDeployment is false"))
+		}
+		if config.ServerSideApply != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("serverSideApply"), "is only supported if helmDeployment is false"))
+		}
+	}
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -76,6 +97,61 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	return allErrs.ToAggregate()
 }
 
+// ValidateConfiguration validates the configuration of a helm deployer
+func ValidateConfiguration(config *helmv1alpha1.Configuration) error {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateChartCacheConfiguration(field.NewPath("chartCache"), config.ChartCache)...)
+	allErrs = append(allErrs, ValidateChartVerificationConfiguration(field.NewPath("chartVerification"), config.ChartVerification)...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateChartCacheConfiguration validates the chart cache settings of a helm deployer configuration
+func ValidateChartCacheConfiguration(fldPath *field.Path, config *helmv1alpha1.ChartCacheConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	if len(config.Path) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), "must not be empty"))
+	}
+	if len(config.Size) != 0 {
+		if _, err := resource.ParseQuantity(config.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), config.Size, err.Error()))
+		}
+	}
+	if config.GCHighThreshold < 0 || config.GCHighThreshold > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gcHighThreshold"), config.GCHighThreshold, "must be between 0 and 1"))
+	}
+	if config.GCLowThreshold < 0 || config.GCLowThreshold > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gcLowThreshold"), config.GCLowThreshold, "must be between 0 and 1"))
+	}
+	if config.GCLowThreshold > config.GCHighThreshold {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gcLowThreshold"), config.GCLowThreshold, "must not be greater than gcHighThreshold"))
+	}
+
+	return allErrs
+}
+
+// ValidateChartVerificationConfiguration validates the chart verification settings of a helm deployer configuration
+func ValidateChartVerificationConfiguration(fldPath *field.Path, config *helmv1alpha1.ChartVerificationConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	validPolicies := []string{
+		string(helmv1alpha1.ChartVerificationEnforce),
+		string(helmv1alpha1.ChartVerificationDoNotEnforce),
+		string(helmv1alpha1.ChartVerificationDisabled),
+	}
+	if len(config.EnforcementPolicy) != 0 && !sets.NewString(validPolicies...).Has(string(config.EnforcementPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementPolicy"), config.EnforcementPolicy, validPolicies))
+	}
+
+	return allErrs
+}
+
 // ValidateChart validates the access methods for a chart
 func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -92,6 +168,103 @@ func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorLis
 		allErrs = append(allErrs, ValidateHelmChartRepo(fldPath.Child("helmChartRepo"), chart.HelmChartRepo)...)
 	}
 
+	if chart.Verification != nil {
+		allErrs = append(allErrs, ValidateChartVerification(fldPath.Child("verification"), chart)...)
+	}
+
+	return allErrs
+}
+
+// ValidateChartVerification validates the verification of a chart
+func ValidateChartVerification(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !helper.IsChartVerifiable(&chart) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "is only supported for charts defined by ref, helmChartRepo or archive.remote"))
+	}
+
+	if chart.Verification.KeyringSecretRef != nil && len(chart.Verification.KeyringSecretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyringSecretRef", "name"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
+// ValidatePostRendererConfiguration validates the patches and image rewrites of a post-renderer
+func ValidatePostRendererConfiguration(fldPath *field.Path, config *helmv1alpha1.PostRendererConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	for i, patch := range config.Patches {
+		if len(patch.Patch) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("patches").Index(i).Child("patch"), "must not be empty"))
+		}
+	}
+
+	for i, image := range config.Images {
+		imgPath := fldPath.Child("images").Index(i)
+		if len(image.Name) == 0 {
+			allErrs = append(allErrs, field.Required(imgPath.Child("name"), "must not be empty"))
+		}
+		if len(image.NewName) == 0 && len(image.NewTag) == 0 && len(image.Digest) == 0 {
+			allErrs = append(allErrs, field.Required(imgPath, "one of newName, newTag or digest must be set"))
+		}
+		if len(image.NewTag) != 0 && len(image.Digest) != 0 {
+			allErrs = append(allErrs, field.Forbidden(imgPath.Child("digest"), "must not be set together with newTag"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateValuesFrom validates the sources of helm values
+func ValidateValuesFrom(fldPath *field.Path, sources []helmv1alpha1.ValuesFromSource) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, source := range sources {
+		srcPath := fldPath.Index(i)
+
+		count := 0
+		if source.SecretRef != nil {
+			count++
+			allErrs = append(allErrs, validateValuesFromObjectReference(srcPath.Child("secretRef"), source.SecretRef)...)
+		}
+		if source.ConfigMapRef != nil {
+			count++
+			allErrs = append(allErrs, validateValuesFromObjectReference(srcPath.Child("configMapRef"), source.ConfigMapRef)...)
+		}
+		if source.ResourceRef != nil {
+			count++
+			resPath := srcPath.Child("resourceRef")
+			if len(source.ResourceRef.ResourceName) == 0 {
+				allErrs = append(allErrs, field.Required(resPath.Child("resourceName"), "must not be empty"))
+			}
+			if source.ResourceRef.Reference == nil && source.ResourceRef.Inline == nil {
+				allErrs = append(allErrs, field.Required(resPath, "either ref or inline must be set"))
+			}
+		}
+		if count != 1 {
+			allErrs = append(allErrs, field.Invalid(srcPath, source, "exactly one of secretRef, configMapRef or resourceRef must be set"))
+		}
+	}
+	return allErrs
+}
+
+func validateValuesFromObjectReference(fldPath *field.Path, ref *helmv1alpha1.ValuesFromObjectReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must not be empty"))
+	}
+	if len(ref.Key) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "must not be empty"))
+	}
+	if ref.TargetName != nil && len(ref.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must not be empty if targetName is set"))
+	}
+	if ref.TargetName == nil && len(ref.Namespace) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("namespace"), "must only be set together with targetName"))
+	}
 	return allErrs
 }
 
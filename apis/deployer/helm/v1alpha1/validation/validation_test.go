@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("Configuration", func() {
+		It("should accept the known chart verification enforcement policies", func() {
+			for _, policy := range []helmv1alpha1.ChartVerificationEnforcementPolicy{
+				"",
+				helmv1alpha1.ChartVerificationEnforce,
+				helmv1alpha1.ChartVerificationDoNotEnforce,
+				helmv1alpha1.ChartVerificationDisabled,
+			} {
+				config := &helmv1alpha1.Configuration{
+					ChartVerification: &helmv1alpha1.ChartVerificationConfiguration{EnforcementPolicy: policy},
+				}
+				Expect(validation.ValidateConfiguration(config)).To(Succeed())
+			}
+			Expect(validation.ValidateConfiguration(&helmv1alpha1.Configuration{})).To(Succeed())
+		})
+
+		It("should reject an unknown chart verification enforcement policy", func() {
+			allErrs := validation.ValidateChartVerificationConfiguration(field.NewPath("chartVerification"),
+				&helmv1alpha1.ChartVerificationConfiguration{EnforcementPolicy: "enforce"})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("chartVerification.enforcementPolicy"),
+			}))))
+		})
+	})
+
+	Context("ChartCache", func() {
+		newConfig := func() *helmv1alpha1.ChartCacheConfiguration {
+			return &helmv1alpha1.ChartCacheConfiguration{
+				Path:            "/app/ls/chart-cache",
+				Size:            "1Gi",
+				GCHighThreshold: 0.85,
+				GCLowThreshold:  0.80,
+			}
+		}
+
+		It("should accept a valid chart cache configuration", func() {
+			Expect(validation.ValidateChartCacheConfiguration(field.NewPath("chartCache"), newConfig())).To(BeEmpty())
+		})
+
+		It("should reject an invalid size", func() {
+			config := newConfig()
+			config.Size = "1 gigabyte"
+			Expect(validation.ValidateChartCacheConfiguration(field.NewPath("chartCache"), config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("chartCache.size"),
+			}))))
+		})
+
+		It("should reject a low threshold that is greater than the high threshold", func() {
+			config := newConfig()
+			config.GCLowThreshold = 0.9
+			Expect(validation.ValidateChartCacheConfiguration(field.NewPath("chartCache"), config)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("chartCache.gcLowThreshold"),
+			}))))
+		})
+	})
+
+	Context("ProviderConfiguration", func() {
+		newConfig := func() *helmv1alpha1.ProviderConfiguration {
+			return &helmv1alpha1.ProviderConfiguration{
+				Name:                 "test",
+				Namespace:            "default",
+				Chart:                helmv1alpha1.Chart{Ref: "example.com/charts/mychart:1.0.0"},
+				HelmDeploymentConfig: &helmv1alpha1.HelmDeploymentConfiguration{RunTests: true},
+			}
+		}
+
+		It("should accept tests of helm deployments", func() {
+			Expect(validation.ValidateProviderConfiguration(newConfig())).To(Succeed())
+
+			config := newConfig()
+			config.HelmDeployment = ptr.To(true)
+			Expect(validation.ValidateProviderConfiguration(config)).To(Succeed())
+		})
+
+		It("should forbid tests if the helm deployment is disabled", func() {
+			config := newConfig()
+			config.HelmDeployment = ptr.To(false)
+			err := validation.ValidateProviderConfiguration(config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("helmDeploymentConfig.runTests"))
+		})
+
+		It("should accept server-side apply if the helm deployment is disabled", func() {
+			config := newConfig()
+			config.HelmDeployment = ptr.To(false)
+			config.HelmDeploymentConfig = nil
+			config.UpdateStrategy = helmv1alpha1.UpdateStrategyServerSideApply
+			config.ServerSideApply = &managedresource.ServerSideApplyConfiguration{}
+			Expect(validation.ValidateProviderConfiguration(config)).To(Succeed())
+		})
+
+		It("should forbid server-side apply if the helm deployment is not disabled", func() {
+			for _, helmDeployment := range []*bool{nil, ptr.To(true)} {
+				config := newConfig()
+				config.HelmDeployment = helmDeployment
+				config.UpdateStrategy = helmv1alpha1.UpdateStrategyServerSideApply
+				config.ServerSideApply = &managedresource.ServerSideApplyConfiguration{}
+				err := validation.ValidateProviderConfiguration(config)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("updateStrategy"))
+				Expect(err.Error()).To(ContainSubstring("serverSideApply: Forbidden"))
+			}
+		})
+	})
+
+	Context("ChartVerification", func() {
+		It("should accept a verification of charts from verifiable sources", func() {
+			verification := &helmv1alpha1.ChartVerification{}
+			for _, chart := range []helmv1alpha1.Chart{
+				{Ref: "example.com/charts/mychart:1.0.0", Verification: verification},
+				{HelmChartRepo: &helmv1alpha1.HelmChartRepo{}, Verification: verification},
+				{Archive: &helmv1alpha1.ArchiveAccess{Remote: &helmv1alpha1.RemoteArchiveAccess{URL: "https://example.com"}}, Verification: verification},
+			} {
+				Expect(validation.ValidateChartVerification(field.NewPath("verification"), chart)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid a verification of inline archives", func() {
+			chart := helmv1alpha1.Chart{
+				Archive:      &helmv1alpha1.ArchiveAccess{Raw: "abc"},
+				Verification: &helmv1alpha1.ChartVerification{},
+			}
+			allErrs := validation.ValidateChartVerification(field.NewPath("verification"), chart)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("verification"),
+			}))))
+		})
+	})
+})
@@ -20,6 +20,8 @@ import (
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helm "github.com/gardener/landscaper/apis/deployer/helm"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	kustomize "github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -60,6 +62,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChartCacheConfiguration)(nil), (*helm.ChartCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartCacheConfiguration_To_helm_ChartCacheConfiguration(a.(*ChartCacheConfiguration), b.(*helm.ChartCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartCacheConfiguration)(nil), (*ChartCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartCacheConfiguration_To_v1alpha1_ChartCacheConfiguration(a.(*helm.ChartCacheConfiguration), b.(*ChartCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChartVerification)(nil), (*helm.ChartVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartVerification_To_helm_ChartVerification(a.(*ChartVerification), b.(*helm.ChartVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartVerification)(nil), (*ChartVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartVerification_To_v1alpha1_ChartVerification(a.(*helm.ChartVerification), b.(*ChartVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChartVerificationConfiguration)(nil), (*helm.ChartVerificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartVerificationConfiguration_To_helm_ChartVerificationConfiguration(a.(*ChartVerificationConfiguration), b.(*helm.ChartVerificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartVerificationConfiguration)(nil), (*ChartVerificationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartVerificationConfiguration_To_v1alpha1_ChartVerificationConfiguration(a.(*helm.ChartVerificationConfiguration), b.(*ChartVerificationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*helm.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_helm_Configuration(a.(*Configuration), b.(*helm.Configuration), scope)
 	}); err != nil {
@@ -80,6 +112,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DiffConfiguration)(nil), (*helm.DiffConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiffConfiguration_To_helm_DiffConfiguration(a.(*DiffConfiguration), b.(*helm.DiffConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.DiffConfiguration)(nil), (*DiffConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_DiffConfiguration_To_v1alpha1_DiffConfiguration(a.(*helm.DiffConfiguration), b.(*DiffConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExportConfiguration)(nil), (*helm.ExportConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExportConfiguration_To_helm_ExportConfiguration(a.(*ExportConfiguration), b.(*helm.ExportConfiguration), scope)
 	}); err != nil {
@@ -150,6 +192,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageRewrite)(nil), (*helm.ImageRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageRewrite_To_helm_ImageRewrite(a.(*ImageRewrite), b.(*helm.ImageRewrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ImageRewrite)(nil), (*ImageRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ImageRewrite_To_v1alpha1_ImageRewrite(a.(*helm.ImageRewrite), b.(*ImageRewrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererConfiguration)(nil), (*helm.PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(a.(*PostRendererConfiguration), b.(*helm.PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererConfiguration)(nil), (*PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(a.(*helm.PostRendererConfiguration), b.(*PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -170,6 +232,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseDiff)(nil), (*helm.ReleaseDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseDiff_To_helm_ReleaseDiff(a.(*ReleaseDiff), b.(*helm.ReleaseDiff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseDiff)(nil), (*ReleaseDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseDiff_To_v1alpha1_ReleaseDiff(a.(*helm.ReleaseDiff), b.(*ReleaseDiff), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteArchiveAccess)(nil), (*helm.RemoteArchiveAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(a.(*RemoteArchiveAccess), b.(*helm.RemoteArchiveAccess), scope)
 	}); err != nil {
@@ -190,6 +262,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResourceDiff)(nil), (*helm.ResourceDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceDiff_To_helm_ResourceDiff(a.(*ResourceDiff), b.(*helm.ResourceDiff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ResourceDiff)(nil), (*ResourceDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ResourceDiff_To_v1alpha1_ResourceDiff(a.(*helm.ResourceDiff), b.(*ResourceDiff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ValuesFromObjectReference)(nil), (*helm.ValuesFromObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValuesFromObjectReference_To_helm_ValuesFromObjectReference(a.(*ValuesFromObjectReference), b.(*helm.ValuesFromObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ValuesFromObjectReference)(nil), (*ValuesFromObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ValuesFromObjectReference_To_v1alpha1_ValuesFromObjectReference(a.(*helm.ValuesFromObjectReference), b.(*ValuesFromObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ValuesFromResourceReference)(nil), (*helm.ValuesFromResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValuesFromResourceReference_To_helm_ValuesFromResourceReference(a.(*ValuesFromResourceReference), b.(*helm.ValuesFromResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ValuesFromResourceReference)(nil), (*ValuesFromResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ValuesFromResourceReference_To_v1alpha1_ValuesFromResourceReference(a.(*helm.ValuesFromResourceReference), b.(*ValuesFromResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ValuesFromSource)(nil), (*helm.ValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(a.(*ValuesFromSource), b.(*helm.ValuesFromSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ValuesFromSource)(nil), (*ValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(a.(*helm.ValuesFromSource), b.(*ValuesFromSource), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -247,6 +359,7 @@ func autoConvert_v1alpha1_Chart_To_helm_Chart(in *Chart, out *helm.Chart, s conv
 	out.Archive = (*helm.ArchiveAccess)(unsafe.Pointer(in.Archive))
 	out.HelmChartRepo = (*helm.HelmChartRepo)(unsafe.Pointer(in.HelmChartRepo))
 	out.ResourceRef = in.ResourceRef
+	out.Verification = (*helm.ChartVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -261,6 +374,7 @@ func autoConvert_helm_Chart_To_v1alpha1_Chart(in *helm.Chart, out *Chart, s conv
 	out.Archive = (*ArchiveAccess)(unsafe.Pointer(in.Archive))
 	out.HelmChartRepo = (*HelmChartRepo)(unsafe.Pointer(in.HelmChartRepo))
 	out.ResourceRef = in.ResourceRef
+	out.Verification = (*ChartVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -269,6 +383,74 @@ func Convert_helm_Chart_To_v1alpha1_Chart(in *helm.Chart, out *Chart, s conversi
 	return autoConvert_helm_Chart_To_v1alpha1_Chart(in, out, s)
 }
 
+func autoConvert_v1alpha1_ChartCacheConfiguration_To_helm_ChartCacheConfiguration(in *ChartCacheConfiguration, out *helm.ChartCacheConfiguration, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Size = in.Size
+	out.GCHighThreshold = in.GCHighThreshold
+	out.GCLowThreshold = in.GCLowThreshold
+	return nil
+}
+
+// Convert_v1alpha1_ChartCacheConfiguration_To_helm_ChartCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ChartCacheConfiguration_To_helm_ChartCacheConfiguration(in *ChartCacheConfiguration, out *helm.ChartCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartCacheConfiguration_To_helm_ChartCacheConfiguration(in, out, s)
+}
+
+func autoConvert_helm_ChartCacheConfiguration_To_v1alpha1_ChartCacheConfiguration(in *helm.ChartCacheConfiguration, out *ChartCacheConfiguration, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Size = in.Size
+	out.GCHighThreshold = in.GCHighThreshold
+	out.GCLowThreshold = in.GCLowThreshold
+	return nil
+}
+
+// Convert_helm_ChartCacheConfiguration_To_v1alpha1_ChartCacheConfiguration is an autogenerated conversion function.
+func Convert_helm_ChartCacheConfiguration_To_v1alpha1_ChartCacheConfiguration(in *helm.ChartCacheConfiguration, out *ChartCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_ChartCacheConfiguration_To_v1alpha1_ChartCacheConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ChartVerification_To_helm_ChartVerification(in *ChartVerification, out *helm.ChartVerification, s conversion.Scope) error {
+	out.KeyringSecretRef = (*corev1alpha1.LocalSecretReference)(unsafe.Pointer(in.KeyringSecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_ChartVerification_To_helm_ChartVerification is an autogenerated conversion function.
+func Convert_v1alpha1_ChartVerification_To_helm_ChartVerification(in *ChartVerification, out *helm.ChartVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartVerification_To_helm_ChartVerification(in, out, s)
+}
+
+func autoConvert_helm_ChartVerification_To_v1alpha1_ChartVerification(in *helm.ChartVerification, out *ChartVerification, s conversion.Scope) error {
+	out.KeyringSecretRef = (*corev1alpha1.LocalSecretReference)(unsafe.Pointer(in.KeyringSecretRef))
+	return nil
+}
+
+// Convert_helm_ChartVerification_To_v1alpha1_ChartVerification is an autogenerated conversion function.
+func Convert_helm_ChartVerification_To_v1alpha1_ChartVerification(in *helm.ChartVerification, out *ChartVerification, s conversion.Scope) error {
+	return autoConvert_helm_ChartVerification_To_v1alpha1_ChartVerification(in, out, s)
+}
+
+func autoConvert_v1alpha1_ChartVerificationConfiguration_To_helm_ChartVerificationConfiguration(in *ChartVerificationConfiguration, out *helm.ChartVerificationConfiguration, s conversion.Scope) error {
+	out.EnforcementPolicy = helm.ChartVerificationEnforcementPolicy(in.EnforcementPolicy)
+	out.KeyringFile = in.KeyringFile
+	return nil
+}
+
+// Convert_v1alpha1_ChartVerificationConfiguration_To_helm_ChartVerificationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ChartVerificationConfiguration_To_helm_ChartVerificationConfiguration(in *ChartVerificationConfiguration, out *helm.ChartVerificationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartVerificationConfiguration_To_helm_ChartVerificationConfiguration(in, out, s)
+}
+
+func autoConvert_helm_ChartVerificationConfiguration_To_v1alpha1_ChartVerificationConfiguration(in *helm.ChartVerificationConfiguration, out *ChartVerificationConfiguration, s conversion.Scope) error {
+	out.EnforcementPolicy = ChartVerificationEnforcementPolicy(in.EnforcementPolicy)
+	out.KeyringFile = in.KeyringFile
+	return nil
+}
+
+// Convert_helm_ChartVerificationConfiguration_To_v1alpha1_ChartVerificationConfiguration is an autogenerated conversion function.
+func Convert_helm_ChartVerificationConfiguration_To_v1alpha1_ChartVerificationConfiguration(in *helm.ChartVerificationConfiguration, out *ChartVerificationConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_ChartVerificationConfiguration_To_v1alpha1_ChartVerificationConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration, out *helm.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
@@ -280,6 +462,8 @@ func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration,
 	if err := Convert_v1alpha1_Controller_To_helm_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.ChartCache = (*helm.ChartCacheConfiguration)(unsafe.Pointer(in.ChartCache))
+	out.ChartVerification = (*helm.ChartVerificationConfiguration)(unsafe.Pointer(in.ChartVerification))
 	return nil
 }
 
@@ -299,6 +483,8 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	if err := Convert_helm_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.ChartCache = (*ChartCacheConfiguration)(unsafe.Pointer(in.ChartCache))
+	out.ChartVerification = (*ChartVerificationConfiguration)(unsafe.Pointer(in.ChartVerification))
 	return nil
 }
 
@@ -327,6 +513,26 @@ func Convert_helm_Controller_To_v1alpha1_Controller(in *helm.Controller, out *Co
 	return autoConvert_helm_Controller_To_v1alpha1_Controller(in, out, s)
 }
 
+func autoConvert_v1alpha1_DiffConfiguration_To_helm_DiffConfiguration(in *DiffConfiguration, out *helm.DiffConfiguration, s conversion.Scope) error {
+	out.DiffOnly = in.DiffOnly
+	return nil
+}
+
+// Convert_v1alpha1_DiffConfiguration_To_helm_DiffConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DiffConfiguration_To_helm_DiffConfiguration(in *DiffConfiguration, out *helm.DiffConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiffConfiguration_To_helm_DiffConfiguration(in, out, s)
+}
+
+func autoConvert_helm_DiffConfiguration_To_v1alpha1_DiffConfiguration(in *helm.DiffConfiguration, out *DiffConfiguration, s conversion.Scope) error {
+	out.DiffOnly = in.DiffOnly
+	return nil
+}
+
+// Convert_helm_DiffConfiguration_To_v1alpha1_DiffConfiguration is an autogenerated conversion function.
+func Convert_helm_DiffConfiguration_To_v1alpha1_DiffConfiguration(in *helm.DiffConfiguration, out *DiffConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_DiffConfiguration_To_v1alpha1_DiffConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExportConfiguration_To_helm_ExportConfiguration(in *ExportConfiguration, out *helm.ExportConfiguration, s conversion.Scope) error {
 	out.DefaultTimeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.DefaultTimeout))
 	return nil
@@ -415,6 +621,7 @@ func autoConvert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConf
 	out.Install = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.RunTests = in.RunTests
 	return nil
 }
 
@@ -427,6 +634,7 @@ func autoConvert_helm_HelmDeploymentConfiguration_To_v1alpha1_HelmDeploymentConf
 	out.Install = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.RunTests = in.RunTests
 	return nil
 }
 
@@ -479,8 +687,59 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageRewrite_To_helm_ImageRewrite(in *ImageRewrite, out *helm.ImageRewrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NewName = in.NewName
+	out.NewTag = in.NewTag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1alpha1_ImageRewrite_To_helm_ImageRewrite is an autogenerated conversion function.
+func Convert_v1alpha1_ImageRewrite_To_helm_ImageRewrite(in *ImageRewrite, out *helm.ImageRewrite, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageRewrite_To_helm_ImageRewrite(in, out, s)
+}
+
+func autoConvert_helm_ImageRewrite_To_v1alpha1_ImageRewrite(in *helm.ImageRewrite, out *ImageRewrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NewName = in.NewName
+	out.NewTag = in.NewTag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_helm_ImageRewrite_To_v1alpha1_ImageRewrite is an autogenerated conversion function.
+func Convert_helm_ImageRewrite_To_v1alpha1_ImageRewrite(in *helm.ImageRewrite, out *ImageRewrite, s conversion.Scope) error {
+	return autoConvert_helm_ImageRewrite_To_v1alpha1_ImageRewrite(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]kustomize.Patch)(unsafe.Pointer(&in.Patches))
+	out.Images = *(*[]helm.ImageRewrite)(unsafe.Pointer(&in.Images))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in, out, s)
+}
+
+func autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]kustomize.Patch)(unsafe.Pointer(&in.Patches))
+	out.Images = *(*[]ImageRewrite)(unsafe.Pointer(&in.Images))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	if err := Convert_v1alpha1_Chart_To_helm_Chart(&in.Chart, &out.Chart, s); err != nil {
 		return err
@@ -489,11 +748,15 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]helm.ValuesFromSource)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.Diff = (*helm.DiffConfiguration)(unsafe.Pointer(in.Diff))
+	out.PostRenderer = (*helm.PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
 	return nil
@@ -507,6 +770,7 @@ func Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *Pr
 func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *helm.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	out.ReadinessChecks = in.ReadinessChecks
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	if err := Convert_helm_Chart_To_v1alpha1_Chart(&in.Chart, &out.Chart, s); err != nil {
 		return err
 	}
@@ -514,11 +778,15 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]ValuesFromSource)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.Diff = (*DiffConfiguration)(unsafe.Pointer(in.Diff))
+	out.PostRenderer = (*PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
 	return nil
@@ -531,6 +799,8 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Diff = (*helm.ReleaseDiff)(unsafe.Pointer(in.Diff))
+	out.TestedRevision = in.TestedRevision
 	return nil
 }
 
@@ -541,6 +811,8 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Diff = (*ReleaseDiff)(unsafe.Pointer(in.Diff))
+	out.TestedRevision = in.TestedRevision
 	return nil
 }
 
@@ -549,6 +821,30 @@ func Convert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderSta
 	return autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReleaseDiff_To_helm_ReleaseDiff(in *ReleaseDiff, out *helm.ReleaseDiff, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Summary = in.Summary
+	out.Resources = *(*[]helm.ResourceDiff)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseDiff_To_helm_ReleaseDiff is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseDiff_To_helm_ReleaseDiff(in *ReleaseDiff, out *helm.ReleaseDiff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseDiff_To_helm_ReleaseDiff(in, out, s)
+}
+
+func autoConvert_helm_ReleaseDiff_To_v1alpha1_ReleaseDiff(in *helm.ReleaseDiff, out *ReleaseDiff, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Summary = in.Summary
+	out.Resources = *(*[]ResourceDiff)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_helm_ReleaseDiff_To_v1alpha1_ReleaseDiff is an autogenerated conversion function.
+func Convert_helm_ReleaseDiff_To_v1alpha1_ReleaseDiff(in *helm.ReleaseDiff, out *ReleaseDiff, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseDiff_To_v1alpha1_ReleaseDiff(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(in *RemoteArchiveAccess, out *helm.RemoteArchiveAccess, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -590,3 +886,101 @@ func autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *
 func Convert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *helm.RemoteChartReference, out *RemoteChartReference, s conversion.Scope) error {
 	return autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in, out, s)
 }
+
+func autoConvert_v1alpha1_ResourceDiff_To_helm_ResourceDiff(in *ResourceDiff, out *helm.ResourceDiff, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Change = helm.ResourceChange(in.Change)
+	out.Fields = *(*[]string)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_v1alpha1_ResourceDiff_To_helm_ResourceDiff is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceDiff_To_helm_ResourceDiff(in *ResourceDiff, out *helm.ResourceDiff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceDiff_To_helm_ResourceDiff(in, out, s)
+}
+
+func autoConvert_helm_ResourceDiff_To_v1alpha1_ResourceDiff(in *helm.ResourceDiff, out *ResourceDiff, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Change = ResourceChange(in.Change)
+	out.Fields = *(*[]string)(unsafe.Pointer(&in.Fields))
+	return nil
+}
+
+// Convert_helm_ResourceDiff_To_v1alpha1_ResourceDiff is an autogenerated conversion function.
+func Convert_helm_ResourceDiff_To_v1alpha1_ResourceDiff(in *helm.ResourceDiff, out *ResourceDiff, s conversion.Scope) error {
+	return autoConvert_helm_ResourceDiff_To_v1alpha1_ResourceDiff(in, out, s)
+}
+
+func autoConvert_v1alpha1_ValuesFromObjectReference_To_helm_ValuesFromObjectReference(in *ValuesFromObjectReference, out *helm.ValuesFromObjectReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Key = in.Key
+	out.TargetName = (*string)(unsafe.Pointer(in.TargetName))
+	return nil
+}
+
+// Convert_v1alpha1_ValuesFromObjectReference_To_helm_ValuesFromObjectReference is an autogenerated conversion function.
+func Convert_v1alpha1_ValuesFromObjectReference_To_helm_ValuesFromObjectReference(in *ValuesFromObjectReference, out *helm.ValuesFromObjectReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValuesFromObjectReference_To_helm_ValuesFromObjectReference(in, out, s)
+}
+
+func autoConvert_helm_ValuesFromObjectReference_To_v1alpha1_ValuesFromObjectReference(in *helm.ValuesFromObjectReference, out *ValuesFromObjectReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Key = in.Key
+	out.TargetName = (*string)(unsafe.Pointer(in.TargetName))
+	return nil
+}
+
+// Convert_helm_ValuesFromObjectReference_To_v1alpha1_ValuesFromObjectReference is an autogenerated conversion function.
+func Convert_helm_ValuesFromObjectReference_To_v1alpha1_ValuesFromObjectReference(in *helm.ValuesFromObjectReference, out *ValuesFromObjectReference, s conversion.Scope) error {
+	return autoConvert_helm_ValuesFromObjectReference_To_v1alpha1_ValuesFromObjectReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ValuesFromResourceReference_To_helm_ValuesFromResourceReference(in *ValuesFromResourceReference, out *helm.ValuesFromResourceReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha1_ValuesFromResourceReference_To_helm_ValuesFromResourceReference is an autogenerated conversion function.
+func Convert_v1alpha1_ValuesFromResourceReference_To_helm_ValuesFromResourceReference(in *ValuesFromResourceReference, out *helm.ValuesFromResourceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValuesFromResourceReference_To_helm_ValuesFromResourceReference(in, out, s)
+}
+
+func autoConvert_helm_ValuesFromResourceReference_To_v1alpha1_ValuesFromResourceReference(in *helm.ValuesFromResourceReference, out *ValuesFromResourceReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_helm_ValuesFromResourceReference_To_v1alpha1_ValuesFromResourceReference is an autogenerated conversion function.
+func Convert_helm_ValuesFromResourceReference_To_v1alpha1_ValuesFromResourceReference(in *helm.ValuesFromResourceReference, out *ValuesFromResourceReference, s conversion.Scope) error {
+	return autoConvert_helm_ValuesFromResourceReference_To_v1alpha1_ValuesFromResourceReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in *ValuesFromSource, out *helm.ValuesFromSource, s conversion.Scope) error {
+	out.SecretRef = (*helm.ValuesFromObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.ConfigMapRef = (*helm.ValuesFromObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ResourceRef = (*helm.ValuesFromResourceReference)(unsafe.Pointer(in.ResourceRef))
+	out.JSONPath = in.JSONPath
+	return nil
+}
+
+// Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource is an autogenerated conversion function.
+func Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in *ValuesFromSource, out *helm.ValuesFromSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in, out, s)
+}
+
+func autoConvert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in *helm.ValuesFromSource, out *ValuesFromSource, s conversion.Scope) error {
+	out.SecretRef = (*ValuesFromObjectReference)(unsafe.Pointer(in.SecretRef))
+	out.ConfigMapRef = (*ValuesFromObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.ResourceRef = (*ValuesFromResourceReference)(unsafe.Pointer(in.ResourceRef))
+	out.JSONPath = in.JSONPath
+	return nil
+}
+
+// Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource is an autogenerated conversion function.
+func Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in *helm.ValuesFromSource, out *ValuesFromSource, s conversion.Scope) error {
+	return autoConvert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in, out, s)
+}
@@ -16,6 +16,8 @@ import (
 	config "github.com/gardener/landscaper/apis/config"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	kustomize "github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -79,6 +81,11 @@ func (in *Chart) DeepCopyInto(out *Chart) {
 		*out = new(HelmChartRepo)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ChartVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -92,6 +99,59 @@ func (in *Chart) DeepCopy() *Chart {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartCacheConfiguration) DeepCopyInto(out *ChartCacheConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartCacheConfiguration.
+func (in *ChartCacheConfiguration) DeepCopy() *ChartCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVerification) DeepCopyInto(out *ChartVerification) {
+	*out = *in
+	if in.KeyringSecretRef != nil {
+		in, out := &in.KeyringSecretRef, &out.KeyringSecretRef
+		*out = new(corev1alpha1.LocalSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVerification.
+func (in *ChartVerification) DeepCopy() *ChartVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVerificationConfiguration) DeepCopyInto(out *ChartVerificationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVerificationConfiguration.
+func (in *ChartVerificationConfiguration) DeepCopy() *ChartVerificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVerificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -115,6 +175,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.ChartCache != nil {
+		in, out := &in.ChartCache, &out.ChartCache
+		*out = new(ChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChartVerification != nil {
+		in, out := &in.ChartVerification, &out.ChartVerification
+		*out = new(ChartVerificationConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -153,6 +223,22 @@ func (in *Controller) DeepCopy() *Controller {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffConfiguration) DeepCopyInto(out *DiffConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffConfiguration.
+func (in *DiffConfiguration) DeepCopy() *DiffConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExportConfiguration) DeepCopyInto(out *ExportConfiguration) {
 	*out = *in
@@ -308,10 +394,66 @@ func (in *HelmUninstallConfiguration) DeepCopy() *HelmUninstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRewrite) DeepCopyInto(out *ImageRewrite) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRewrite.
+func (in *ImageRewrite) DeepCopy() *ImageRewrite {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRewrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererConfiguration) DeepCopyInto(out *PostRendererConfiguration) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]kustomize.Patch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageRewrite, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererConfiguration.
+func (in *PostRendererConfiguration) DeepCopy() *PostRendererConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	in.Chart.DeepCopyInto(&out.Chart)
 	if in.Values != nil {
@@ -319,6 +461,13 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExportsFromManifests != nil {
 		in, out := &in.ExportsFromManifests, &out.ExportsFromManifests
 		*out = make([]managedresource.Export, len(*in))
@@ -336,6 +485,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(driftdetection.DriftDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HelmDeployment != nil {
 		in, out := &in.HelmDeployment, &out.HelmDeployment
 		*out = new(bool)
@@ -346,6 +500,16 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(HelmDeploymentConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(DiffConfiguration)
+		**out = **in
+	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(PostRendererConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeletionGroups != nil {
 		in, out := &in.DeletionGroups, &out.DeletionGroups
 		*out = make([]managedresource.DeletionGroupDefinition, len(*in))
@@ -392,6 +556,11 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(ReleaseDiff)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -413,6 +582,29 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseDiff) DeepCopyInto(out *ReleaseDiff) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDiff.
+func (in *ReleaseDiff) DeepCopy() *ReleaseDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteArchiveAccess) DeepCopyInto(out *RemoteArchiveAccess) {
 	*out = *in
@@ -446,6 +638,28 @@ func (in *RemoteChartReference) DeepCopy() *RemoteChartReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
+	*out = *in
+	out.Resource = in.Resource
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDiff.
+func (in *ResourceDiff) DeepCopy() *ResourceDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
@@ -461,3 +675,72 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesFromObjectReference) DeepCopyInto(out *ValuesFromObjectReference) {
+	*out = *in
+	if in.TargetName != nil {
+		in, out := &in.TargetName, &out.TargetName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesFromObjectReference.
+func (in *ValuesFromObjectReference) DeepCopy() *ValuesFromObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesFromObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesFromResourceReference) DeepCopyInto(out *ValuesFromResourceReference) {
+	*out = *in
+	in.ComponentDescriptorDefinition.DeepCopyInto(&out.ComponentDescriptorDefinition)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesFromResourceReference.
+func (in *ValuesFromResourceReference) DeepCopy() *ValuesFromResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesFromResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesFromSource) DeepCopyInto(out *ValuesFromSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ValuesFromObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ValuesFromObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(ValuesFromResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesFromSource.
+func (in *ValuesFromSource) DeepCopy() *ValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -25,6 +25,12 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 
 func SetObjectDefaults_Configuration(in *Configuration) {
 	configv1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
+	if in.ChartCache != nil {
+		SetDefaults_ChartCacheConfiguration(in.ChartCache)
+	}
+	if in.ChartVerification != nil {
+		SetDefaults_ChartVerificationConfiguration(in.ChartVerification)
+	}
 }
 
 func SetObjectDefaults_ProviderConfiguration(in *ProviderConfiguration) {
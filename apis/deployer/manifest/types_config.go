@@ -9,6 +9,7 @@ import (
 
 	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 )
 
@@ -28,6 +29,9 @@ type Configuration struct {
 	// Identity identity describes the unique identity of the deployer.
 	// +optional
 	Identity string `json:"identity,omitempty"`
+	// OCI configures the oci client that reads the kustomization resources of component versions.
+	// +optional
+	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
 	// Export defines the export configuration.
@@ -9,7 +9,10 @@ import (
 
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
 )
 
@@ -21,6 +24,10 @@ type ProviderConfiguration struct {
 	// UpdateStrategy defines the strategy how the manifest are updated in the cluster.
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy"`
+	// ServerSideApply configures the server-side apply update strategy.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readiness,omitempty"`
@@ -32,12 +39,57 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+
+	// DriftDetection configures the periodic comparison of the deployed resources with their desired state.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+	// ApplyGroups defines the order in which objects are applied.
+	// +optional
+	ApplyGroups []managedresource.ApplyGroupDefinition `json:"applyGroups,omitempty"`
 	// DeletionGroups defines the order in which objects are deleted.
 	// +optional
 	DeletionGroups []managedresource.DeletionGroupDefinition `json:"deletionGroups,omitempty"`
 	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
 	// +optional
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+	// PruneProtection restricts the deletion of resources that have been removed from the manifests.
+	// If it is not set, only the default protected resources are protected.
+	// +optional
+	PruneProtection *managedresource.PruneProtection `json:"pruneProtection,omitempty"`
+	// Kustomize defines a kustomization that is built by the deployer.
+	// The resulting resources are applied together with the manifests.
+	// +optional
+	Kustomize *Kustomization `json:"kustomize,omitempty"`
+}
+
+// Kustomization defines a kustomization base and overlay patches that are built by the deployer.
+// The files of the base are read from a resource of a component version and from inline files.
+// Inline files overwrite files of the resource with the same path.
+type Kustomization struct {
+	// ResourceRef references a resource of a component version that contains the files of the base as tar archive.
+	// +optional
+	ResourceRef *KustomizationResourceReference `json:"resourceRef,omitempty"`
+	// Files contains files of the base by their path.
+	// +optional
+	Files map[string]string `json:"files,omitempty"`
+	// Path is the directory of the base which contains the kustomization file. Defaults to the root directory.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Patches are strategic merge or json patches that are applied to the resources of the base.
+	// +optional
+	Patches []kustomize.Patch `json:"patches,omitempty"`
+	// Policy defines the manage policy of the resulting resources. Defaults to "manage".
+	// +optional
+	Policy managedresource.ManifestPolicy `json:"policy,omitempty"`
+}
+
+// KustomizationResourceReference references a resource of a component version.
+type KustomizationResourceReference struct {
+	// ComponentDescriptorDefinition defines the component version.
+	// If no repository context is defined, the repository context of the landscaper context is used.
+	lsv1alpha1.ComponentDescriptorDefinition `json:",inline"`
+	// ResourceName is the name of the resource in the component version.
+	ResourceName string `json:"resourceName"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -48,6 +100,8 @@ const (
 	UpdateStrategyPatch          UpdateStrategy = "patch"
 	UpdateStrategyMerge          UpdateStrategy = "merge"
 	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	// UpdateStrategyServerSideApply applies the resources with server-side apply using a field manager per deploy item.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -11,6 +11,7 @@ package v1alpha1
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
@@ -19,6 +20,11 @@ import (
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(config.OCIConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetSelector != nil {
 		in, out := &in.TargetSelector, &out.TargetSelector
 		*out = make([]corev1alpha1.TargetSelector, len(*in))
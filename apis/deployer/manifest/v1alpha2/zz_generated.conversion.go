@@ -14,9 +14,12 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	kustomize "github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -67,6 +70,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Kustomization)(nil), (*manifest.Kustomization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Kustomization_To_manifest_Kustomization(a.(*Kustomization), b.(*manifest.Kustomization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.Kustomization)(nil), (*Kustomization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_Kustomization_To_v1alpha2_Kustomization(a.(*manifest.Kustomization), b.(*Kustomization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KustomizationResourceReference)(nil), (*manifest.KustomizationResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KustomizationResourceReference_To_manifest_KustomizationResourceReference(a.(*KustomizationResourceReference), b.(*manifest.KustomizationResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.KustomizationResourceReference)(nil), (*KustomizationResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_KustomizationResourceReference_To_v1alpha2_KustomizationResourceReference(a.(*manifest.KustomizationResourceReference), b.(*KustomizationResourceReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*manifest.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(a.(*ProviderConfiguration), b.(*manifest.ProviderConfiguration), scope)
 	}); err != nil {
@@ -92,6 +115,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 
 func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
 	if err := Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
@@ -110,6 +134,7 @@ func Convert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration,
 
 func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
+	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
@@ -186,14 +211,69 @@ func Convert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in *manifest
 	return autoConvert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha2_Kustomization_To_manifest_Kustomization(in *Kustomization, out *manifest.Kustomization, s conversion.Scope) error {
+	out.ResourceRef = (*manifest.KustomizationResourceReference)(unsafe.Pointer(in.ResourceRef))
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	out.Path = in.Path
+	out.Patches = *(*[]kustomize.Patch)(unsafe.Pointer(&in.Patches))
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_v1alpha2_Kustomization_To_manifest_Kustomization is an autogenerated conversion function.
+func Convert_v1alpha2_Kustomization_To_manifest_Kustomization(in *Kustomization, out *manifest.Kustomization, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Kustomization_To_manifest_Kustomization(in, out, s)
+}
+
+func autoConvert_manifest_Kustomization_To_v1alpha2_Kustomization(in *manifest.Kustomization, out *Kustomization, s conversion.Scope) error {
+	out.ResourceRef = (*KustomizationResourceReference)(unsafe.Pointer(in.ResourceRef))
+	out.Files = *(*map[string]string)(unsafe.Pointer(&in.Files))
+	out.Path = in.Path
+	out.Patches = *(*[]kustomize.Patch)(unsafe.Pointer(&in.Patches))
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	return nil
+}
+
+// Convert_manifest_Kustomization_To_v1alpha2_Kustomization is an autogenerated conversion function.
+func Convert_manifest_Kustomization_To_v1alpha2_Kustomization(in *manifest.Kustomization, out *Kustomization, s conversion.Scope) error {
+	return autoConvert_manifest_Kustomization_To_v1alpha2_Kustomization(in, out, s)
+}
+
+func autoConvert_v1alpha2_KustomizationResourceReference_To_manifest_KustomizationResourceReference(in *KustomizationResourceReference, out *manifest.KustomizationResourceReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha2_KustomizationResourceReference_To_manifest_KustomizationResourceReference is an autogenerated conversion function.
+func Convert_v1alpha2_KustomizationResourceReference_To_manifest_KustomizationResourceReference(in *KustomizationResourceReference, out *manifest.KustomizationResourceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KustomizationResourceReference_To_manifest_KustomizationResourceReference(in, out, s)
+}
+
+func autoConvert_manifest_KustomizationResourceReference_To_v1alpha2_KustomizationResourceReference(in *manifest.KustomizationResourceReference, out *KustomizationResourceReference, s conversion.Scope) error {
+	out.ComponentDescriptorDefinition = in.ComponentDescriptorDefinition
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_manifest_KustomizationResourceReference_To_v1alpha2_KustomizationResourceReference is an autogenerated conversion function.
+func Convert_manifest_KustomizationResourceReference_To_v1alpha2_KustomizationResourceReference(in *manifest.KustomizationResourceReference, out *KustomizationResourceReference, s conversion.Scope) error {
+	return autoConvert_manifest_KustomizationResourceReference_To_v1alpha2_KustomizationResourceReference(in, out, s)
+}
+
 func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in *ProviderConfiguration, out *manifest.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = manifest.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.ApplyGroups = *(*[]managedresource.ApplyGroupDefinition)(unsafe.Pointer(&in.ApplyGroups))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.PruneProtection = (*managedresource.PruneProtection)(unsafe.Pointer(in.PruneProtection))
+	out.Kustomize = (*manifest.Kustomization)(unsafe.Pointer(in.Kustomize))
 	return nil
 }
 
@@ -204,12 +284,17 @@ func Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in
 
 func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in *manifest.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.ApplyGroups = *(*[]managedresource.ApplyGroupDefinition)(unsafe.Pointer(&in.ApplyGroups))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.PruneProtection = (*managedresource.PruneProtection)(unsafe.Pointer(in.PruneProtection))
+	out.Kustomize = (*Kustomization)(unsafe.Pointer(in.Kustomize))
 	return nil
 }
 
@@ -5,11 +5,16 @@
 package validation
 
 import (
+	"path/filepath"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
@@ -20,10 +25,54 @@ func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguratio
 	allErrs = append(allErrs, validation.ValidateManifestList(field.NewPath(""), config.Manifests)...)
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
+	allErrs = append(allErrs, validation.ValidateApplyGroups(field.NewPath("applyGroups"), config.ApplyGroups)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidatePruneProtection(field.NewPath("pruneProtection"), config.PruneProtection)...)
+	allErrs = append(allErrs, ValidateKustomization(field.NewPath("kustomize"), config.Kustomize)...)
 	return allErrs.ToAggregate()
 }
 
+// ValidateKustomization validates a kustomization of a manifest provider configuration.
+func ValidateKustomization(fldPath *field.Path, kustomization *manifestv1alpha2.Kustomization) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if kustomization == nil {
+		return allErrs
+	}
+
+	if kustomization.ResourceRef == nil && len(kustomization.Files) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "either a resource reference or files must be defined"))
+	}
+	if kustomization.ResourceRef != nil {
+		refPath := fldPath.Child("resourceRef")
+		if kustomization.ResourceRef.Reference == nil && kustomization.ResourceRef.Inline == nil {
+			allErrs = append(allErrs, field.Required(refPath, "either a component descriptor reference or an inline component descriptor must be defined"))
+		}
+		if len(kustomization.ResourceRef.ResourceName) == 0 {
+			allErrs = append(allErrs, field.Required(refPath.Child("resourceName"), "resource name must be defined"))
+		}
+	}
+	if filepath.IsAbs(kustomization.Path) || strings.HasPrefix(filepath.Clean(kustomization.Path), "..") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), kustomization.Path, "path must be relative and must not leave the base"))
+	}
+	for i, patch := range kustomization.Patches {
+		if len(strings.TrimSpace(patch.Patch)) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("patches").Index(i).Child("patch"), "patch must not be empty"))
+		}
+	}
+
+	switch kustomization.Policy {
+	case "", managedresource.ManagePolicy, managedresource.FallbackPolicy, managedresource.KeepPolicy,
+		managedresource.IgnorePolicy, managedresource.ImmutablePolicy, managedresource.AdoptPolicy:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), kustomization.Policy, []string{
+			string(managedresource.ManagePolicy), string(managedresource.FallbackPolicy),
+			string(managedresource.KeepPolicy), string(managedresource.IgnorePolicy), string(managedresource.ImmutablePolicy),
+			string(managedresource.AdoptPolicy)}))
+	}
+	return allErrs
+}
+
 // ValidateTimeout validates a timeout.
 func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -11,8 +11,11 @@ package manifest
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	kustomize "github.com/gardener/landscaper/apis/deployer/utils/kustomize"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -20,6 +23,11 @@ import (
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(config.OCIConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetSelector != nil {
 		in, out := &in.TargetSelector, &out.TargetSelector
 		*out = make([]v1alpha1.TargetSelector, len(*in))
@@ -109,10 +117,67 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kustomization) DeepCopyInto(out *Kustomization) {
+	*out = *in
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(KustomizationResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]kustomize.Patch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kustomization.
+func (in *Kustomization) DeepCopy() *Kustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(Kustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizationResourceReference) DeepCopyInto(out *KustomizationResourceReference) {
+	*out = *in
+	in.ComponentDescriptorDefinition.DeepCopyInto(&out.ComponentDescriptorDefinition)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizationResourceReference.
+func (in *KustomizationResourceReference) DeepCopy() *KustomizationResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizationResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	if in.Manifests != nil {
 		in, out := &in.Manifests, &out.Manifests
@@ -131,6 +196,18 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(driftdetection.DriftDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyGroups != nil {
+		in, out := &in.ApplyGroups, &out.ApplyGroups
+		*out = make([]managedresource.ApplyGroupDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DeletionGroups != nil {
 		in, out := &in.DeletionGroups, &out.DeletionGroups
 		*out = make([]managedresource.DeletionGroupDefinition, len(*in))
@@ -145,6 +222,16 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PruneProtection != nil {
+		in, out := &in.PruneProtection, &out.PruneProtection
+		*out = new(managedresource.PruneProtection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(Kustomization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package driftdetection contains types for the drift detection specification.
+// +k8s:deepcopy-gen=package
+// +k8s:openapi-gen=true
+
+package driftdetection
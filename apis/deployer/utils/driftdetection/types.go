@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DriftDetectionSpec configures the periodic comparison of the deployed resources with their desired state.
+type DriftDetectionSpec struct {
+	// Every specifies the interval in which the deployed resources are compared with their desired state.
+	Every *lsv1alpha1.Duration `json:"every,omitempty"`
+
+	// AutoCorrect specifies whether the deploy item is reconciled automatically if a drift is detected.
+	// Otherwise, a drift is only reported.
+	// +optional
+	AutoCorrect bool `json:"autoCorrect,omitempty"`
+}
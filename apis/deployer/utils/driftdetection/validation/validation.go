@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+)
+
+// ValidateDriftDetectionSpec validates a drift detection spec.
+// A value of nil is considered valid and disables drift detection.
+func ValidateDriftDetectionSpec(fldPath *field.Path, spec *dd.DriftDetectionSpec) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if spec.Every == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("every"), "an interval is required"))
+	} else if spec.Every.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("every"), spec.Every, "specified duration has to be greater than zero"))
+	}
+	return allErrs
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("DriftDetectionSpec", func() {
+		It("should accept a nil spec", func() {
+			Expect(ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), nil)).To(HaveLen(0))
+		})
+
+		It("should accept a positive interval", func() {
+			spec := &dd.DriftDetectionSpec{
+				Every:       &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+				AutoCorrect: true,
+			}
+			Expect(ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)).To(HaveLen(0))
+		})
+
+		It("should deny a spec without interval", func() {
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), &dd.DriftDetectionSpec{})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("driftDetection.every"),
+			}))))
+		})
+
+		It("should deny a non-positive interval", func() {
+			spec := &dd.DriftDetectionSpec{
+				Every: &lsv1alpha1.Duration{Duration: 0},
+			}
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("driftDetection.every"),
+			}))))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+// Patch is a strategic merge patch or a JSON6902 patch of resources.
+type Patch struct {
+	// Patch is the strategic merge patch or the JSON6902 patch in yaml or json format.
+	Patch string `json:"patch"`
+
+	// Target selects the resources that are patched. It is required for JSON6902 patches.
+	// A strategic merge patch without target is applied to the resource with the same kind, name and namespace.
+	// +optional
+	Target *PatchTarget `json:"target,omitempty"`
+}
+
+// PatchTarget selects the resources that are patched. All specified fields must match.
+type PatchTarget struct {
+	// +optional
+	Group string `json:"group,omitempty"`
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the resources. It may be a regular expression.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the resources. It may be a regular expression.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector is a label selector in the string format of kubernetes label selectors.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// AnnotationSelector is an annotation selector in the string format of kubernetes label selectors.
+	// +optional
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+}
@@ -29,6 +29,10 @@ const (
 	IgnorePolicy ManifestPolicy = "ignore"
 	// ImmutablePolicy defines a policy where the resource is created and deleted but never updated.
 	ImmutablePolicy ManifestPolicy = "immutable"
+	// AdoptPolicy defines a policy where the resource is created, updated and deleted.
+	// An existing resource that is not managed by any deploy item is taken over,
+	// whereas a resource that is managed by another deploy item results in an error.
+	AdoptPolicy ManifestPolicy = "adopt"
 )
 
 // Manifest defines a manifest that is managed by the deployer.
@@ -91,6 +95,35 @@ type ManagedResourceStatus struct {
 	Policy ManifestPolicy `json:"policy,omitempty"`
 	// Resources describes the managed kubernetes resource.
 	Resource corev1.ObjectReference `json:"resource"`
+	// FieldManager is the name of the field manager that owns the fields applied by the deployer.
+	// It is only set if the resource has been applied with the server-side apply update strategy.
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+}
+
+// ServerSideApplyConfiguration configures the server-side apply update strategy.
+type ServerSideApplyConfiguration struct {
+	// Force defines whether conflicts with other field managers are resolved by taking over the ownership
+	// of the conflicting fields. If false, the apply of a resource fails in case of a conflict.
+	// +optional
+	Force bool `json:"force,omitempty"`
+}
+
+// PruneProtection restricts the deletion of orphaned resources, i.e. of resources that have been removed
+// from the manifests of a deploy item.
+type PruneProtection struct {
+	// MaxPrunedResources is the maximal number of orphaned resources that are deleted in one reconciliation.
+	// If more resources are orphaned, the reconciliation fails and no orphaned resource is deleted.
+	// +optional
+	MaxPrunedResources *int32 `json:"maxPrunedResources,omitempty"`
+	// ProtectedResources defines types of resources that are never deleted as orphaned resources.
+	// They are added to the default protected resources: persistent volume claims, namespaces and custom resource definitions.
+	// +optional
+	ProtectedResources []ResourceType `json:"protectedResources,omitempty"`
+	// DisableDefaultProtectedResources disables the protection of persistent volume claims, namespaces
+	// and custom resource definitions.
+	// +optional
+	DisableDefaultProtectedResources bool `json:"disableDefaultProtectedResources,omitempty"`
 }
 
 // Exports describes one export that is read from a resource.
@@ -105,7 +138,18 @@ type Export struct {
 
 	// JSONPath is the jsonpath to look for a value.
 	// The JSONPath root is the referenced resource
-	JSONPath string `json:"jsonPath"`
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// Expression is a CEL expression that computes the exported value.
+	// The resources that are defined in Resources are available in the expression as variables.
+	// Expression and JSONPath are mutually exclusive.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+
+	// Resources defines the resources that are read from the target cluster and that are available in the expression.
+	// +optional
+	Resources []ExportResource `json:"resources,omitempty"`
 
 	// FromResource specifies the name of the resource where the value should be read.
 	FromResource *lsv1alpha1.TypedObjectReference `json:"fromResource,omitempty"`
@@ -121,6 +165,27 @@ type Export struct {
 	TargetName *string `json:"targetName,omitempty"`
 }
 
+// ExportResource defines a resource of the target cluster that is available as variable in an export expression.
+// If a name is set, the variable contains the object with this name.
+// Otherwise, the variable contains the list of all objects that match the namespace and the label selector.
+type ExportResource struct {
+	// Variable is the name of the variable under which the resource is available in the expression.
+	Variable string `json:"variable"`
+	// APIVersion is the group and version of the resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Name is the name of the object.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the object, or the namespace in which the objects are listed.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector selects the listed objects by their labels. It must not be set together with a name.
+	// +optional
+	LabelSelector map[string]string `json:"labelSelector,omitempty"`
+}
+
 // FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from.
 // This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.
 type FromObjectReference struct {
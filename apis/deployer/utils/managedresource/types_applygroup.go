@@ -0,0 +1,47 @@
+package managedresource
+
+// ApplyGroupDefinition defines a group of resources that are applied together.
+// Groups are applied in the order in which they are defined. A resource belongs to the first group that matches it.
+// Resources that match no group are applied after all groups.
+type ApplyGroupDefinition struct {
+	// +optional
+	PredefinedResourceGroup *PredefinedApplyGroup `json:"predefinedResourceGroup,omitempty"`
+
+	// +optional
+	CustomResourceGroup *CustomApplyGroup `json:"customResourceGroup,omitempty"`
+
+	// WaitForReadiness defines whether the next group is only applied after the resources of this group are ready.
+	// +optional
+	WaitForReadiness bool `json:"waitForReadiness,omitempty"`
+}
+
+func (g *ApplyGroupDefinition) IsPredefined() bool {
+	return g.PredefinedResourceGroup != nil
+}
+
+func (g *ApplyGroupDefinition) IsCustom() bool {
+	return g.CustomResourceGroup != nil
+}
+
+type PredefinedApplyGroup struct {
+	Type PredefinedApplyGroupType `json:"type,omitempty"`
+}
+
+type PredefinedApplyGroupType string
+
+const (
+	// PredefinedApplyGroupNamespaces contains all namespaces.
+	PredefinedApplyGroupNamespaces PredefinedApplyGroupType = "namespaces"
+	// PredefinedApplyGroupCRDs contains all custom resource definitions.
+	PredefinedApplyGroupCRDs PredefinedApplyGroupType = "crds"
+	// PredefinedApplyGroupRBAC contains all service accounts and all resources of the group rbac.authorization.k8s.io.
+	PredefinedApplyGroupRBAC PredefinedApplyGroupType = "rbac"
+	// PredefinedApplyGroupWebhooks contains all validating and mutating webhook configurations.
+	PredefinedApplyGroupWebhooks PredefinedApplyGroupType = "webhooks"
+	// PredefinedApplyGroupWorkloads contains all deployments, stateful sets, daemon sets, replica sets, jobs, cron jobs and pods.
+	PredefinedApplyGroupWorkloads PredefinedApplyGroupType = "workloads"
+)
+
+type CustomApplyGroup struct {
+	Resources []ResourceType `json:"resources,omitempty"`
+}
@@ -5,12 +5,18 @@
 package validation
 
 import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
+// variableNameRegexp matches the names that can be used as variables in export expressions.
+var variableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 // ValidateManifestList validates a list of manifests.
 func ValidateManifestList(fldPath *field.Path, list []managedresource.Manifest) field.ErrorList {
 	var allErrs field.ErrorList
@@ -36,9 +42,15 @@ func ValidateManifestExport(fldPath *field.Path, export *managedresource.Export)
 	if len(export.Key) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "must not be empty"))
 	}
+	if len(export.Expression) != 0 {
+		return append(allErrs, validateExpressionExport(fldPath, export)...)
+	}
 	if len(export.JSONPath) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("jsonPath"), "must not be empty"))
 	}
+	if len(export.Resources) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resources"), "must only be set together with an expression"))
+	}
 	if export.FromResource != nil {
 		allErrs = append(allErrs, ValidateTypedObjectReference(fldPath.Child("fromResource"), export.FromResource)...)
 	}
@@ -49,6 +61,46 @@ func ValidateManifestExport(fldPath *field.Path, export *managedresource.Export)
 	return allErrs
 }
 
+// validateExpressionExport validates an export whose value is computed by an expression.
+func validateExpressionExport(fldPath *field.Path, export *managedresource.Export) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(export.JSONPath) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("jsonPath"), "must not be set together with an expression"))
+	}
+	if export.FromResource != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fromResource"), "must not be set together with an expression"))
+	}
+	if export.FromObjectReference != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fromObjectRef"), "must not be set together with an expression"))
+	}
+
+	variables := sets.New[string]()
+	for i, res := range export.Resources {
+		resPath := fldPath.Child("resources").Index(i)
+		if len(res.Variable) == 0 {
+			allErrs = append(allErrs, field.Required(resPath.Child("variable"), "must not be empty"))
+		} else if !variableNameRegexp.MatchString(res.Variable) {
+			allErrs = append(allErrs, field.Invalid(resPath.Child("variable"), res.Variable, "must start with a letter or an underscore and must only contain letters, digits and underscores"))
+		} else if variables.Has(res.Variable) {
+			allErrs = append(allErrs, field.Duplicate(resPath.Child("variable"), res.Variable))
+		}
+		variables.Insert(res.Variable)
+
+		if len(res.APIVersion) == 0 {
+			allErrs = append(allErrs, field.Required(resPath.Child("apiVersion"), "must not be empty"))
+		}
+		if len(res.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(resPath.Child("kind"), "must not be empty"))
+		}
+		if len(res.Name) != 0 && len(res.LabelSelector) != 0 {
+			allErrs = append(allErrs, field.Forbidden(resPath.Child("labelSelector"), "must not be set together with a name"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateTypedObjectReference validates a typed object reference.
 func ValidateTypedObjectReference(fldPath *field.Path, ref *lsv1alpha1.TypedObjectReference) field.ErrorList {
 	var allErrs field.ErrorList
@@ -140,3 +192,73 @@ func validateCustomResourceGroup(fldPath *field.Path, c *managedresource.CustomR
 
 	return allErrs
 }
+
+func ValidateApplyGroups(fldPath *field.Path, groups []managedresource.ApplyGroupDefinition) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, g := range groups {
+		allErrs = append(allErrs, validateApplyGroup(fldPath.Index(i), &g)...)
+	}
+	return allErrs
+}
+
+func validateApplyGroup(fldPath *field.Path, g *managedresource.ApplyGroupDefinition) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if g.IsPredefined() && g.IsCustom() {
+		allErrs = append(allErrs, field.Invalid(fldPath, g, "predefinedResourceGroup and customResourceGroup must not both be set"))
+	}
+	if !g.IsPredefined() && !g.IsCustom() {
+		allErrs = append(allErrs, field.Invalid(fldPath, g, "either predefinedResourceGroup or customResourceGroup must be set"))
+	}
+	if g.IsPredefined() {
+		allErrs = append(allErrs, validatePredefinedApplyGroup(fldPath.Child("predefinedResourceGroup"), g.PredefinedResourceGroup)...)
+	}
+	if g.IsCustom() && len(g.CustomResourceGroup.Resources) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("customResourceGroup", "resources"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validatePredefinedApplyGroup(fldPath *field.Path, p *managedresource.PredefinedApplyGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch p.Type {
+	case managedresource.PredefinedApplyGroupNamespaces,
+		managedresource.PredefinedApplyGroupCRDs,
+		managedresource.PredefinedApplyGroupRBAC,
+		managedresource.PredefinedApplyGroupWebhooks,
+		managedresource.PredefinedApplyGroupWorkloads:
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must not be empty"))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), p.Type, []string{
+			string(managedresource.PredefinedApplyGroupNamespaces),
+			string(managedresource.PredefinedApplyGroupCRDs),
+			string(managedresource.PredefinedApplyGroupRBAC),
+			string(managedresource.PredefinedApplyGroupWebhooks),
+			string(managedresource.PredefinedApplyGroupWorkloads),
+		}))
+	}
+
+	return allErrs
+}
+
+// ValidatePruneProtection validates a prune protection configuration.
+func ValidatePruneProtection(fldPath *field.Path, p *managedresource.PruneProtection) field.ErrorList {
+	var allErrs field.ErrorList
+	if p == nil {
+		return allErrs
+	}
+
+	if p.MaxPrunedResources != nil && *p.MaxPrunedResources < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPrunedResources"), *p.MaxPrunedResources, "must not be negative"))
+	}
+	for i, t := range p.ProtectedResources {
+		if len(t.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("protectedResources").Index(i).Child("kind"), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
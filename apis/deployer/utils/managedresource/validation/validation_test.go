@@ -106,6 +106,109 @@ var _ = Describe("Validation", func() {
 		})
 	})
 
+	Context("Expression export", func() {
+		It("should accept an export with an expression and resources", func() {
+			export := &managedresource.Export{
+				Key:        "abc",
+				Expression: "svc.spec.clusterIP",
+				Resources: []managedresource.ExportResource{
+					{
+						Variable:   "svc",
+						APIVersion: "v1",
+						Kind:       "Service",
+						Name:       "my-svc",
+						Namespace:  "default",
+					},
+					{
+						Variable:      "secrets",
+						APIVersion:    "v1",
+						Kind:          "Secret",
+						Namespace:     "default",
+						LabelSelector: map[string]string{"app": "x"},
+					},
+				},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny resources without an expression", func() {
+			export := &managedresource.Export{
+				Key:      "abc",
+				JSONPath: "b",
+				Resources: []managedresource.ExportResource{
+					{Variable: "svc", APIVersion: "v1", Kind: "Service"},
+				},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("a.resources"),
+			}))))
+		})
+
+		It("should deny an expression together with a jsonpath or a resource reference", func() {
+			export := &managedresource.Export{
+				Key:        "abc",
+				Expression: "'x'",
+				JSONPath:   "b",
+				FromResource: &lsv1alpha1.TypedObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					ObjectReference: lsv1alpha1.ObjectReference{
+						Name: "abc",
+					},
+				},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("a.jsonPath"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("a.fromResource"),
+				})),
+			))
+		})
+
+		It("should deny invalid resources", func() {
+			export := &managedresource.Export{
+				Key:        "abc",
+				Expression: "'x'",
+				Resources: []managedresource.ExportResource{
+					{Variable: "my-svc", APIVersion: "v1", Kind: "Service"},
+					{Variable: "svc", APIVersion: "v1", Kind: "Service"},
+					{Variable: "svc", Name: "a", LabelSelector: map[string]string{"app": "x"}},
+				},
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("a.resources[0].variable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("a.resources[2].variable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.resources[2].apiVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.resources[2].kind"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("a.resources[2].labelSelector"),
+				})),
+			))
+		})
+	})
+
 	Context("Deletion groups", func() {
 
 		It("should accept valid deletion groups", func() {
@@ -188,4 +291,102 @@ var _ = Describe("Validation", func() {
 		})
 
 	})
+
+	Context("Apply groups", func() {
+
+		It("should accept valid apply groups", func() {
+			applyGroups := []managedresource.ApplyGroupDefinition{
+				{PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+					Type: managedresource.PredefinedApplyGroupNamespaces,
+				}},
+				{PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+					Type: managedresource.PredefinedApplyGroupCRDs,
+				}},
+				{PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+					Type: managedresource.PredefinedApplyGroupRBAC,
+				}},
+				{
+					PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+						Type: managedresource.PredefinedApplyGroupWorkloads,
+					},
+					WaitForReadiness: true,
+				},
+				{PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+					Type: managedresource.PredefinedApplyGroupWebhooks,
+				}},
+				{CustomResourceGroup: &managedresource.CustomApplyGroup{
+					Resources: []managedresource.ResourceType{
+						{APIVersion: "landscaper.gardener.cloud/v1alpha1", Kind: "TestObject"},
+					},
+				}},
+			}
+			allErrs := validation.ValidateApplyGroups(fld, applyGroups)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject an apply group that is predefined and custom", func() {
+			applyGroups := []managedresource.ApplyGroupDefinition{
+				{
+					PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{},
+					CustomResourceGroup:     &managedresource.CustomApplyGroup{},
+				},
+			}
+			allErrs := validation.ValidateApplyGroups(fld, applyGroups)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("a[0]"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a[0].predefinedResourceGroup.type"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a[0].customResourceGroup.resources"),
+			}))))
+		})
+
+		It("should reject a predefined apply group with unsupported type", func() {
+			applyGroups := []managedresource.ApplyGroupDefinition{
+				{PredefinedResourceGroup: &managedresource.PredefinedApplyGroup{
+					Type: "test",
+				}},
+			}
+			allErrs := validation.ValidateApplyGroups(fld, applyGroups)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a[0].predefinedResourceGroup.type"),
+			}))))
+		})
+
+	})
+
+	Context("Prune protection", func() {
+
+		It("should accept a valid prune protection", func() {
+			maxPrunedResources := int32(0)
+			allErrs := validation.ValidatePruneProtection(fld, &managedresource.PruneProtection{
+				MaxPrunedResources: &maxPrunedResources,
+				ProtectedResources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+			})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject a negative maximal number of pruned resources and protected resources without kind", func() {
+			maxPrunedResources := int32(-1)
+			allErrs := validation.ValidatePruneProtection(fld, &managedresource.PruneProtection{
+				MaxPrunedResources: &maxPrunedResources,
+				ProtectedResources: []managedresource.ResourceType{{APIVersion: "v1"}},
+			})
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("a.maxPrunedResources"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("a.protectedResources[0].kind"),
+			}))))
+		})
+
+	})
 })
@@ -14,6 +14,55 @@ import (
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyGroupDefinition) DeepCopyInto(out *ApplyGroupDefinition) {
+	*out = *in
+	if in.PredefinedResourceGroup != nil {
+		in, out := &in.PredefinedResourceGroup, &out.PredefinedResourceGroup
+		*out = new(PredefinedApplyGroup)
+		**out = **in
+	}
+	if in.CustomResourceGroup != nil {
+		in, out := &in.CustomResourceGroup, &out.CustomResourceGroup
+		*out = new(CustomApplyGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyGroupDefinition.
+func (in *ApplyGroupDefinition) DeepCopy() *ApplyGroupDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyGroupDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomApplyGroup) DeepCopyInto(out *CustomApplyGroup) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomApplyGroup.
+func (in *CustomApplyGroup) DeepCopy() *CustomApplyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomApplyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourceGroup) DeepCopyInto(out *CustomResourceGroup) {
 	*out = *in
@@ -71,6 +120,13 @@ func (in *DeletionGroupDefinition) DeepCopy() *DeletionGroupDefinition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Export) DeepCopyInto(out *Export) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ExportResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FromResource != nil {
 		in, out := &in.FromResource, &out.FromResource
 		*out = new(v1alpha1.TypedObjectReference)
@@ -99,6 +155,29 @@ func (in *Export) DeepCopy() *Export {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportResource) DeepCopyInto(out *ExportResource) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportResource.
+func (in *ExportResource) DeepCopy() *ExportResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Exports) DeepCopyInto(out *Exports) {
 	*out = *in
@@ -234,6 +313,22 @@ func (in *Manifest) DeepCopy() *Manifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredefinedApplyGroup) DeepCopyInto(out *PredefinedApplyGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredefinedApplyGroup.
+func (in *PredefinedApplyGroup) DeepCopy() *PredefinedApplyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PredefinedApplyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PredefinedResourceGroup) DeepCopyInto(out *PredefinedResourceGroup) {
 	*out = *in
@@ -250,6 +345,34 @@ func (in *PredefinedResourceGroup) DeepCopy() *PredefinedResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruneProtection) DeepCopyInto(out *PruneProtection) {
+	*out = *in
+	if in.MaxPrunedResources != nil {
+		in, out := &in.MaxPrunedResources, &out.MaxPrunedResources
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProtectedResources != nil {
+		in, out := &in.ProtectedResources, &out.ProtectedResources
+		*out = make([]ResourceType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruneProtection.
+func (in *PruneProtection) DeepCopy() *PruneProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(PruneProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceType) DeepCopyInto(out *ResourceType) {
 	*out = *in
@@ -275,3 +398,19 @@ func (in *ResourceType) DeepCopy() *ResourceType {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConfiguration) DeepCopyInto(out *ServerSideApplyConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConfiguration.
+func (in *ServerSideApplyConfiguration) DeepCopy() *ServerSideApplyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,6 +16,12 @@ type ReadinessCheckConfiguration struct {
 	// DisableDefault allows to disable the default readiness checks.
 	// +optional
 	DisableDefault bool `json:"disableDefault,omitempty"`
+	// Generic extends the default readiness checks.
+	// Jobs, PersistentVolumeClaims, Services of type LoadBalancer, CustomResourceDefinitions and APIServices
+	// are checked by built-in checks. All other resources are checked by their status.observedGeneration
+	// and their "Ready" or "Available" conditions.
+	// +optional
+	Generic bool `json:"generic,omitempty"`
 	// CustomReadinessChecks is a set of custom readiness check configurations
 	// +optional
 	CustomReadinessChecks []CustomReadinessCheckConfiguration `json:"custom,omitempty"`
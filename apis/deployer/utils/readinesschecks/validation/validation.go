@@ -18,6 +18,10 @@ import (
 func ValidateReadinessCheckConfiguration(fldPath *field.Path, config *readinesschecks.ReadinessCheckConfiguration) field.ErrorList {
 	var allErrs field.ErrorList
 
+	if config.DisableDefault && config.Generic {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("generic"), config.Generic, "the generic readiness check requires the default readiness check"))
+	}
+
 	// if we have a custom readiness check configuration, the default should be disabled
 	customReadinessChecks := config.CustomReadinessChecks
 	for _, c := range customReadinessChecks {
@@ -96,6 +96,21 @@ var _ = Describe("Validation", func() {
 		Expect(allErrs).To(HaveLen(0))
 	})
 
+	It("should accept a readiness check configuration with the generic readiness check enabled", func() {
+		rc.DisableDefault = false
+		rc.Generic = true
+
+		allErrs := validation.ValidateReadinessCheckConfiguration(fld, &rc)
+		Expect(allErrs).To(HaveLen(0))
+	})
+
+	It("should reject a readiness check configuration with the generic readiness check enabled and the default readiness check disabled", func() {
+		rc.Generic = true
+
+		allErrs := validation.ValidateReadinessCheckConfiguration(fld, &rc)
+		Expect(allErrs).To(HaveLen(1))
+	})
+
 	It("should reject a custom readiness check without a name", func() {
 		rc.CustomReadinessChecks[0].Name = ""
 
@@ -40,6 +40,15 @@ const (
 	// This is the legacy deprecated artifact media type.
 	JSONSchemaArtifactsMediaTypeV1 = "application/vnd.gardener.landscaper.jsonschema.layer.v1.json"
 
+	// HelmValuesType is the name of the type of a resource in a component descriptor that contains values for a helm chart.
+	// Like JSONSchemaType, it is not a media or MIME type.
+	HelmValuesType = "landscaper.gardener.cloud/helm-values"
+
+	// KustomizationType is the name of the type of a resource in a component descriptor that contains
+	// the files of a kustomization as tar archive.
+	// Like JSONSchemaType, it is not a media or MIME type.
+	KustomizationType = "landscaper.gardener.cloud/kustomization"
+
 	// GZipCompression is the identifier for a gzip compressed file.
 	GZipCompression = "gzip"
 
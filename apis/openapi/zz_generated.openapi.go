@@ -78,6 +78,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
 		"github.com/gardener/landscaper/apis/core.AnyJSON":                                                     schema_gardener_landscaper_apis_core_AnyJSON(ref),
+		"github.com/gardener/landscaper/apis/core.ApprovalStatus":                                              schema_gardener_landscaper_apis_core_ApprovalStatus(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcile":                                          schema_gardener_landscaper_apis_core_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus":                                    schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref),
 		"github.com/gardener/landscaper/apis/core.Blueprint":                                                   schema_gardener_landscaper_apis_core_Blueprint(ref),
@@ -108,12 +109,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.DependentToTrigger":                                          schema_gardener_landscaper_apis_core_DependentToTrigger(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItem":                                                  schema_gardener_landscaper_apis_core_DeployItem(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemCache":                                             schema_gardener_landscaper_apis_core_DeployItemCache(ref),
+		"github.com/gardener/landscaper/apis/core.DeployItemDryRunResult":                                      schema_gardener_landscaper_apis_core_DeployItemDryRunResult(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemList":                                              schema_gardener_landscaper_apis_core_DeployItemList(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemSpec":                                              schema_gardener_landscaper_apis_core_DeployItemSpec(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemStatus":                                            schema_gardener_landscaper_apis_core_DeployItemStatus(ref),
 		"github.com/gardener/landscaper/apis/core.DeployItemTemplate":                                          schema_gardener_landscaper_apis_core_DeployItemTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.DeployerInformation":                                         schema_gardener_landscaper_apis_core_DeployerInformation(ref),
 		"github.com/gardener/landscaper/apis/core.DiNamePair":                                                  schema_gardener_landscaper_apis_core_DiNamePair(ref),
+		"github.com/gardener/landscaper/apis/core.DryRunStatus":                                                schema_gardener_landscaper_apis_core_DryRunStatus(ref),
 		"github.com/gardener/landscaper/apis/core.Duration":                                                    schema_gardener_landscaper_apis_core_Duration(ref),
 		"github.com/gardener/landscaper/apis/core.Error":                                                       schema_gardener_landscaper_apis_core_Error(ref),
 		"github.com/gardener/landscaper/apis/core.Execution":                                                   schema_gardener_landscaper_apis_core_Execution(ref),
@@ -129,10 +132,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.InstallationExports":                                         schema_gardener_landscaper_apis_core_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationImports":                                         schema_gardener_landscaper_apis_core_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationList":                                            schema_gardener_landscaper_apis_core_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core.InstallationRevision":                                        schema_gardener_landscaper_apis_core_InstallationRevision(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationSpec":                                            schema_gardener_landscaper_apis_core_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationStatus":                                          schema_gardener_landscaper_apis_core_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationTemplate":                                        schema_gardener_landscaper_apis_core_InstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition":                     schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref),
+		"github.com/gardener/landscaper/apis/core.JSONPatchOperation":                                          schema_gardener_landscaper_apis_core_JSONPatchOperation(ref),
 		"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition":                                        schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref),
 		"github.com/gardener/landscaper/apis/core.LocalConfigMapReference":                                     schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core.LocalSecretReference":                                        schema_gardener_landscaper_apis_core_LocalSecretReference(ref),
@@ -146,6 +151,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.Requirement":                                                 schema_gardener_landscaper_apis_core_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core.ResolvedTarget":                                              schema_gardener_landscaper_apis_core_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core.ResourceReference":                                           schema_gardener_landscaper_apis_core_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core.RollbackStatus":                                              schema_gardener_landscaper_apis_core_RollbackStatus(ref),
+		"github.com/gardener/landscaper/apis/core.RolloutPolicy":                                               schema_gardener_landscaper_apis_core_RolloutPolicy(ref),
+		"github.com/gardener/landscaper/apis/core.RolloutStatus":                                               schema_gardener_landscaper_apis_core_RolloutStatus(ref),
 		"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef":                                      schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core.SecretReference":                                             schema_gardener_landscaper_apis_core_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core.StaticDataSource":                                            schema_gardener_landscaper_apis_core_StaticDataSource(ref),
@@ -179,6 +187,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.VersionedObjectReference":                                    schema_gardener_landscaper_apis_core_VersionedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.VersionedResourceReference":                                  schema_gardener_landscaper_apis_core_VersionedResourceReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalStatus":                                     schema_landscaper_apis_core_v1alpha1_ApprovalStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Blueprint":                                          schema_landscaper_apis_core_v1alpha1_Blueprint(ref),
@@ -209,12 +218,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger":                                 schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem":                                         schema_landscaper_apis_core_v1alpha1_DeployItem(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache":                                    schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemDryRunResult":                             schema_landscaper_apis_core_v1alpha1_DeployItemDryRunResult(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemList":                                     schema_landscaper_apis_core_v1alpha1_DeployItemList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec":                                     schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus":                                   schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate":                                 schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation":                                schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair":                                         schema_landscaper_apis_core_v1alpha1_DiNamePair(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DryRunStatus":                                       schema_landscaper_apis_core_v1alpha1_DryRunStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Duration":                                           schema_landscaper_apis_core_v1alpha1_Duration(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Error":                                              schema_landscaper_apis_core_v1alpha1_Error(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Execution":                                          schema_landscaper_apis_core_v1alpha1_Execution(ref),
@@ -230,10 +241,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports":                                schema_landscaper_apis_core_v1alpha1_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationList":                                   schema_landscaper_apis_core_v1alpha1_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision":                               schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec":                                   schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus":                                 schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplate":                               schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition":            schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.JSONPatchOperation":                                 schema_landscaper_apis_core_v1alpha1_JSONPatchOperation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition":                               schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference":                            schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
@@ -247,6 +260,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResourceReference":                                  schema_landscaper_apis_core_v1alpha1_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus":                                     schema_landscaper_apis_core_v1alpha1_RollbackStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy":                                      schema_landscaper_apis_core_v1alpha1_RolloutPolicy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus":                                      schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef":                             schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference":                                    schema_landscaper_apis_core_v1alpha1_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataSource":                                   schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref),
@@ -286,9 +302,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container.DebugOptions":                                  schema_landscaper_apis_deployer_container_DebugOptions(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.GarbageCollection":                             schema_landscaper_apis_deployer_container_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration":                              schema_landscaper_apis_deployer_container_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.JobConfiguration":                              schema_landscaper_apis_deployer_container_JobConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.LogsConfiguration":                             schema_landscaper_apis_deployer_container_LogsConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.ObjectStoreStateBackend":                       schema_landscaper_apis_deployer_container_ObjectStoreStateBackend(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.PVCStateBackend":                               schema_landscaper_apis_deployer_container_PVCStateBackend(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.PodConfiguration":                              schema_landscaper_apis_deployer_container_PodConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.PodStatus":                                     schema_landscaper_apis_deployer_container_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderConfiguration":                         schema_landscaper_apis_deployer_container_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderStatus":                                schema_landscaper_apis_deployer_container_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.StateConfiguration":                            schema_landscaper_apis_deployer_container_StateConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.StateEncryption":                               schema_landscaper_apis_deployer_container_StateEncryption(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Configuration":                        schema_apis_deployer_container_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec":                        schema_apis_deployer_container_v1alpha1_ContainerSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus":                      schema_apis_deployer_container_v1alpha1_ContainerStatus(ref),
@@ -296,14 +319,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions":                         schema_apis_deployer_container_v1alpha1_DebugOptions(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection":                    schema_apis_deployer_container_v1alpha1_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration":                     schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration":                     schema_apis_deployer_container_v1alpha1_JobConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogsConfiguration":                    schema_apis_deployer_container_v1alpha1_LogsConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ObjectStoreStateBackend":              schema_apis_deployer_container_v1alpha1_ObjectStoreStateBackend(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PVCStateBackend":                      schema_apis_deployer_container_v1alpha1_PVCStateBackend(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodConfiguration":                     schema_apis_deployer_container_v1alpha1_PodConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus":                            schema_apis_deployer_container_v1alpha1_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderConfiguration":                schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderStatus":                       schema_apis_deployer_container_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration":                   schema_apis_deployer_container_v1alpha1_StateConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption":                      schema_apis_deployer_container_v1alpha1_StateEncryption(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess":                                      schema_landscaper_apis_deployer_helm_ArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Auth":                                               schema_landscaper_apis_deployer_helm_Auth(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Chart":                                              schema_landscaper_apis_deployer_helm_Chart(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ChartCacheConfiguration":                            schema_landscaper_apis_deployer_helm_ChartCacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ChartVerification":                                  schema_landscaper_apis_deployer_helm_ChartVerification(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ChartVerificationConfiguration":                     schema_landscaper_apis_deployer_helm_ChartVerificationConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Configuration":                                      schema_landscaper_apis_deployer_helm_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.Controller":                                         schema_landscaper_apis_deployer_helm_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.DiffConfiguration":                                  schema_landscaper_apis_deployer_helm_DiffConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration":                                schema_landscaper_apis_deployer_helm_ExportConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration":                                   schema_landscaper_apis_deployer_helm_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo":                                      schema_landscaper_apis_deployer_helm_HelmChartRepo(ref),
@@ -311,15 +345,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration":                        schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmInstallConfiguration":                           schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.HelmUninstallConfiguration":                         schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ImageRewrite":                                       schema_landscaper_apis_deployer_helm_ImageRewrite(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration":                          schema_landscaper_apis_deployer_helm_PostRendererConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ProviderConfiguration":                              schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.ProviderStatus":                                     schema_landscaper_apis_deployer_helm_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ReleaseDiff":                                        schema_landscaper_apis_deployer_helm_ReleaseDiff(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess":                                schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference":                               schema_landscaper_apis_deployer_helm_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ResourceDiff":                                       schema_landscaper_apis_deployer_helm_ResourceDiff(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ValuesFromObjectReference":                          schema_landscaper_apis_deployer_helm_ValuesFromObjectReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ValuesFromResourceReference":                        schema_landscaper_apis_deployer_helm_ValuesFromResourceReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm.ValuesFromSource":                                   schema_landscaper_apis_deployer_helm_ValuesFromSource(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess":                             schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth":                                      schema_apis_deployer_helm_v1alpha1_Auth(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart":                                     schema_apis_deployer_helm_v1alpha1_Chart(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartCacheConfiguration":                   schema_apis_deployer_helm_v1alpha1_ChartCacheConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerification":                         schema_apis_deployer_helm_v1alpha1_ChartVerification(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerificationConfiguration":            schema_apis_deployer_helm_v1alpha1_ChartVerificationConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Configuration":                             schema_apis_deployer_helm_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller":                                schema_apis_deployer_helm_v1alpha1_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.DiffConfiguration":                         schema_apis_deployer_helm_v1alpha1_DiffConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration":                       schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration":                          schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo":                             schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref),
@@ -327,15 +372,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration":               schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmInstallConfiguration":                  schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmUninstallConfiguration":                schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ImageRewrite":                              schema_apis_deployer_helm_v1alpha1_ImageRewrite(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration":                 schema_apis_deployer_helm_v1alpha1_PostRendererConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderStatus":                            schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseDiff":                               schema_apis_deployer_helm_v1alpha1_ReleaseDiff(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference":                      schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceDiff":                              schema_apis_deployer_helm_v1alpha1_ResourceDiff(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceRef":                               schema_apis_deployer_helm_v1alpha1_ResourceRef(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromObjectReference":                 schema_apis_deployer_helm_v1alpha1_ValuesFromObjectReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromResourceReference":               schema_apis_deployer_helm_v1alpha1_ValuesFromResourceReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromSource":                          schema_apis_deployer_helm_v1alpha1_ValuesFromSource(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Configuration":                                  schema_landscaper_apis_deployer_manifest_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Controller":                                     schema_landscaper_apis_deployer_manifest_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration":                            schema_landscaper_apis_deployer_manifest_ExportConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration":                               schema_landscaper_apis_deployer_manifest_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest.Kustomization":                                  schema_landscaper_apis_deployer_manifest_Kustomization(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest.KustomizationResourceReference":                 schema_landscaper_apis_deployer_manifest_KustomizationResourceReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.ProviderConfiguration":                          schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.ProviderStatus":                                 schema_landscaper_apis_deployer_manifest_ProviderStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Configuration":                         schema_apis_deployer_manifest_v1alpha1_Configuration(ref),
@@ -348,6 +402,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller":                            schema_apis_deployer_manifest_v1alpha2_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration":                   schema_apis_deployer_manifest_v1alpha2_ExportConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration":                      schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Kustomization":                         schema_apis_deployer_manifest_v1alpha2_Kustomization(ref),
+		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizationResourceReference":        schema_apis_deployer_manifest_v1alpha2_KustomizationResourceReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ProviderConfiguration":                 schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ProviderStatus":                        schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock.Configuration":                                      schema_landscaper_apis_deployer_mock_Configuration(ref),
@@ -355,15 +411,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.Configuration":                             schema_apis_deployer_mock_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec":                 schema_apis_deployer_utils_driftdetection_DriftDetectionSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch":                                   schema_apis_deployer_utils_kustomize_Patch(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/kustomize.PatchTarget":                             schema_apis_deployer_utils_kustomize_PatchTarget(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ApplyGroupDefinition":              schema_apis_deployer_utils_managedresource_ApplyGroupDefinition(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomApplyGroup":                  schema_apis_deployer_utils_managedresource_CustomApplyGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomResourceGroup":               schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition":           schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportResource":                    schema_apis_deployer_utils_managedresource_ExportResource(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference":               schema_apis_deployer_utils_managedresource_FromObjectReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus":             schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest":                          schema_apis_deployer_utils_managedresource_Manifest(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedApplyGroup":              schema_apis_deployer_utils_managedresource_PredefinedApplyGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedResourceGroup":           schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.PruneProtection":                   schema_apis_deployer_utils_managedresource_PruneProtection(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType":                      schema_apis_deployer_utils_managedresource_ResourceType(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration":      schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.CustomReadinessCheckConfiguration": schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.LabelSelectorSpec":                 schema_apis_deployer_utils_readinesschecks_LabelSelectorSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration":       schema_apis_deployer_utils_readinesschecks_ReadinessCheckConfiguration(ref),
@@ -2860,6 +2925,44 @@ func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback)
 	}
 }
 
+func schema_gardener_landscaper_apis_core_ApprovalStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApprovalStatus describes the approval of the current job of an execution or installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job that was approved.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the identity of the approver, as specified in the approved-by annotation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalTime is the time when the approval was recorded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"jobID", "approvedBy", "approvalTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2879,6 +2982,13 @@ func schema_gardener_landscaper_apis_core_AutomaticReconcile(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core.FailedReconcile"),
 						},
 					},
+					"onFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailure defines the behavior if a root installation fails. If set to \"rollback\", the installation is automatically rolled back to the latest succeeded revision whose spec differs from the failed spec. An installation that fails after a rollback is not rolled back again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4210,6 +4320,64 @@ func schema_gardener_landscaper_apis_core_DeployItemCache(ref common.ReferenceCa
 	}
 }
 
+func schema_gardener_landscaper_apis_core_DeployItemDryRunResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemDryRunResult describes the planned change of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the deploy item as defined in the deploy executions of the blueprint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action describes how the deploy item would be changed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItemRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemReference is the reference to the existing deploy item. It is not set if the deploy item would be created.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
+					"specification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification is the rendered deploy item template. It is not set if the deploy item would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployItemTemplate"),
+						},
+					},
+					"configDiff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigDiff contains the JSON patch operations (RFC 6902) that transform the configuration of the existing deploy item into the rendered configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.JSONPatchOperation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "action"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DeployItemTemplate", "github.com/gardener/landscaper/apis/core.JSONPatchOperation", "github.com/gardener/landscaper/apis/core.ObjectReference"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4534,12 +4702,25 @@ func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core.OnDeleteConfig"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the deploy item to a rollout, which starts the deploy items of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that the deploy item is only started after the current job of the execution has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core.Duration", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.OnDeleteConfig", "github.com/gardener/landscaper/apis/core.RolloutPolicy", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -4606,6 +4787,56 @@ func schema_gardener_landscaper_apis_core_DiNamePair(ref common.ReferenceCallbac
 	}
 }
 
+func schema_gardener_landscaper_apis_core_DryRunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DryRunStatus describes the result of a dry-run of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the installation for which the dry-run was computed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime is the time when the dry-run was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the planned changes of the deploy items of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemDryRunResult"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the error that prevented the dry-run from being computed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration", "lastRunTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DeployItemDryRunResult", "github.com/gardener/landscaper/apis/core.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4906,12 +5137,32 @@ func schema_gardener_landscaper_apis_core_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts describes the progress of the rollouts of the deploy items.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval describes the approval of the deploy items that require approval in the current job.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ApprovalStatus"),
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.ApprovalStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DeployItemCache", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.RolloutStatus", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5310,6 +5561,66 @@ func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceC
 	}
 }
 
+func schema_gardener_landscaper_apis_core_InstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevision describes a succeeded revision of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the revision. It is increased for every new revision.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the installation that succeeded.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"componentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersion is the resolved version of the component of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"succeededTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededTime is the time when the revision succeeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"dataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataRef is the name of the secret in the namespace of the installation that contains the spec of the installation and the rendered deploy items of the revision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revision", "generation", "succeededTime", "dataRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5397,6 +5708,20 @@ func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the maximal number of succeeded revisions that are kept for a root installation. The revisions can be used to roll back the installation. If not set, a default of 5 is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops the processing of the installation and of all installations, executions and deploy items below it. The processing is continued when the flag is removed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"blueprint"},
 			},
@@ -5513,12 +5838,58 @@ func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
 						},
 					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun contains the result of the last dry-run of the installation. A dry-run is triggered by the operation annotation \"landscaper.gardener.cloud/operation: dry-run\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.DryRunStatus"),
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions lists the succeeded revisions of a root installation, the latest revision last. The number of revisions is bounded by the revision history limit of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.InstallationRevision"),
+									},
+								},
+							},
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback describes the last rollback of the installation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.RollbackStatus"),
+						},
+					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts describes the progress of the rollouts of the subinstallations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval describes the approval of the current job of an installation that requires approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ApprovalStatus"),
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.ApprovalStatus", "github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.DryRunStatus", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.InstallationRevision", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.RollbackStatus", "github.com/gardener/landscaper/apis/core.RolloutStatus", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5606,12 +5977,25 @@ func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.Refere
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the subinstallation to a rollout, which starts the subinstallations of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that every job of the subinstallation waits until it has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.RolloutPolicy"},
 	}
 }
 
@@ -5643,26 +6027,64 @@ func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinitio
 	}
 }
 
-func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_JSONPatchOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Description: "JSONPatchOperation describes a JSON patch operation (RFC 6902).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"op": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the patch operation, i.e. one of \"add\", \"remove\" or \"replace\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSON pointer to the changed value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the new value.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"op", "path"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Name is the name of the configmap",
 							Default:     "",
@@ -6055,6 +6477,193 @@ func schema_gardener_landscaper_apis_core_ResourceReference(ref common.Reference
 	}
 }
 
+func schema_gardener_landscaper_apis_core_RollbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackStatus describes a rollback of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision to which the installation was rolled back.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"fromGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromGeneration is the generation of the installation before the rollback.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the installation that was created by the rollback.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the rollback.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"automatic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Automatic is true if the rollback was triggered by the onFailure policy of the installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the error that prevented the rollback.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+				},
+				Required: []string{"revision", "fromGeneration", "generation", "time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RolloutPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutPolicy defines how a group of deploy items or subinstallations is rolled out in waves. All deploy items of an execution, or all subinstallations of an installation, with the same rollout group form one rollout. The items of a rollout are ordered by name and divided into waves. A wave is only started if all items of the previous wave have finished. The policy of a rollout is taken from its first item, the policies of all items of a rollout should be equal.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the name of the rollout to which the item belongs.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel is the maximal number of items of a wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxParallelPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelPercentage is the maximal number of items of a wave as a percentage of the number of items of the rollout. The number is rounded up, so that a wave contains at least one item. If both maxParallel and maxParallelPercentage are set, the smaller number applies. If none of them is set, all items are rolled out in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseBetweenWaves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenWaves is the time to wait after a wave has finished before the next wave is started.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+					"failureBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureBudget is the number of items of the rollout that may fail. Failed items within the budget do not prevent the start of further waves. If more items fail, no further items of the rollout are started. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"group"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus describes the progress of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"waves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Waves is the number of waves of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentWave is the number of the wave that is currently rolled out, starting with 1.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Succeeded is the number of succeeded items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of failed items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextWaveTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextWaveTime is the time when the current wave is started, if it waits for the pause between waves.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"stopped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stopped is true if the failure budget of the rollout is exceeded, so that no further items are started.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "total", "waves", "currentWave", "succeeded", "failed"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6343,12 +6952,25 @@ func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the subinstallation to a rollout, which starts the subinstallations of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that every job of the subinstallation waits until it has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.RolloutPolicy"},
 	}
 }
 
@@ -7429,6 +8051,44 @@ func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback)
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_ApprovalStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApprovalStatus describes the approval of the current job of an execution or installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the job that was approved.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the identity of the approver, as specified in the approved-by annotation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalTime is the time when the approval was recorded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"jobID", "approvedBy", "approvalTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7448,6 +8108,13 @@ func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
 						},
 					},
+					"onFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailure defines the behavior if a root installation fails. If set to \"rollback\", the installation is automatically rolled back to the latest succeeded revision whose spec differs from the failed spec. An installation that fails after a rollback is not rolled back again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8778,48 +9445,106 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCa
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemDryRunResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "DeployItemDryRunResult describes the planned change of a deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the deploy item as defined in the deploy executions of the blueprint.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Action describes how the deploy item would be changed.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"deployItemRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "DeployItemReference is the reference to the existing deploy item. It is not set if the deploy item would be created.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
-					"items": {
+					"specification": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Specification is the rendered deploy item template. It is not set if the deploy item would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+						},
+					},
+					"configDiff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigDiff contains the JSON patch operations (RFC 6902) that transform the configuration of the existing deploy item into the rendered configuration.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONPatchOperation"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "action"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONPatchOperation", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemList contains a list of DeployItems",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
@@ -9102,12 +9827,25 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the deploy item to a rollout, which starts the deploy items of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that the deploy item is only started after the current job of the execution has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -9174,6 +9912,56 @@ func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallbac
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_DryRunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DryRunStatus describes the result of a dry-run of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the installation for which the dry-run was computed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime is the time when the dry-run was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the planned changes of the deploy items of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemDryRunResult"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the error that prevented the dry-run from being computed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration", "lastRunTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemDryRunResult", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9474,11 +10262,31 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts describes the progress of the rollouts of the deploy items.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval describes the approval of the deploy items that require approval in the current job.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9877,6 +10685,66 @@ func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceC
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevision describes a succeeded revision of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the revision. It is increased for every new revision.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the installation that succeeded.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"componentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersion is the resolved version of the component of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"succeededTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededTime is the time when the revision succeeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"dataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataRef is the name of the secret in the namespace of the installation that contains the spec of the installation and the rendered deploy items of the revision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revision", "generation", "succeededTime", "dataRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9964,6 +10832,20 @@ func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the maximal number of succeeded revisions that are kept for a root installation. The revisions can be used to roll back the installation. If not set, a default of 5 is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspended": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspended stops the processing of the installation and of all installations, executions and deploy items below it. The processing is continued when the flag is removed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"blueprint"},
 			},
@@ -10080,12 +10962,58 @@ func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
 						},
 					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun contains the result of the last dry-run of the installation. A dry-run is triggered by the operation annotation \"landscaper.gardener.cloud/operation: dry-run\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DryRunStatus"),
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions lists the succeeded revisions of a root installation, the latest revision last. The number of revisions is bounded by the revision history limit of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision"),
+									},
+								},
+							},
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback describes the last rollback of the installation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus"),
+						},
+					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts describes the progress of the rollouts of the subinstallations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval describes the approval of the current job of an installation that requires approval.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalStatus"),
+						},
+					},
 				},
 				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ApprovalStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.DryRunStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.RollbackStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -10173,12 +11101,25 @@ func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.Refere
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the subinstallation to a rollout, which starts the subinstallations of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that every job of the subinstallation waits until it has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy"},
 	}
 }
 
@@ -10210,6 +11151,44 @@ func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinitio
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_JSONPatchOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONPatchOperation describes a JSON patch operation (RFC 6902).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"op": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the patch operation, i.e. one of \"add\", \"remove\" or \"replace\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSON pointer to the changed value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the new value.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"op", "path"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10622,26 +11601,213 @@ func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.Reference
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RollbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "RollbackStatus describes a rollback of an installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
+							Description: "Revision is the revision to which the installation was rolled back.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"fromGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromGeneration is the generation of the installation before the rollback.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the installation that was created by the rollback.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time of the rollback.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"automatic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Automatic is true if the rollback was triggered by the onFailure policy of the installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the error that prevented the rollback.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+				},
+				Required: []string{"revision", "fromGeneration", "generation", "time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutPolicy defines how a group of deploy items or subinstallations is rolled out in waves. All deploy items of an execution, or all subinstallations of an installation, with the same rollout group form one rollout. The items of a rollout are ordered by name and divided into waves. A wave is only started if all items of the previous wave have finished. The policy of a rollout is taken from its first item, the policies of all items of a rollout should be equal.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the name of the rollout to which the item belongs.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel is the maximal number of items of a wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxParallelPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelPercentage is the maximal number of items of a wave as a percentage of the number of items of the rollout. The number is rounded up, so that a wave contains at least one item. If both maxParallel and maxParallelPercentage are set, the smaller number applies. If none of them is set, all items are rolled out in one wave.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseBetweenWaves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenWaves is the time to wait after a wave has finished before the next wave is started.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"failureBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureBudget is the number of items of the rollout that may fail. Failed items within the budget do not prevent the start of further waves. If more items fail, no further items of the rollout are started. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"group"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStatus describes the progress of a rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the name of the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"waves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Waves is the number of waves of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentWave is the number of the wave that is currently rolled out, starting with 1.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Succeeded is the number of succeeded items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of failed items of the rollout.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextWaveTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextWaveTime is the time when the current wave is started, if it waits for the pause between waves.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"stopped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stopped is true if the failure budget of the rollout is exceeded, so that no further items are started.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "total", "waves", "currentWave", "succeeded", "failed"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
 							},
 						},
 					},
@@ -10910,12 +12076,25 @@ func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout assigns the subinstallation to a rollout, which starts the subinstallations of the rollout in waves.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy"),
+						},
+					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that every job of the subinstallation waits until it has been approved with the approved-by annotation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutPolicy"},
 	}
 }
 
@@ -12087,12 +13266,39 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
 						},
 					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod configures defaults and restrictions for the pods that execute the deploy items.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PodConfiguration"),
+						},
+					},
+					"logs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logs configures the persistence of the logs of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.LogsConfiguration"),
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job configures the container deployer to run the operations of deploy items as kubernetes jobs instead of bare pods.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.JobConfiguration"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State configures the backend that stores the state of deploy items.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.StateConfiguration"),
+						},
+					},
 				},
 				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration", "github.com/gardener/landscaper/apis/deployer/container.JobConfiguration", "github.com/gardener/landscaper/apis/deployer/container.LogsConfiguration", "github.com/gardener/landscaper/apis/deployer/container.PodConfiguration", "github.com/gardener/landscaper/apis/deployer/container.StateConfiguration"},
 	}
 }
 
@@ -12313,105 +13519,413 @@ func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.Refer
 	}
 }
 
-func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_JobConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Description: "JobConfiguration configures the jobs that execute the operations of deploy items. The active deadline of a job is derived from the remaining time until the timeout of the deploy item.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BackoffLimit is the number of retries before a job is considered as failed. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"lastRun": {
+					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastRun is the time when the pod was executed the last time.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TTLSecondsAfterFinished is the duration after which a finished job is deleted by kubernetes. If not set, a finished job is deleted by the container deployer as soon as its status has been processed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"lastSuccessfulJobID": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_LogsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogsConfiguration configures the persistence of the logs of the main container. The wait container stores the tail of the logs of every run in secrets in the host cluster, which are referenced in the pod status of the deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
-							Type:        []string{"string"},
+							Description: "Disable disables the persistence of the logs.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"containerStatus": {
+					"tailLines": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Description: "TailLines is the number of lines from the end of the logs that are persisted. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"initContainerStatus": {
+					"limitBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerStatus contains the status of the init container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Description: "LimitBytes is the maximum size of the persisted logs in bytes. Logs that exceed the size of a secret are split into several secrets. Defaults to 262144 (256Ki).",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"waitContainerStatus": {
+					"retention": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitContainerStatus contains the status of the wait container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Description: "Retention is the number of runs of a deploy item whose logs are kept. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ObjectStoreStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
+				Description: "ObjectStoreStateBackend configures a state backend that stores the state in a s3 compatible object store.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Endpoint is the url of the object store. If not set, AWS S3 is used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Region is the region of the bucket. Defaults to us-east-1.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"image": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Description: "Bucket is the name of the bucket that stores the state.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"command": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Prefix is prepended to the keys of the state objects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usePathStyle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsePathStyle addresses the bucket in the path of the url instead of the host name, which is required by most s3 compatible object stores.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretRef references a secret in the host namespace that contains the access key in \"accessKeyID\" and the secret key in \"secretAccessKey\".",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"bucket", "credentialsSecretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_PVCStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PVCStateBackend configures a state backend that stores the state on a persistent volume. The volume is mounted into the init and wait containers of all pods, so it has to support the access mode ReadWriteMany if the pods run on different nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the persistent volume claim in the host namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_PodConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodConfiguration configures defaults and restrictions for the pods that execute the deploy items. The defaults are only used if a deploy item does not define the respective value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"defaultResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultResources are the compute resources of the main container.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"maxResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResources are the upper bounds for the resource requests and limits of the main container.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"defaultNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultNodeSelector is the node selector of the pods.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedNodeSelectorKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedNodeSelectorKeys restricts the keys that deploy items can use in their node selector. If empty, all keys are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultTolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultTolerations are the tolerations of the pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"allowedTolerationKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedTolerationKeys restricts the keys that deploy items can tolerate. If empty, all keys are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultSecurityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultSecurityContext is the security context of the main container. It is merged onto a security context that complies with the restricted pod security standard, so that unset fields keep their restricted values.",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"allowPrivileged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivileged allows deploy items to run the main container privileged, as root, or with privilege escalation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowedCapabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCapabilities restricts the capabilities that deploy items can add to the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedVolumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedVolumeTypes restricts the types of the additional volumes of deploy items, e.g. \"configMap\", \"secret\" or \"emptyDir\". Defaults to configMap, secret, emptyDir, projected and downwardAPI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName is the name of the created pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobName is the name of the job that created the pod, if the container deployer runs the operations of deploy items as jobs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRun is the time when the pod was executed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSuccessfulJobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"initContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerStatus contains the status of the init container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"waitContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitContainerStatus contains the status of the wait container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"logSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSecrets reference the secrets in the host cluster that contain the tail of the logs of the main container. The logs are split into several secrets in the given order if they exceed the size of a secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 					"args": {
@@ -12429,6 +13943,90 @@ func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.
 							},
 						},
 					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env defines additional environment variables of the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources defines the compute resources of the main container. Defaults to the default resources of the container deployer configuration. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext defines the security context of the main container. Its fields overwrite the fields of the default security context of the container deployer configuration, unset fields keep their default values. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector defines the node selector of the pod. Defaults to the default node selector of the container deployer configuration.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations defines the tolerations of the pod. Defaults to the default tolerations of the container deployer configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes defines additional volumes of the pod that can be mounted into the main container. The allowed volume types are restricted by the container deployer configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts defines additional volume mounts of the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
 					"importValues": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ImportValues contains the import values for the container.",
@@ -12436,6 +14034,26 @@ func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.
 							Format:      "byte",
 						},
 					},
+					"exportSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchema is a jsonschema that the exports and sensitive exports of the container have to match. The exports of a successful run are validated before they are synced to the deploy item, and the deploy item fails if they do not match the schema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"exportSchemaLocalTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchemaLocalTypes defines jsonschemas that can be referenced in the export schema with \"local://<name>\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+									},
+								},
+							},
+						},
+					},
 					"blueprint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Blueprint is the resolved reference to the Blueprint definition",
@@ -12472,7 +14090,7 @@ func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -12520,6 +14138,69 @@ func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.Referen
 	}
 }
 
+func schema_landscaper_apis_deployer_container_StateConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateConfiguration configures the backend that stores the state of deploy items. The state directory of a deploy item is archived by the wait container after every run and restored by the init container before the next run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend is the type of the state backend. One of \"secret\", \"pvc\" or \"objectStore\". Defaults to \"secret\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pvc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PVC configures the pvc state backend.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PVCStateBackend"),
+						},
+					},
+					"objectStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectStore configures the object store state backend.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ObjectStoreStateBackend"),
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption configures the encryption of the state. If not set, the state is stored unencrypted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.StateEncryption"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container.ObjectStoreStateBackend", "github.com/gardener/landscaper/apis/deployer/container.PVCStateBackend", "github.com/gardener/landscaper/apis/deployer/container.StateEncryption"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_StateEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateEncryption configures the encryption of the state with AES-GCM.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeySecretRef references the key of a secret in the host namespace that contains the encryption key. The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+				},
+				Required: []string{"keySecretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
 func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12623,12 +14304,39 @@ func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
 						},
 					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod configures defaults and restrictions for the pods that execute the deploy items.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodConfiguration"),
+						},
+					},
+					"logs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logs configures the persistence of the logs of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogsConfiguration"),
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job configures the container deployer to run the operations of deploy items as kubernetes jobs instead of bare pods.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State configures the backend that stores the state of deploy items.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration"),
+						},
+					},
 				},
 				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogsConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodConfiguration", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration"},
 	}
 }
 
@@ -12849,6 +14557,293 @@ func schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref common.Referen
 	}
 }
 
+func schema_apis_deployer_container_v1alpha1_JobConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobConfiguration configures the jobs that execute the operations of deploy items. The active deadline of a job is derived from the remaining time until the timeout of the deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackoffLimit is the number of retries before a job is considered as failed. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the duration after which a finished job is deleted by kubernetes. If not set, a finished job is deleted by the container deployer as soon as its status has been processed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_LogsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogsConfiguration configures the persistence of the logs of the main container. The wait container stores the tail of the logs of every run in secrets in the host cluster, which are referenced in the pod status of the deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable disables the persistence of the logs.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tailLines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TailLines is the number of lines from the end of the logs that are persisted. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"limitBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LimitBytes is the maximum size of the persisted logs in bytes. Logs that exceed the size of a secret are split into several secrets. Defaults to 262144 (256Ki).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention is the number of runs of a deploy item whose logs are kept. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_ObjectStoreStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectStoreStateBackend configures a state backend that stores the state in a s3 compatible object store.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the url of the object store. If not set, AWS S3 is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the bucket. Defaults to us-east-1.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket is the name of the bucket that stores the state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is prepended to the keys of the state objects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usePathStyle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsePathStyle addresses the bucket in the path of the url instead of the host name, which is required by most s3 compatible object stores.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretRef references a secret in the host namespace that contains the access key in \"accessKeyID\" and the secret key in \"secretAccessKey\".",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"bucket", "credentialsSecretRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_PVCStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PVCStateBackend configures a state backend that stores the state on a persistent volume. The volume is mounted into the init and wait containers of all pods, so it has to support the access mode ReadWriteMany if the pods run on different nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the persistent volume claim in the host namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_PodConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodConfiguration configures defaults and restrictions for the pods that execute the deploy items. The defaults are only used if a deploy item does not define the respective value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"defaultResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultResources are the compute resources of the main container.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"maxResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResources are the upper bounds for the resource requests and limits of the main container.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"defaultNodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultNodeSelector is the node selector of the pods.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedNodeSelectorKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedNodeSelectorKeys restricts the keys that deploy items can use in their node selector. If empty, all keys are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultTolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultTolerations are the tolerations of the pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"allowedTolerationKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedTolerationKeys restricts the keys that deploy items can tolerate. If empty, all keys are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultSecurityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultSecurityContext is the security context of the main container. It is merged onto a security context that complies with the restricted pod security standard, so that unset fields keep their restricted values.",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"allowPrivileged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivileged allows deploy items to run the main container privileged, as root, or with privilege escalation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowedCapabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCapabilities restricts the capabilities that deploy items can add to the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"allowedVolumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedVolumeTypes restricts the types of the additional volumes of deploy items, e.g. \"configMap\", \"secret\" or \"emptyDir\". Defaults to configMap, secret, emptyDir, projected and downwardAPI.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12858,8 +14853,15 @@ func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
-							Default:     "",
+							Description: "PodName is the name of the created pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobName is the name of the job that created the pod, if the container deployer runs the operations of deploy items as jobs.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -12898,12 +14900,26 @@ func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallb
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
+					"logSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSecrets reference the secrets in the host cluster that contain the tail of the logs of the main container. The logs are split into several secrets in the given order if they exceed the size of a secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -12965,6 +14981,90 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 							},
 						},
 					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env defines additional environment variables of the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources defines the compute resources of the main container. Defaults to the default resources of the container deployer configuration. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext defines the security context of the main container. Its fields overwrite the fields of the default security context of the container deployer configuration, unset fields keep their default values. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector defines the node selector of the pod. Defaults to the default node selector of the container deployer configuration.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations defines the tolerations of the pod. Defaults to the default tolerations of the container deployer configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes defines additional volumes of the pod that can be mounted into the main container. The allowed volume types are restricted by the container deployer configuration.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts defines additional volume mounts of the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
 					"importValues": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ImportValues contains the import values for the container.",
@@ -12972,6 +15072,26 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 							Format:      "byte",
 						},
 					},
+					"exportSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchema is a jsonschema that the exports and sensitive exports of the container have to match. The exports of a successful run are validated before they are synced to the deploy item, and the deploy item fails if they do not match the schema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"exportSchemaLocalTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportSchemaLocalTypes defines jsonschemas that can be referenced in the export schema with \"local://<name>\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+									},
+								},
+							},
+						},
+					},
 					"blueprint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Blueprint is the resolved reference to the Blueprint definition",
@@ -13008,7 +15128,7 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -13056,6 +15176,69 @@ func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.Reference
 	}
 }
 
+func schema_apis_deployer_container_v1alpha1_StateConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateConfiguration configures the backend that stores the state of deploy items. The state directory of a deploy item is archived by the wait container after every run and restored by the init container before the next run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend is the type of the state backend. One of \"secret\", \"pvc\" or \"objectStore\". Defaults to \"secret\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pvc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PVC configures the pvc state backend.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PVCStateBackend"),
+						},
+					},
+					"objectStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectStore configures the object store state backend.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ObjectStoreStateBackend"),
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption configures the encryption of the state. If not set, the state is stored unencrypted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ObjectStoreStateBackend", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PVCStateBackend", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_StateEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateEncryption configures the encryption of the state with AES-GCM.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeySecretRef references the key of a secret in the host namespace that contains the encryption key. The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+				},
+				Required: []string{"keySecretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
 func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13165,11 +15348,109 @@ func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification configures the verification of the provenance of the chart. It is supported for charts that are referenced by Ref, HelmChartRepo or Archive.Remote.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ChartVerification"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.ChartVerification", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ChartCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartCacheConfiguration configures the cache of helm charts on the filesystem. The path can be located on a persistent volume that is shared between the replicas of the deployer, so that cached charts survive restarts and are available to new replicas. The garbage collection determines the size of the cache from the files in the directory, so that the size limit applies to the files of all replicas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the root directory of the cache.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the maximum size of the files in the cache. If the value is empty or 0, the cache is not limited and no garbage collection happens. See the kubernetes quantity docs for a detailed description of the format https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gcHighThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCHighThreshold defines the percent of the size which triggers the garbage collection. Defaults to 0.85.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"gcLowThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCLowThreshold defines the percent of the size to which the garbage collection attempts to free. Defaults to 0.80.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ChartVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartVerification configures the verification of the provenance file of a helm chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyringSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyringSecretRef references a secret in the namespace of the deploy item that contains the public keys which are trusted to sign the chart. If no key is specified, the key \"keyring\" is used. If not set, the keyring of the deployer configuration is used. It must not be set if the deployer enforces the verification.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ChartVerificationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartVerificationConfiguration configures the verification of the provenance of helm charts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enforcementPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementPolicy defines whether the provenance of helm charts must be verified.\n\nPossible enum values:\n - `\"Disabled\"` disables the verification, even for deploy items that configure a verification.\n - `\"DoNotEnforce\"` verifies only the charts of deploy items that configure a verification. [DEFAULT]\n - `\"Enforce\"` enforces the verification of all charts that are referenced by an oci reference, a helm chart repository, or a remote archive. Deploy items that do not configure a verification are verified with the keyring of the deployer configuration. Charts from other sources, which cannot be verified, are rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Disabled", "DoNotEnforce", "Enforce"},
+						},
+					},
+					"keyringFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyringFile is the path to a file with the public keys that are trusted to sign helm charts. It is used for all deploy items that do not reference a keyring.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -13241,11 +15522,23 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
 						},
 					},
+					"chartCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartCache configures a cache of helm charts on the filesystem in addition to the in-memory cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ChartCacheConfiguration"),
+						},
+					},
+					"chartVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartVerification configures the verification of the provenance of helm charts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ChartVerificationConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.ChartCacheConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.ChartVerificationConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
 	}
 }
 
@@ -13266,8 +15559,28 @@ func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallbac
 				Required: []string{"CommonControllerConfig"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_DiffConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiffConfiguration configures the comparison of a deployed helm release with the newly rendered chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"diffOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiffOnly specifies that the release is neither installed nor upgraded. Only the diff is computed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -13416,6 +15729,13 @@ func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common
 							},
 						},
 					},
+					"runTests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunTests specifies that the test hooks of the chart are run after a successful install or upgrade. The deploy item fails if a test fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13478,6 +15798,108 @@ func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.
 	}
 }
 
+func schema_landscaper_apis_deployer_helm_ImageRewrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageRewrite rewrites the container images with a given name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the image that is rewritten, without tag or digest.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewName replaces the name of the image, e.g. to use another registry.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewTag replaces the tag of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest replaces the tag of the image with a digest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_PostRendererConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostRendererConfiguration configures kustomize-style modifications of the rendered manifests of a chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are strategic merge patches or JSON6902 patches that are applied to the rendered resources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"),
+									},
+								},
+							},
+						},
+					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Images rewrites the names, tags and digests of container images.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.ImageRewrite"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the metadata of all rendered resources. Selectors and pod templates are not changed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ImageRewrite", "github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"},
+	}
+}
+
 func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13513,6 +15935,12 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply update strategy. Only relevant if the update strategy is \"serverSideApply\" and HelmDeployment is false.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"chart": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Chart defines helm chart to be templated and applied.",
@@ -13551,6 +15979,20 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Format:      "byte",
 						},
 					},
+					"valuesFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesFrom lists sources from which values are read when the chart is deployed. The values of the sources are merged in the given order on top of the inline values, so that values of later sources overwrite values of earlier sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.ValuesFromSource"),
+									},
+								},
+							},
+						},
+					},
 					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
@@ -13577,6 +16019,12 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic comparison of the deployed resources with their desired state.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
 					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
@@ -13590,6 +16038,18 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
 						},
 					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff configures a preview of the changes of a helm upgrade. The manifests of the deployed release are compared with the newly rendered chart, and the differences are summarized in the provider status. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.DiffConfiguration"),
+						},
+					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer configures patches that are applied to the rendered manifests of the chart before they are deployed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
@@ -13604,97 +16064,282 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							},
 						},
 					},
-					"deletionGroupsDuringUpdate": {
+					"deletionGroupsDuringUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.DiffConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.PostRendererConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.ValuesFromSource", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderStatus is the helm provider specific status",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff contains the differences between the deployed release and the rendered chart of the last reconciliation. Only set if a diff is configured.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ReleaseDiff"),
+						},
+					},
+					"testedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestedRevision is the revision of the release whose tests have succeeded last. The tests are not run again for this revision or for upgrades of it that do not change the release.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ReleaseDiff", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_ReleaseDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseDiff describes the differences between the manifests of a deployed helm release and a newly rendered chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the deployed release. It is 0 if the release is not yet installed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is a short summary of the differences.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the resources that are added, changed or removed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.ResourceDiff"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"revision", "summary"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ResourceDiff"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ResourceDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ResourceDiff describes the change of a resource in a release diff.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resource is the added, changed or removed resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
-					"apiVersion": {
+					"change": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Change is the kind of change.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedResources": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "Fields are the paths of the changed fields. Only set for changed resources.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"resource", "change"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ValuesFromObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Description: "ValuesFromObjectReference references a key of a secret or config map.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
+							Description: "Name is the name of the secret or config map.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the secret or config map. It is required if TargetName is set, and must not be set otherwise, because objects in the landscaper cluster are always read from the namespace of the deploy item.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the data entry that contains the values.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetName is the name of a target in the namespace of the deploy item. If set, the secret or config map is read from the cluster of this target instead of the landscaper cluster. The value typically comes from a target import parameter, for example: {{.imports.myCluster.metadata.name}}.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "key"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ValuesFromResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Description: "ValuesFromResourceReference references a resource of a component version that contains helm values. The resource must be of type \"landscaper.gardener.cloud/helm-values\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"ref": {
@@ -13711,7 +16356,7 @@ func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.Refere
 					},
 					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Description: "ResourceName is the name of the resource as defined by the component descriptor.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -13726,6 +16371,46 @@ func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.Refere
 	}
 }
 
+func schema_landscaper_apis_deployer_helm_ValuesFromSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValuesFromSource defines a source of helm values. Exactly one of SecretRef, ConfigMapRef and ResourceRef must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef reads the values from a key of a secret.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ValuesFromObjectReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef reads the values from a key of a config map.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ValuesFromObjectReference"),
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef reads the values from a resource of a component version.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ValuesFromResourceReference"),
+						},
+					},
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath is the path in the helm values at which the content of the source is inserted, e.g. \".database.password\". If not set, the content must be a yaml or json object, which is merged into the top level of the helm values.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ValuesFromObjectReference", "github.com/gardener/landscaper/apis/deployer/helm.ValuesFromResourceReference"},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13835,11 +16520,109 @@ func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification configures the verification of the provenance of the chart. It is supported for charts that are referenced by Ref, HelmChartRepo or Archive.Remote.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerification"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerification", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ChartCacheConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartCacheConfiguration configures the cache of helm charts on the filesystem. The path can be located on a persistent volume that is shared between the replicas of the deployer, so that cached charts survive restarts and are available to new replicas. The garbage collection determines the size of the cache from the files in the directory, so that the size limit applies to the files of all replicas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the root directory of the cache.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the maximum size of the files in the cache. If the value is empty or 0, the cache is not limited and no garbage collection happens. See the kubernetes quantity docs for a detailed description of the format https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gcHighThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCHighThreshold defines the percent of the size which triggers the garbage collection. Defaults to 0.85.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"gcLowThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCLowThreshold defines the percent of the size to which the garbage collection attempts to free. Defaults to 0.80.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ChartVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartVerification configures the verification of the provenance file of a helm chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyringSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyringSecretRef references a secret in the namespace of the deploy item that contains the public keys which are trusted to sign the chart. If no key is specified, the key \"keyring\" is used. If not set, the keyring of the deployer configuration is used. It must not be set if the deployer enforces the verification.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ChartVerificationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChartVerificationConfiguration configures the verification of the provenance of helm charts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enforcementPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementPolicy defines whether the provenance of helm charts must be verified.\n\nPossible enum values:\n - `\"Disabled\"` disables the verification, even for deploy items that configure a verification.\n - `\"DoNotEnforce\"` verifies only the charts of deploy items that configure a verification. [DEFAULT]\n - `\"Enforce\"` enforces the verification of all charts that are referenced by an oci reference, a helm chart repository, or a remote archive. Deploy items that do not configure a verification are verified with the keyring of the deployer configuration. Charts from other sources, which cannot be verified, are rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Disabled", "DoNotEnforce", "Enforce"},
+						},
+					},
+					"keyringFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyringFile is the path to a file with the public keys that are trusted to sign helm charts. It is used for all deploy items that do not reference a keyring.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -13911,11 +16694,23 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
 						},
 					},
+					"chartCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartCache configures a cache of helm charts on the filesystem in addition to the in-memory cache.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartCacheConfiguration"),
+						},
+					},
+					"chartVerification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChartVerification configures the verification of the provenance of helm charts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerificationConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartCacheConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ChartVerificationConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
 	}
 }
 
@@ -13941,6 +16736,26 @@ func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback)
 	}
 }
 
+func schema_apis_deployer_helm_v1alpha1_DiffConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiffConfiguration configures the comparison of a deployed helm release with the newly rendered chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"diffOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiffOnly specifies that the release is neither installed nor upgraded. Only the diff is computed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14086,65 +16901,174 @@ func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.R
 							},
 						},
 					},
+					"runTests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunTests specifies that the test hooks of the chart are run after a successful install or upgrade. The deploy item fails if a test fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"atomic": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ImageRewrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUpgradeConfiguration defines settings for a helm upgrade operation.",
+				Description: "ImageRewrite rewrites the container images with a given name.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"atomic": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Name is the name of the image that is rewritten, without tag or digest.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"force": {
+					"newName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "NewName replaces the name of the image, e.g. to use another registry.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"timeout": {
+					"newTag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "NewTag replaces the tag of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest replaces the tag of the image with a digest.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_PostRendererConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Description: "PostRendererConfiguration configures kustomize-style modifications of the rendered manifests of a chart.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"timeout": {
+					"patches": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Patches are strategic merge patches or JSON6902 patches that are applied to the rendered resources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"),
+									},
+								},
+							},
+						},
+					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Images rewrites the names, tags and digests of container images.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ImageRewrite"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the metadata of all rendered resources. Selectors and pod templates are not changed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ImageRewrite", "github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"},
 	}
 }
 
@@ -14176,6 +17100,12 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply update strategy. Only relevant if the update strategy is \"serverSideApply\" and HelmDeployment is false.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -14221,6 +17151,20 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"valuesFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesFrom lists sources from which values are read when the chart is deployed. The values of the sources are merged in the given order on top of the inline values, so that values of later sources overwrite values of earlier sources.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromSource"),
+									},
+								},
+							},
+						},
+					},
 					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
@@ -14247,6 +17191,12 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic comparison of the deployed resources with their desired state.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
 					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
@@ -14256,115 +17206,330 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 					},
 					"helmDeploymentConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff configures a preview of the changes of a helm upgrade. The manifests of the deployed release are compared with the newly rendered chart, and the differences are summarized in the provider status. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.DiffConfiguration"),
+						},
+					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer configures patches that are applied to the rendered manifests of the chart before they are deployed.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration"),
+						},
+					},
+					"deletionGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"deletionGroupsDuringUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.DiffConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.PostRendererConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromSource", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderStatus is the helm provider specific status",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff contains the differences between the deployed release and the rendered chart of the last reconciliation. Only set if a diff is configured.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseDiff"),
+						},
+					},
+					"testedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestedRevision is the revision of the release whose tests have succeeded last. The tests are not run again for this revision or for upgrades of it that do not change the release.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseDiff", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ReleaseDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseDiff describes the differences between the manifests of a deployed helm release and a newly rendered chart.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the deployed release. It is 0 if the release is not yet installed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is a short summary of the differences.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the resources that are added, changed or removed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceDiff"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"revision", "summary"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceDiff"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"deletionGroups": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
-					"deletionGroupsDuringUpdate": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
-									},
-								},
-							},
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ResourceDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ResourceDiff describes the change of a resource in a release diff.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resource is the added, changed or removed resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
-					"apiVersion": {
+					"change": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Change is the kind of change.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"managedResources": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "Fields are the paths of the changed fields. Only set for changed resources.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"resource", "change"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ValuesFromObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValuesFromObjectReference references a key of a secret or config map.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
+							Description: "Name is the name of the secret or config map.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the secret or config map. It is required if TargetName is set, and must not be set otherwise, because objects in the landscaper cluster are always read from the namespace of the deploy item.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the data entry that contains the values.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetName is the name of a target in the namespace of the deploy item. If set, the secret or config map is read from the cluster of this target instead of the landscaper cluster. The value typically comes from a target import parameter, for example: {{.imports.myCluster.metadata.name}}.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "key"},
 			},
 		},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ValuesFromResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Description: "ValuesFromResourceReference references a resource of a component version that contains helm values. The resource must be of type \"landscaper.gardener.cloud/helm-values\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"ref": {
@@ -14381,7 +17546,7 @@ func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.Referenc
 					},
 					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Description: "ResourceName is the name of the resource as defined by the component descriptor.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -14396,21 +17561,43 @@ func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.Referenc
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ValuesFromSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ValuesFromSource defines a source of helm values. Exactly one of SecretRef, ConfigMapRef and ResourceRef must be set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SecretRef reads the values from a key of a secret.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromObjectReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef reads the values from a key of a config map.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromObjectReference"),
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef reads the values from a resource of a component version.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromResourceReference"),
+						},
+					},
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath is the path in the helm values at which the content of the source is inserted, e.g. \".database.password\". If not set, the content must be a yaml or json object, which is merged into the top level of the helm values.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromObjectReference", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ValuesFromResourceReference"},
 	}
 }
 
@@ -14442,6 +17629,12 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 							Format:      "",
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client that reads the kustomization resources of component versions.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -14480,7 +17673,7 @@ func schema_landscaper_apis_deployer_manifest_Configuration(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest.Controller", "github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest.HPAConfiguration"},
 	}
 }
 
@@ -14546,6 +17739,107 @@ func schema_landscaper_apis_deployer_manifest_HPAConfiguration(ref common.Refere
 	}
 }
 
+func schema_landscaper_apis_deployer_manifest_Kustomization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Kustomization defines a kustomization base and overlay patches that are built by the deployer. The files of the base are read from a resource of a component version and from inline files. Inline files overwrite files of the resource with the same path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef references a resource of a component version that contains the files of the base as tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.KustomizationResourceReference"),
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files contains files of the base by their path.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory of the base which contains the kustomization file. Defaults to the root directory.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are strategic merge or json patches that are applied to the resources of the base.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"),
+									},
+								},
+							},
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the manage policy of the resulting resources. Defaults to \"manage\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/manifest.KustomizationResourceReference", "github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"},
+	}
+}
+
+func schema_landscaper_apis_deployer_manifest_KustomizationResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KustomizationResourceReference references a resource of a component version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the resource in the component version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+	}
+}
+
 func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14575,6 +17869,12 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply update strategy. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"readiness": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -14608,6 +17908,26 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic comparison of the deployed resources with their desired state.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
+					"applyGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplyGroups defines the order in which objects are applied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ApplyGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -14636,11 +17956,23 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							},
 						},
 					},
+					"pruneProtection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PruneProtection restricts the deletion of resources that have been removed from the manifests.\nIf it is not set, only the default protected resources are protected.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.PruneProtection"),
+						},
+					},
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize defines a kustomization that is built by the deployer. The resulting resources are applied together with the manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest.Kustomization"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/manifest.Kustomization", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ApplyGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.PruneProtection", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -14747,6 +18079,12 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client that reads the kustomization resources of component versions.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -14785,7 +18123,7 @@ func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.HPAConfiguration"},
 	}
 }
 
@@ -15007,9 +18345,15 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 					},
 					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client that reads the kustomization resources of component versions.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
 						},
 					},
 					"targetSelector": {
@@ -15050,7 +18394,7 @@ func schema_apis_deployer_manifest_v1alpha2_Configuration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Controller", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.HPAConfiguration"},
 	}
 }
 
@@ -15116,6 +18460,107 @@ func schema_apis_deployer_manifest_v1alpha2_HPAConfiguration(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_manifest_v1alpha2_Kustomization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Kustomization defines a kustomization base and overlay patches that are built by the deployer. The files of the base are read from a resource of a component version and from inline files. Inline files overwrite files of the resource with the same path.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef references a resource of a component version that contains the files of the base as tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizationResourceReference"),
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Files contains files of the base by their path.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory of the base which contains the kustomization file. Defaults to the root directory.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches are strategic merge or json patches that are applied to the resources of the base.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"),
+									},
+								},
+							},
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the manage policy of the resulting resources. Defaults to \"manage\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.KustomizationResourceReference", "github.com/gardener/landscaper/apis/deployer/utils/kustomize.Patch"},
+	}
+}
+
+func schema_apis_deployer_manifest_v1alpha2_KustomizationResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KustomizationResourceReference references a resource of a component version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the resource in the component version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/component-spec/bindings-go/apis/v2.ComponentDescriptor", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"},
+	}
+}
+
 func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15144,6 +18589,12 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply update strategy. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -15177,6 +18628,26 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic comparison of the deployed resources with their desired state.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
+					"applyGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplyGroups defines the order in which objects are applied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ApplyGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -15205,11 +18676,23 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							},
 						},
 					},
+					"pruneProtection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PruneProtection restricts the deletion of resources that have been removed from the manifests.\nIf it is not set, only the default protected resources are protected.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.PruneProtection"),
+						},
+					},
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize defines a kustomization that is built by the deployer. The resulting resources are applied together with the manifests.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Kustomization"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2.Kustomization", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ApplyGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.PruneProtection", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -15391,120 +18874,296 @@ func schema_apis_deployer_mock_v1alpha1_Configuration(ref common.ReferenceCallba
 					},
 					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
+	}
+}
+
+func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase sets the phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initialPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderStatus sets the provider status to the given value",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export sets the exported configuration to the given value",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContinuousReconcileSpec represents the specification of a continuous reconcile schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cron": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cron is a standard crontab specification of the reconciliation schedule. Either Cron or Every has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"every": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Every specifies a delay after which the reconcile should happen. Either Cron or Every has to be specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_utils_driftdetection_DriftDetectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftDetectionSpec configures the periodic comparison of the deployed resources with their desired state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"every": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Every specifies the interval in which the deployed resources are compared with their desired state.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"autoCorrect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoCorrect specifies whether the deploy item is reconciled automatically if a drift is detected. Otherwise, a drift is only reported.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_utils_kustomize_Patch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Patch is a strategic merge patch or a JSON6902 patch of resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is the strategic merge patch or the JSON6902 patch in yaml or json format.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetSelector": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetSelector describes all selectors the deployer should depend on.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
-									},
-								},
-							},
+							Description: "Target selects the resources that are patched. It is required for JSON6902 patches. A strategic merge patch without target is applied to the resource with the same kind, name and namespace.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/kustomize.PatchTarget"),
 						},
 					},
 				},
+				Required: []string{"patch"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"},
+			"github.com/gardener/landscaper/apis/deployer/utils/kustomize.PatchTarget"},
 	}
 }
 
-func schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_utils_kustomize_PatchTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "PatchTarget selects the resources that are patched. All specified fields must match.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the resources. It may be a regular expression.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of the resources. It may be a regular expression.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase sets the phase of the DeployItem",
+							Description: "LabelSelector is a label selector in the string format of kubernetes label selectors.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"initialPhase": {
+					"annotationSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitialPhase sets the phase of the DeployItem, but only if it is empty or \"Init\" Additionally, setting it will suppress the DeployItem phase being set to \"Succeeded\" after successful reconciliation",
+							Description: "AnnotationSelector is an annotation selector in the string format of kubernetes label selectors.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerStatus": {
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_ApplyGroupDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplyGroupDefinition defines a group of resources that are applied together. Groups are applied in the order in which they are defined. A resource belongs to the first group that matches it. Resources that match no group are applied after all groups.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"predefinedResourceGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus sets the provider status to the given value",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Ref: ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedApplyGroup"),
 						},
 					},
-					"export": {
+					"customResourceGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Export sets the exported configuration to the given value",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Ref: ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomApplyGroup"),
 						},
 					},
-					"continuousReconcile": {
+					"waitForReadiness": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "WaitForReadiness defines whether the next group is only applied after the resources of this group are ready.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomApplyGroup", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedApplyGroup"},
 	}
 }
 
-func schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_utils_managedresource_CustomApplyGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContinuousReconcileSpec represents the specification of a continuous reconcile schedule.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cron": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Cron is a standard crontab specification of the reconciliation schedule. Either Cron or Every has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"every": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Every specifies a delay after which the reconcile should happen. Either Cron or Every has to be specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType"},
 	}
 }
 
@@ -15595,11 +19254,31 @@ func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallb
 					"jsonPath": {
 						SchemaProps: spec.SchemaProps{
 							Description: "JSONPath is the jsonpath to look for a value. The JSONPath root is the referenced resource",
-							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression that computes the exported value. The resources that are defined in Resources are available in the expression as variables. Expression and JSONPath are mutually exclusive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources defines the resources that are read from the target cluster and that are available in the expression.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportResource"),
+									},
+								},
+							},
+						},
+					},
 					"fromResource": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FromResource specifies the name of the resource where the value should be read.",
@@ -15620,11 +19299,79 @@ func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallb
 						},
 					},
 				},
-				Required: []string{"key", "jsonPath"},
+				Required: []string{"key"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ExportResource", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference"},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_ExportResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportResource defines a resource of the target cluster that is available as variable in an export expression. If a name is set, the variable contains the object with this name. Otherwise, the variable contains the list of all objects that match the namespace and the label selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"variable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variable is the name of the variable under which the resource is available in the expression.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the object, or the namespace in which the objects are listed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the listed objects by their labels. It must not be set together with a name.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"variable", "apiVersion", "kind"},
+			},
+		},
 	}
 }
 
@@ -15737,6 +19484,13 @@ func schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref common
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"fieldManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldManager is the name of the field manager that owns the fields applied by the deployer. It is only set if the resource has been applied with the server-side apply update strategy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"resource"},
 			},
@@ -15818,6 +19572,24 @@ func schema_apis_deployer_utils_managedresource_Manifest(ref common.ReferenceCal
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_PredefinedApplyGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15842,6 +19614,49 @@ func schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref comm
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_PruneProtection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PruneProtection restricts the deletion of orphaned resources, i.e. of resources that have been removed from the manifests of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxPrunedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPrunedResources is the maximal number of orphaned resources that are deleted in one reconciliation. If more resources are orphaned, the reconciliation fails and no orphaned resource is deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"protectedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProtectedResources defines types of resources that are never deleted as orphaned resources. They are added to the default protected resources: persistent volume claims, namespaces and custom resource definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType"),
+									},
+								},
+							},
+						},
+					},
+					"disableDefaultProtectedResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableDefaultProtectedResources disables the protection of persistent volume claims, namespaces and custom resource definitions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType"},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_ResourceType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15894,6 +19709,26 @@ func schema_apis_deployer_utils_managedresource_ResourceType(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerSideApplyConfiguration configures the server-side apply update strategy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Force defines whether conflicts with other field managers are resolved by taking over the ownership of the conflicting fields. If false, the apply of a resource fails in case of a conflict.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16026,6 +19861,13 @@ func schema_apis_deployer_utils_readinesschecks_ReadinessCheckConfiguration(ref
 							Format:      "",
 						},
 					},
+					"generic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generic extends the default readiness checks. Jobs, PersistentVolumeClaims, Services of type LoadBalancer, CustomResourceDefinitions and APIServices are checked by built-in checks. All other resources are checked by their status.observedGeneration and their \"Ready\" or \"Available\" conditions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"custom": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CustomReadinessChecks is a set of custom readiness check configurations",
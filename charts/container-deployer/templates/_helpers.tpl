@@ -103,6 +103,22 @@ hpa:
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
 {{- end }}
+{{- if .Values.deployer.pod }}
+pod:
+{{ .Values.deployer.pod | toYaml | indent 2 }}
+{{- end }}
+{{- if .Values.deployer.logs }}
+logs:
+{{ .Values.deployer.logs | toYaml | indent 2 }}
+{{- end }}
+{{- if .Values.deployer.job }}
+job:
+{{ .Values.deployer.job | toYaml | indent 2 }}
+{{- end }}
+{{- if .Values.deployer.state }}
+state:
+{{ .Values.deployer.state | toYaml | indent 2 }}
+{{- end }}
 {{- end }}
 
 {{- define "deployer-image" -}}
@@ -95,6 +95,18 @@ hpa:
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
 {{- end }}
+{{- with .Values.deployer.chartVerification }}
+chartVerification:
+  enforcementPolicy: {{ .enforcementPolicy | default "DoNotEnforce" }}
+  {{- if .keyring }}
+  keyringFile: /app/ls/config/keyring
+  {{- end }}
+{{- end }}
+{{- if .Values.deployer.chartCache }}
+chartCache:
+  path: /app/ls/chart-cache
+{{ omit .Values.deployer.chartCache "persistentVolumeClaim" | toYaml | indent 2 }}
+{{- end }}
 {{- end }}
 
 {{- define "deployer-image" -}}
@@ -72,6 +72,17 @@ kind: Configuration
 identity: {{ .Values.deployer.identity }}
 {{- end }}
 namespace: {{ .Values.deployer.namespace | default .Release.Namespace  }}
+{{- if .Values.deployer.oci }}
+oci:
+  allowPlainHttp: {{ .Values.deployer.oci.allowPlainHttp }}
+  insecureSkipVerify: {{ .Values.deployer.oci.insecureSkipVerify }}
+  {{- if .Values.deployer.oci.secrets }}
+  configFiles:
+  {{- range $key, $value := .Values.deployer.oci.secrets }}
+  - /app/ls/registry/secrets/{{ $key }}
+  {{- end }}
+  {{- end }}
+{{- end }}
 {{- with .Values.deployer.targetSelector }}
 targetSelector:
 {{ toYaml . }}
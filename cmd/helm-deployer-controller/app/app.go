@@ -15,9 +15,11 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	"github.com/spf13/cobra"
+	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	helmctrl "github.com/gardener/landscaper/pkg/deployer/helm"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/version"
 )
@@ -47,11 +49,14 @@ func (o *options) run(ctx context.Context) error {
 	callerName := "helm"
 	controllerName := "deployitem"
 
-	if err := helmctrl.AddDeployerToManager(
+	metrics.RegisterHelmChartCacheMetrics(controllerruntimeMetrics.Registry)
+
+	driftDetection, err := helmctrl.AddDeployerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
 		o.DeployerOptions.FinishedObjectCache,
 		o.DeployerOptions.Log, o.DeployerOptions.LsMgr, o.DeployerOptions.HostMgr,
-		o.Config, callerName, controllerName); err != nil {
+		o.Config, callerName, controllerName)
+	if err != nil {
 		return fmt.Errorf("unable to setup helm controller")
 	}
 
@@ -67,5 +72,5 @@ func (o *options) run(ctx context.Context) error {
 	}
 
 	o.DeployerOptions.Log.Info("Starting helm deployer manager")
-	return o.DeployerOptions.StartManagers(ctx)
+	return o.DeployerOptions.StartManagers(ctx, driftDetection)
 }
@@ -5,9 +5,12 @@
 package app
 
 import (
+	"fmt"
+
 	flag "github.com/spf13/pflag"
 
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	helmv1alpha1validation "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/validation"
 	"github.com/gardener/landscaper/pkg/deployer/helm"
 	deployercmd "github.com/gardener/landscaper/pkg/deployer/lib/cmd"
 )
@@ -35,5 +38,8 @@ func (o *options) Complete() error {
 	if err := o.DeployerOptions.GetConfig(&o.Config); err != nil {
 		return err
 	}
+	if err := helmv1alpha1validation.ValidateConfiguration(&o.Config); err != nil {
+		return fmt.Errorf("invalid helm deployer configuration: %w", err)
+	}
 	return nil
 }
@@ -29,6 +29,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	contextctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/context"
 	deployitemctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployitem"
+	driftctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/drift"
 	executionactrl "github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/healthcheck"
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
@@ -225,6 +226,10 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := driftctrl.AddControllersToManager(lsUncachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to setup drift controllers: %w", err)
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if os.Getenv("ENABLE_PROFILER") == "true" {
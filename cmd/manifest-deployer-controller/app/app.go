@@ -48,11 +48,12 @@ func (o *options) run(ctx context.Context) error {
 	callerName := "manifest"
 	controllerName := "deployitem"
 
-	if err := manifestctlr.AddDeployerToManager(
+	driftDetection, err := manifestctlr.AddDeployerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
 		o.DeployerOptions.FinishedObjectCache,
 		o.DeployerOptions.Log, o.DeployerOptions.LsMgr,
-		o.DeployerOptions.HostMgr, o.Config, callerName, controllerName); err != nil {
+		o.DeployerOptions.HostMgr, o.Config, callerName, controllerName)
+	if err != nil {
 		return fmt.Errorf("unable to setup manifest controller")
 	}
 
@@ -68,5 +69,5 @@ func (o *options) run(ctx context.Context) error {
 	}
 
 	o.DeployerOptions.Log.Info("Starting manifest deployer manager")
-	return o.DeployerOptions.StartManagers(ctx)
+	return o.DeployerOptions.StartManagers(ctx, driftDetection)
 }
@@ -61,6 +61,8 @@ kube::codegen::gen_helpers "$PROJECT_ROOT" \
    --extra-pkgs "$API_MODULE_PATH/deployer/utils/readinesschecks" \
    --extra-pkgs "$API_MODULE_PATH/deployer/utils/managedresource" \
    --extra-pkgs "$API_MODULE_PATH/deployer/utils/continuousreconcile" \
+   --extra-pkgs "$API_MODULE_PATH/deployer/utils/driftdetection" \
+   --extra-pkgs "$API_MODULE_PATH/deployer/utils/kustomize" \
    --extra-pkgs "$API_MODULE_PATH/deployer/helm/v1alpha1" \
    --extra-pkgs "$API_MODULE_PATH/deployer/manifest/v1alpha1" \
    --extra-pkgs "$API_MODULE_PATH/deployer/manifest/v1alpha2" \
@@ -41,6 +41,22 @@ type TypedResourceContent struct {
 	Resource interface{}
 }
 
+// HelmChartArchiveProvider is implemented by helm chart resources which provide the packaged chart
+// together with its provenance file.
+type HelmChartArchiveProvider interface {
+	// GetChartArchive returns the packaged chart and its provenance file.
+	// The keyring is required to download the provenance file.
+	GetChartArchive(ctx context.Context, keyring []byte) (*HelmChartArchive, error)
+}
+
+// HelmChartArchive is a packaged helm chart together with its provenance file.
+type HelmChartArchive struct {
+	// Name is the file name of the archive, which is referenced in the provenance file.
+	Name       string
+	Data       []byte
+	Provenance []byte
+}
+
 type GlobalResourceIdentity struct {
 	ComponentIdentity ComponentIdentity `json:"component"`
 	ResourceIdentity  v1.Identity       `json:"resource"`
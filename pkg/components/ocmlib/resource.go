@@ -122,4 +122,36 @@ func (h *HelmChartProvider) GetTypedContent(ctx context.Context) (_ *model.Typed
 	}, nil
 }
 
+func (h *HelmChartProvider) GetChartArchive(ctx context.Context, keyring []byte) (_ *model.HelmChartArchive, rerr error) {
+	access, err := helm.DownloadChart(common.NewPrinter(nil), h.ocictx, h.ref, h.version, h.repourl, helm.WithKeyring(keyring))
+	if err != nil {
+		return nil, err
+	}
+	defer errors.PropagateError(&rerr, access.Close)
+
+	chartLoader := loader.AccessLoader(access)
+	helmChart, err := chartLoader.Chart()
+	if err != nil {
+		return nil, err
+	}
+	archive, err := chartLoader.ChartArchive()
+	if err != nil {
+		return nil, err
+	}
+	data, err := archive.Get()
+	if err != nil {
+		return nil, err
+	}
+	provenanceFile, err := chartLoader.Provenance()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.HelmChartArchive{
+		Name:       fmt.Sprintf("%s-%s.tgz", helmChart.Metadata.Name, helmChart.Metadata.Version),
+		Data:       data,
+		Provenance: provenanceFile,
+	}, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
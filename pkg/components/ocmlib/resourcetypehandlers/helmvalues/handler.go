@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helmvalues
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/mandelsoft/goutils/finalizer"
+	"ocm.software/ocm/api/ocm"
+	"ocm.software/ocm/api/utils/compression"
+
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/ocmlib/registries"
+)
+
+func init() {
+	registries.Registry.Register(mediatype.HelmValuesType, New())
+}
+
+// ValuesHandler provides the content of resources that contain helm values as raw bytes.
+type ValuesHandler struct{}
+
+func New() *ValuesHandler {
+	return &ValuesHandler{}
+}
+
+func (h *ValuesHandler) GetResourceContent(ctx context.Context, r model.Resource, access ocm.ResourceAccess) (_ *model.TypedResourceContent, rerr error) {
+	var finalize finalizer.Finalizer
+	defer finalize.FinalizeWithErrorPropagationf(&rerr, "accessing (and decompressing) helm values")
+
+	m, err := access.AccessMethod()
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(m)
+
+	valuesRaw, err := m.Reader()
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(valuesRaw)
+
+	values, _, err := compression.AutoDecompress(valuesRaw)
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(values)
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(values); err != nil {
+		return nil, err
+	}
+
+	return &model.TypedResourceContent{
+		Type:     mediatype.HelmValuesType,
+		Resource: buf.Bytes(),
+	}, nil
+}
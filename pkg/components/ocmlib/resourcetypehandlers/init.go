@@ -6,5 +6,7 @@ package resourcetypehandlers
 
 import (
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/resourcetypehandlers/blueprint"
+	_ "github.com/gardener/landscaper/pkg/components/ocmlib/resourcetypehandlers/helmvalues"
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/resourcetypehandlers/jsonschema"
+	_ "github.com/gardener/landscaper/pkg/components/ocmlib/resourcetypehandlers/kustomization"
 )
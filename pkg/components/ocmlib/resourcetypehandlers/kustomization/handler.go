@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomization
+
+import (
+	"context"
+
+	"github.com/mandelsoft/goutils/finalizer"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"ocm.software/ocm/api/ocm"
+	"ocm.software/ocm/api/utils/compression"
+
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/tar"
+	"github.com/gardener/landscaper/pkg/components/ocmlib/registries"
+)
+
+func init() {
+	registries.Registry.Register(mediatype.KustomizationType, New())
+}
+
+// KustomizationHandler provides the content of resources that contain the files of a kustomization
+// as in-memory filesystem. The content of the resource must be a tar archive, which may be compressed.
+type KustomizationHandler struct{}
+
+func New() *KustomizationHandler {
+	return &KustomizationHandler{}
+}
+
+func (h *KustomizationHandler) GetResourceContent(ctx context.Context, r model.Resource, access ocm.ResourceAccess) (_ *model.TypedResourceContent, rerr error) {
+	var finalize finalizer.Finalizer
+	defer finalize.FinalizeWithErrorPropagationf(&rerr, "accessing (and extracting) kustomization")
+
+	m, err := access.AccessMethod()
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(m)
+
+	archiveRaw, err := m.Reader()
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(archiveRaw)
+
+	archive, _, err := compression.AutoDecompress(archiveRaw)
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(archive)
+
+	fs := memoryfs.New()
+	if err := tar.ExtractTar(ctx, archive, fs); err != nil {
+		return nil, err
+	}
+
+	return &model.TypedResourceContent{
+		Type:     mediatype.KustomizationType,
+		Resource: fs,
+	}, nil
+}
@@ -18,10 +18,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/deployer/container/logs"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
 )
 
@@ -99,7 +101,9 @@ func CleanupRBAC(ctx context.Context, deployItem *lsv1alpha1.DeployItem, hostCli
 }
 
 // CleanupDeployItem deletes all secrets from a host cluster which belong to a deploy item.
-func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, lsClient, hostClient client.Client, hostNamespace string) error {
+// A state in an object store is deleted as well, whereas a state on a persistent volume is deleted by the wait container
+// of the delete operation, as the volume is not accessible from the deployer.
+func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, lsClient, hostClient client.Client, hostNamespace string, stateConfig containerv1alpha1.StateConfiguration) error {
 	log := logging.FromContextOrDiscard(ctx)
 	secrets := []string{
 		ConfigurationSecretName(deployItem.Namespace, deployItem.Name),
@@ -128,6 +132,23 @@ func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, l
 		lsv1alpha1helper.ObjectReferenceFromObject(deployItem)); err != nil {
 		return err
 	}
+	if stateConfig.Backend == containerv1alpha1.StateBackendObjectStore {
+		backend, err := state.NewBackend(ctx, hostClient, hostNamespace, lsv1alpha1helper.ObjectReferenceFromObject(deployItem), &stateConfig)
+		if err != nil {
+			return err
+		}
+		if err := backend.Delete(ctx); err != nil {
+			return fmt.Errorf("unable to delete state from object store: %w", err)
+		}
+	}
+
+	// cleanup logs
+	if err := logs.CleanupLogs(ctx,
+		hostClient,
+		hostNamespace,
+		lsv1alpha1helper.ObjectReferenceFromObject(deployItem)); err != nil {
+		return err
+	}
 
 	secret := &corev1.Secret{}
 	secret.Name = DeployItemExportSecretName(deployItem.Name)
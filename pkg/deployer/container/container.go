@@ -5,6 +5,7 @@
 package container
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -12,6 +13,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -55,6 +57,12 @@ type Container struct {
 
 	InitContainerServiceAccountSecret types.NamespacedName
 	WaitContainerServiceAccountSecret types.NamespacedName
+
+	// job is the latest job of the deploy item if the operations are run as jobs.
+	job *batchv1.Job
+	// exportValidator validates the exports against the export schema.
+	// It is nil if no export schema is defined.
+	exportValidator *jsonschema.Validator
 }
 
 // New creates a new internal container item
@@ -80,6 +88,17 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	if err := container1alpha1validation.ValidatePodSettings(providerConfig, &config.Pod); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidatePodSettings", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	exportValidator, err := NewExportValidator(providerConfig)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "CompileExportSchema", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
 	status, err := DecodeProviderStatus(item.Status.ProviderStatus)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err,
@@ -97,6 +116,7 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 		ProviderStatus:        status,
 		ProviderConfiguration: providerConfig,
 		Target:                rt,
+		exportValidator:       exportValidator,
 	}, nil
 }
 
@@ -31,7 +31,7 @@ func (c *Container) Delete(ctx context.Context) error {
 		return lserrors.NewWrappedError(err,
 			"Delete", "CleanupRBAC", err.Error())
 	}
-	if err := CleanupDeployItem(ctx, c.DeployItem, c.lsUncachedClient, c.hostUncachedClient, c.Configuration.Namespace); err != nil {
+	if err := CleanupDeployItem(ctx, c.DeployItem, c.lsUncachedClient, c.hostUncachedClient, c.Configuration.Namespace, c.Configuration.State); err != nil {
 		return lserrors.NewWrappedError(err,
 			"Delete", "CleanupDeployItem", err.Error())
 	}
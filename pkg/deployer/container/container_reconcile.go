@@ -40,6 +40,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/deployer/container/logs"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 	"github.com/gardener/landscaper/pkg/deployerlegacy"
@@ -51,8 +52,9 @@ import (
 // Reconcile handles the reconcile flow for a container deploy item.
 // todo: do retries on failure: difference between main container failure and init/wait container failure
 func (c *Container) Reconcile(ctx context.Context, operation container.OperationType) error {
-	if _, err := timeout.TimeoutExceeded(ctx, c.DeployItem, TimeoutCheckpointContainerStartReconcile); err != nil {
-		return err
+	remainingTime, lserr := timeout.TimeoutExceeded(ctx, c.DeployItem, TimeoutCheckpointContainerStartReconcile)
+	if lserr != nil {
+		return lserr
 	}
 
 	pod, err := c.getPod(ctx)
@@ -67,7 +69,7 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	// do nothing if the pod is still running
 	if pod != nil {
 		if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodUnknown {
-			if err := c.collectAndSetPodStatus(pod, false); err != nil {
+			if err := c.collectAndSetPodStatus(pod, false, nil); err != nil {
 				return lserrors.NewWrappedError(err,
 					"Reconcile", "UpdatePodStatus", err.Error())
 			}
@@ -134,6 +136,9 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			DeployerID: c.Configuration.Identity,
 
 			ProviderConfiguration:             c.ProviderConfiguration,
+			PodConfiguration:                  c.Configuration.Pod,
+			LogsConfiguration:                 c.Configuration.Logs,
+			StateConfiguration:                c.Configuration.State,
 			InitContainer:                     c.Configuration.InitContainer,
 			WaitContainer:                     c.Configuration.WaitContainer,
 			InitContainerServiceAccountSecret: c.InitContainerServiceAccountSecret,
@@ -162,14 +167,28 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 				operationName, "PodGeneration", err.Error())
 		}
 
-		if err := c.hostUncachedClient.Create(ctx, pod); err != nil {
+		if c.Configuration.Job != nil {
+			job := generateJob(pod, c.Configuration.Job, remainingTime)
+			if err := c.hostUncachedClient.Create(ctx, job); err != nil {
+				return lserrors.NewWrappedError(err,
+					operationName, "CreateJob", err.Error())
+			}
+			c.job = job
+			pod = podForJob(job, nil)
+		} else if err := c.hostUncachedClient.Create(ctx, pod); err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "CreatePod", err.Error())
 		}
 
 		// update status
 		c.ProviderStatus.LastOperation = string(operation)
-		if err := c.collectAndSetPodStatus(pod, false); err != nil {
+		if c.Configuration.Job != nil {
+			c.ProviderStatus.PodStatus = &containerv1alpha1.PodStatus{
+				JobName: c.job.Name,
+				LastRun: &pod.CreationTimestamp,
+			}
+		}
+		if err := c.collectAndSetPodStatus(pod, false, nil); err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "UpdatePodStatus", err.Error())
 		}
@@ -193,19 +212,35 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	}
 
 	operationName := "Complete"
+	var exportErr lserrors.LsError
 	if pod != nil {
 		podSucceeded := pod.Status.Phase == corev1.PodSucceeded
 		if podSucceeded {
 			if err := c.SyncExport(ctx); err != nil {
-				return lserrors.NewWrappedError(err,
-					operationName, "SyncExport", err.Error())
+				if !lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorConfigurationProblem) {
+					return lserrors.NewWrappedError(err,
+						operationName, "SyncExport", err.Error())
+				}
+				// invalid exports fail the deploy item, but the pod is cleaned up as usual,
+				// as another run of the same configuration would not change the exports.
+				exportErr = lserrors.NewWrappedError(err,
+					operationName, "SyncExport", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+				podSucceeded = false
+				lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
 			}
 		} else if pod.Status.Phase == corev1.PodFailed {
 			lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
 		}
 
+		logSecrets, err := logs.ListLogSecrets(ctx, c.hostUncachedClient, c.Configuration.Namespace,
+			lsv1alpha1helper.ObjectReferenceFromObject(c.DeployItem), pod.Name)
+		if err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "ListLogSecrets", err.Error())
+		}
+
 		c.ProviderStatus.LastOperation = string(operation)
-		if err := c.collectAndSetPodStatus(pod, podSucceeded); err != nil {
+		if err := c.collectAndSetPodStatus(pod, podSucceeded, logSecrets); err != nil {
 			return lserrors.NewWrappedError(err,
 				"Reconcile", "UpdatePodStatus", err.Error())
 		}
@@ -220,6 +255,10 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		if err := c.CleanupPod(ctx, pod); err != nil {
 			return err
 		}
+
+		if exportErr != nil {
+			return exportErr
+		}
 	}
 	if c.ProviderStatus != nil && c.ProviderStatus.PodStatus != nil && c.ProviderStatus.PodStatus.LastSuccessfulJobID != nil && *c.ProviderStatus.PodStatus.LastSuccessfulJobID == c.DeployItem.Status.JobID {
 		logger.Debug("Setting phase to 'Succeeded', because pod was seen successfully finished for current jobID", lc.KeyJobID, c.DeployItem.Status.JobID)
@@ -228,14 +267,15 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	return nil
 }
 
-// collectAndSetPodStatus the pod status and updates the container provider status
-func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessfulJobID bool) error {
+// collectAndSetPodStatus the pod status and updates the container provider status.
+// The log secrets are only set if they are not nil.
+func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessfulJobID bool, logSecrets []lsv1alpha1.ObjectReference) error {
 	c.DeployItem.Status.Conditions = setConditionsFromPod(pod, c.DeployItem.Status.Conditions)
 	var jobID *string
 	if updateLastSuccessfulJobID {
 		jobID = ptr.To[string](c.DeployItem.Status.JobID)
 	}
-	if err := setStatusFromPod(pod, c.ProviderStatus, jobID); err != nil {
+	if err := setStatusFromPod(pod, c.ProviderStatus, jobID, logSecrets); err != nil {
 		return err
 	}
 
@@ -277,18 +317,23 @@ func (c *Container) shouldRunNewPod(ctx context.Context, pod *corev1.Pod) bool {
 	return false
 }
 
-func setStatusFromPod(pod *corev1.Pod, providerStatus *containerv1alpha1.ProviderStatus, currentJobID *string) error {
+func setStatusFromPod(pod *corev1.Pod, providerStatus *containerv1alpha1.ProviderStatus, currentJobID *string, logSecrets []lsv1alpha1.ObjectReference) error {
 	podStatus := &containerv1alpha1.PodStatus{
 		PodName: pod.Name,
 		LastRun: &pod.CreationTimestamp,
 	}
 	if providerStatus.PodStatus != nil {
 		podStatus = providerStatus.PodStatus
+		// the pod of a job changes if the job retries the operation.
+		podStatus.PodName = pod.Name
 	}
 
 	if currentJobID != nil {
 		podStatus.LastSuccessfulJobID = currentJobID
 	}
+	if logSecrets != nil {
+		podStatus.LogSecrets = logSecrets
+	}
 
 	if mainContainerStatus, err := kutil.GetStatusForContainer(pod.Status.ContainerStatuses, container.MainContainerName); err == nil {
 		podStatus.ContainerStatus = convertCoreContainerStatusToV1alpha1Container(mainContainerStatus)
@@ -730,6 +775,13 @@ func (c *Container) SyncExport(ctx context.Context) error {
 		return fmt.Errorf("unable to fetch exported secret %s from host cluster: %w", ExportSecretName(c.DeployItem.Namespace, c.DeployItem.Name), err)
 	}
 
+	// the exports of a delete operation are not validated, so that invalid exports cannot block the deletion.
+	if c.DeployItem.DeletionTimestamp.IsZero() {
+		if err := ValidateExport(c.exportValidator, secret.Data); err != nil {
+			return lserrors.NewWrappedError(err, "SyncExport", "ValidateExport", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
 	expSecret := &corev1.Secret{}
 	expSecret.Name = DeployItemExportSecretName(c.DeployItem.Name)
 	expSecret.Namespace = c.DeployItem.Namespace
@@ -749,6 +801,11 @@ func (c *Container) SyncExport(ctx context.Context) error {
 }
 
 // CleanupPod cleans up a pod that was started with the container deployer.
+// If the pod belongs to a job, the job is cleaned up instead.
 func (c *Container) CleanupPod(ctx context.Context, pod *corev1.Pod) error {
-	return CleanupPod(ctx, c.hostUncachedClient, pod, c.Configuration.DebugOptions != nil && c.Configuration.DebugOptions.KeepPod)
+	keepPod := c.Configuration.DebugOptions != nil && c.Configuration.DebugOptions.KeepPod
+	if c.job != nil {
+		return CleanupJob(ctx, c.hostUncachedClient, c.job, keepPod)
+	}
+	return CleanupPod(ctx, c.hostUncachedClient, pod, keepPod)
 }
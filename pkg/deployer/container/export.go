@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+// rootField is the field name that gojsonschema uses for the root of a document.
+const rootField = "(root)"
+
+// NewExportValidator compiles the export schema of the provider configuration.
+// Nil is returned if no export schema is defined.
+func NewExportValidator(providerConfig *containerv1alpha1.ProviderConfiguration) (*jsonschema.Validator, error) {
+	if providerConfig.ExportSchema == nil {
+		return nil, nil
+	}
+	v := jsonschema.NewValidator(&jsonschema.ReferenceContext{
+		LocalTypes: providerConfig.ExportSchemaLocalTypes,
+	})
+	if err := v.CompileSchema(providerConfig.ExportSchema.RawMessage); err != nil {
+		return nil, fmt.Errorf("unable to compile export schema: %w", err)
+	}
+	return v, nil
+}
+
+// ValidateExport decodes the exports and the sensitive exports of an export secret
+// and validates them against the export schema if a validator is given.
+// Without a validator, the exports are not checked at all.
+// Otherwise, both exports have to be yaml or json objects. They are validated as one object, in which the sensitive exports
+// overwrite the exports. The values of sensitive exports are never part of the returned error.
+func ValidateExport(validator *jsonschema.Validator, data map[string][]byte) error {
+	if validator == nil {
+		return nil
+	}
+
+	exports := map[string]interface{}{}
+	if raw, ok := data[lsv1alpha1.DataObjectSecretDataKey]; ok {
+		if err := yaml.Unmarshal(raw, &exports); err != nil {
+			return fmt.Errorf("exports are not a valid yaml or json object: %w", err)
+		}
+	}
+	sensitiveExports := map[string]interface{}{}
+	if raw, ok := data[container.SensitiveExportsDataKey]; ok {
+		// the decoding error is omitted as it may contain parts of the sensitive data.
+		if err := yaml.Unmarshal(raw, &sensitiveExports); err != nil {
+			return errors.New("sensitive exports are not a valid yaml or json object")
+		}
+	}
+
+	values := make(map[string]interface{}, len(exports)+len(sensitiveExports))
+	for key, value := range exports {
+		values[key] = value
+	}
+	for key, value := range sensitiveExports {
+		values[key] = value
+	}
+
+	if err := validator.ValidateGoStructOmitValues(values, func(field string) bool {
+		return isSensitiveField(field, sensitiveExports)
+	}); err != nil {
+		return fmt.Errorf("exports do not match the export schema: %w", err)
+	}
+	return nil
+}
+
+// isSensitiveField checks whether the value of the given field may contain sensitive exports.
+// This is the case for the root document and all fields below a top level key of the sensitive exports.
+func isSensitiveField(field string, sensitiveExports map[string]interface{}) bool {
+	if len(sensitiveExports) == 0 {
+		return false
+	}
+	if field == rootField {
+		return true
+	}
+	for key := range sensitiveExports {
+		if field == key || strings.HasPrefix(field, key+".") {
+			return true
+		}
+	}
+	return false
+}
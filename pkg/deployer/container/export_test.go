@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+var _ = Describe("Export", func() {
+
+	var validator *jsonschema.Validator
+
+	BeforeEach(func() {
+		var err error
+		validator, err = containerctlr.NewExportValidator(&containerv1alpha1.ProviderConfiguration{
+			ExportSchema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: json.RawMessage(`{
+				"type": "object",
+				"required": ["endpoint", "password"],
+				"properties": {
+					"endpoint": { "$ref": "local://endpoint" },
+					"password": { "type": "string", "minLength": 8 }
+				}
+			}`)},
+			ExportSchemaLocalTypes: map[string]lsv1alpha1.JSONSchemaDefinition{
+				"endpoint": {RawMessage: json.RawMessage(`{ "type": "string", "pattern": "^https://" }`)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not return a validator if no export schema is defined", func() {
+		v, err := containerctlr.NewExportValidator(&containerv1alpha1.ProviderConfiguration{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(BeNil())
+	})
+
+	It("should fail to compile an export schema with an unknown local type", func() {
+		_, err := containerctlr.NewExportValidator(&containerv1alpha1.ProviderConfiguration{
+			ExportSchema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: json.RawMessage(`{ "$ref": "local://unknown" }`)},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should accept exports and sensitive exports that match the schema", func() {
+		Expect(containerctlr.ValidateExport(validator, map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: []byte("endpoint: https://example.com"),
+			container.SensitiveExportsDataKey:  []byte(`{"password": "my-password"}`),
+		})).To(Succeed())
+	})
+
+	It("should report the invalid fields of the exports", func() {
+		err := containerctlr.ValidateExport(validator, map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: []byte("endpoint: http://example.com\npassword: my-password"),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("endpoint"))
+		Expect(err.Error()).To(ContainSubstring("http://example.com"))
+	})
+
+	It("should not report the values of invalid sensitive exports", func() {
+		err := containerctlr.ValidateExport(validator, map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: []byte("endpoint: https://example.com"),
+			container.SensitiveExportsDataKey:  []byte("password: short"),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("password"))
+		Expect(err.Error()).ToNot(ContainSubstring("short"))
+
+		err = containerctlr.ValidateExport(validator, map[string][]byte{
+			container.SensitiveExportsDataKey: []byte("password: my-password"),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("endpoint"))
+		Expect(err.Error()).ToNot(ContainSubstring("my-password"))
+	})
+
+	It("should fail for exports that are no objects", func() {
+		err := containerctlr.ValidateExport(validator, map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: []byte("- a\n- b"),
+		})
+		Expect(err).To(HaveOccurred())
+
+		err = containerctlr.ValidateExport(validator, map[string][]byte{
+			container.SensitiveExportsDataKey: []byte("my-password"),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).ToNot(ContainSubstring("my-password"))
+	})
+
+	It("should not check the exports if no export schema is defined", func() {
+		Expect(containerctlr.ValidateExport(nil, map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: []byte("- a\n- b"),
+			container.SensitiveExportsDataKey:  []byte("my-password"),
+		})).To(Succeed())
+	})
+})
@@ -13,9 +13,11 @@ import (
 
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -149,6 +151,19 @@ func (gc *GarbageCollector) Cleanup(ctx context.Context) {
 				logger.Error(err, "cleanup pod", lc.KeyResource, kutil.ObjectKeyFromObject(next).String())
 			}
 		}
+
+		// cleanup jobs
+		jobList := &batchv1.JobList{}
+		if err := read_write_layer.ListJobs(ctx, gc.hostUncachedClient, jobList, read_write_layer.R000134, listOptions...); err != nil {
+			logger.Error(err, err.Error())
+		}
+
+		for i := range jobList.Items {
+			next := &jobList.Items[i]
+			if err := gc.cleanupJob(ctx, next, jobList.Items); err != nil {
+				logger.Error(err, "cleanup job", lc.KeyResource, kutil.ObjectKeyFromObject(next).String())
+			}
+		}
 	}
 }
 
@@ -189,6 +204,10 @@ func (gc *GarbageCollector) cleanupSecret(ctx context.Context, obj *corev1.Secre
 // cleanupPod deletes pods that do not have a parent deploy item anymore.
 func (gc *GarbageCollector) cleanupPod(ctx context.Context, obj *corev1.Pod) error {
 	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if owner := metav1.GetControllerOf(obj); owner != nil && owner.Kind == "Job" {
+		logger.Debug("Not garbage collected", lc.KeyReason, "pod is managed by a job")
+		return nil
+	}
 	if obj.Status.Phase == corev1.PodPending || obj.Status.Phase == corev1.PodRunning || obj.Status.Phase == corev1.PodUnknown {
 		logger.Debug("Not garbage collected", lc.KeyReason, "pod is still running", lc.KeyPhase, obj.Status.Phase)
 		return nil
@@ -229,6 +248,54 @@ func (gc *GarbageCollector) cleanupPod(ctx context.Context, obj *corev1.Pod) err
 	return nil
 }
 
+// cleanupJob deletes finished jobs that do not have a parent deploy item anymore or that are not the latest job of their deploy item.
+// The pods of a job are deleted together with the job.
+func (gc *GarbageCollector) cleanupJob(ctx context.Context, obj *batchv1.Job, jobs []batchv1.Job) error {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if !jobHasCondition(obj, batchv1.JobComplete) && !jobHasCondition(obj, batchv1.JobFailed) {
+		logger.Debug("Not garbage collected", lc.KeyReason, "job is still running")
+		return nil
+	}
+
+	shouldGC, err := gc.shouldGarbageCollect(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if shouldGC {
+		logger.Debug("Garbage collected", lc.KeyReason, "deploy item does not exist anymore")
+		if err := CleanupJob(ctx, gc.hostUncachedClient, obj, false); err != nil {
+			return fmt.Errorf("unable to garbage collect job %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+		}
+		return nil
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, container.ContainerDeployerFinalizer) {
+		// finished jobs with a ttl are deleted by kubernetes.
+		if obj.Spec.TTLSecondsAfterFinished != nil {
+			return nil
+		}
+		logger.Debug("Garbage collected", lc.KeyReason, "job has no finalizer")
+		return gc.hostUncachedClient.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	}
+
+	// only the latest job of a deploy item is processed by the deployer.
+	for _, j := range jobs {
+		if j.Labels[container.ContainerDeployerDeployItemNameLabel] != obj.Labels[container.ContainerDeployerDeployItemNameLabel] ||
+			j.Labels[container.ContainerDeployerDeployItemNamespaceLabel] != obj.Labels[container.ContainerDeployerDeployItemNamespaceLabel] {
+			continue
+		}
+		if controllerutil.ContainsFinalizer(&j, container.ContainerDeployerFinalizer) && j.CreationTimestamp.After(obj.CreationTimestamp.Time) {
+			if err := CleanupJob(ctx, gc.hostUncachedClient, obj, false); err != nil {
+				return fmt.Errorf("unable to garbage collect job %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+			}
+			logger.Debug("Garbage collected")
+			return nil
+		}
+	}
+	logger.Debug("Not garbage collected", lc.KeyReason, "latest job")
+	return nil
+}
+
 // isLatestPod cleans returns if the current pod is the latest executed pod.
 func (gc *GarbageCollector) isLatestPod(ctx context.Context, pod *corev1.Pod) (bool, error) {
 	var (
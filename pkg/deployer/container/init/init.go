@@ -208,7 +208,11 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	log.Info("Copied target content to shared volume.")
 
 	log.Info("Restoring state")
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath).WithFs(fs).Restore(ctx); err != nil {
+	st, err := state.NewFromConfiguration(ctx, kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath, opts.StateConfiguration)
+	if err != nil {
+		return err
+	}
+	if err := st.WithFs(fs).Restore(ctx); err != nil {
 		return err
 	}
 	log.Info("State has been successfully restored")
@@ -5,6 +5,7 @@
 package init
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
@@ -16,6 +17,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 )
 
 type options struct {
@@ -38,6 +40,11 @@ type options struct {
 	deployItemNamespace string
 	DeployItemKey       lsv1alpha1.ObjectReference
 	DeployItem          *lsv1alpha1.DeployItem
+
+	// StateConfiguration defines the backend of the state.
+	// It is decoded from the raw json configuration during the validation.
+	StateConfiguration    *containerv1alpha1.StateConfiguration
+	rawStateConfiguration string
 }
 
 // Complete reads necessary options from the expected sources.
@@ -56,6 +63,7 @@ func (o *options) Complete() {
 	o.deployItemName = os.Getenv(container.DeployItemName)
 	o.deployItemNamespace = os.Getenv(container.DeployItemNamespaceName)
 	o.DeployItemKey = lsv1alpha1.ObjectReference{Name: o.deployItemName, Namespace: o.deployItemNamespace}
+	o.rawStateConfiguration = os.Getenv(container.StateConfigurationName)
 
 	o.DefaultBackoff = wait.Backoff{
 		Duration: 10 * time.Second,
@@ -96,5 +104,13 @@ func (o *options) Validate() error {
 	if len(o.deployItemNamespace) == 0 {
 		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.DeployItemNamespaceName))
 	}
+	if len(o.rawStateConfiguration) != 0 {
+		stateConfig := &containerv1alpha1.StateConfiguration{}
+		if decodeErr := json.Unmarshal([]byte(o.rawStateConfiguration), stateConfig); decodeErr != nil {
+			err = multierror.Append(err, fmt.Errorf("unable to decode %s: %w", container.StateConfigurationName, decodeErr))
+		} else {
+			o.StateConfiguration = stateConfig
+		}
+	}
 	return err.ErrorOrNil()
 }
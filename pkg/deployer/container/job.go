@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// generateJob generates a job that runs the given pod.
+// The active deadline of the job is set to the remaining time until the timeout of the deploy item.
+func generateJob(pod *corev1.Pod, jobConfig *containerv1alpha1.JobConfiguration, remainingTime time.Duration) *batchv1.Job {
+	job := &batchv1.Job{}
+	job.GenerateName = pod.GenerateName
+	job.Namespace = pod.Namespace
+	job.Labels = make(map[string]string, len(pod.Labels))
+	for k, v := range pod.Labels {
+		job.Labels[k] = v
+	}
+	job.Finalizers = []string{container.ContainerDeployerFinalizer}
+
+	job.Spec.BackoffLimit = jobConfig.BackoffLimit
+	job.Spec.TTLSecondsAfterFinished = jobConfig.TTLSecondsAfterFinished
+	if remainingTime > 0 {
+		activeDeadlineSeconds := int64(math.Ceil(remainingTime.Seconds()))
+		job.Spec.ActiveDeadlineSeconds = &activeDeadlineSeconds
+	}
+
+	// the pods of the job have no finalizer, as their status is read from the job.
+	job.Spec.Template.Labels = pod.Labels
+	job.Spec.Template.Annotations = pod.Annotations
+	job.Spec.Template.Spec = pod.Spec
+	return job
+}
+
+// getJobPod returns the latest job of the deploy item that has not yet been processed,
+// together with a pod that represents the state of the job.
+// The pod is the latest pod of the job, whose phase is derived from the job status, so that
+// a failed pod that is retried by the job is still considered as running.
+// If the job has not yet created a pod, a pending pod with the name and labels of the job is returned.
+func (c *Container) getJobPod(ctx context.Context) (*corev1.Pod, *batchv1.Job, error) {
+	jobList := &batchv1.JobList{}
+	if err := read_write_layer.ListJobs(ctx, c.hostUncachedClient, jobList, read_write_layer.R000132,
+		client.InNamespace(c.Configuration.Namespace), client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
+		}); err != nil {
+		return nil, nil, err
+	}
+
+	// only return latest job and ignore previous runs
+	var latest *batchv1.Job
+	for _, job := range jobList.Items {
+		// ignore jobs with no finalizer as they are already reconciled and their state was persisted.
+		if !controllerutil.ContainsFinalizer(&job, container.ContainerDeployerFinalizer) {
+			continue
+		}
+		if latest == nil || job.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = job.DeepCopy()
+		}
+	}
+
+	if latest == nil {
+		return nil, nil, apierrors.NewNotFound(schema.GroupResource{
+			Group:    batchv1.SchemeGroupVersion.Group,
+			Resource: "Job",
+		}, c.DeployItem.Name)
+	}
+
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000133,
+		client.InNamespace(latest.Namespace), client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
+		}); err != nil {
+		return nil, nil, err
+	}
+
+	return podForJob(latest, podList.Items), latest, nil
+}
+
+// podForJob returns the pod that represents the state of the given job.
+func podForJob(job *batchv1.Job, pods []corev1.Pod) *corev1.Pod {
+	var pod *corev1.Pod
+	for i := range pods {
+		if !metav1.IsControlledBy(&pods[i], job) {
+			continue
+		}
+		if pod == nil || pods[i].CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = pods[i].DeepCopy()
+		}
+	}
+
+	if pod == nil {
+		pod = &corev1.Pod{}
+		pod.Name = job.Name
+		pod.Namespace = job.Namespace
+		pod.Labels = job.Spec.Template.Labels
+		pod.CreationTimestamp = job.CreationTimestamp
+		pod.Status.Phase = corev1.PodPending
+	}
+
+	switch {
+	case jobHasCondition(job, batchv1.JobComplete):
+		pod.Status.Phase = corev1.PodSucceeded
+	case jobHasCondition(job, batchv1.JobFailed):
+		pod.Status.Phase = corev1.PodFailed
+	case pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed:
+		// the job has not yet processed the finished pod or will retry it.
+		pod.Status.Phase = corev1.PodRunning
+	}
+	return pod
+}
+
+// jobHasCondition checks whether the given condition of the job is true.
+func jobHasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanupJob cleans up a job that was started with the container deployer.
+// A finished job with a ttl is not deleted, as it is deleted by kubernetes.
+func CleanupJob(ctx context.Context, hostClient client.Client, job *batchv1.Job, keepJob bool) error {
+	// only remove the finalizer if we get the status of the job
+	controllerutil.RemoveFinalizer(job, container.ContainerDeployerFinalizer)
+	if err := hostClient.Update(ctx, job); err != nil {
+		err = fmt.Errorf("unable to remove finalizer from job: %w", err)
+		return lserrors.NewWrappedError(err,
+			"CleanupJob", "RemoveFinalizer", err.Error())
+	}
+
+	finished := jobHasCondition(job, batchv1.JobComplete) || jobHasCondition(job, batchv1.JobFailed)
+	if keepJob || (finished && job.Spec.TTLSecondsAfterFinished != nil) {
+		return nil
+	}
+	if err := hostClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		err = fmt.Errorf("unable to delete job: %w", err)
+		return lserrors.NewWrappedError(err,
+			"CleanupJob", "DeleteJob", err.Error())
+	}
+	return nil
+}
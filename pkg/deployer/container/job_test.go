@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+var _ = Describe("Job", func() {
+
+	var (
+		pod *corev1.Pod
+		job *batchv1.Job
+	)
+
+	BeforeEach(func() {
+		pod = &corev1.Pod{}
+		pod.GenerateName = "my-di-"
+		pod.Namespace = "host"
+		pod.Labels = map[string]string{container.ContainerDeployerDeployItemNameLabel: "my-di"}
+		pod.Finalizers = []string{container.ContainerDeployerFinalizer}
+		pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+		pod.Spec.Containers = []corev1.Container{{Name: container.MainContainerName, Image: "example.com/image:1.0.0"}}
+
+		job = generateJob(pod, &containerv1alpha1.JobConfiguration{
+			BackoffLimit:            ptr.To[int32](3),
+			TTLSecondsAfterFinished: ptr.To[int32](600),
+		}, 90500*time.Millisecond)
+		job.Name = "my-di-abc"
+		job.UID = "job-uid"
+		job.CreationTimestamp = metav1.NewTime(time.Now())
+	})
+
+	It("should generate a job that runs the pod", func() {
+		Expect(job.GenerateName).To(Equal(pod.GenerateName))
+		Expect(job.Namespace).To(Equal(pod.Namespace))
+		Expect(job.Labels).To(Equal(pod.Labels))
+		Expect(job.Finalizers).To(ConsistOf(container.ContainerDeployerFinalizer))
+		Expect(job.Spec.BackoffLimit).To(Equal(ptr.To[int32](3)))
+		Expect(job.Spec.TTLSecondsAfterFinished).To(Equal(ptr.To[int32](600)))
+		Expect(job.Spec.ActiveDeadlineSeconds).To(Equal(ptr.To[int64](91)))
+		Expect(job.Spec.Template.Finalizers).To(BeEmpty())
+		Expect(job.Spec.Template.Spec).To(Equal(pod.Spec))
+	})
+
+	It("should return a pending pod if the job has not yet created a pod", func() {
+		res := podForJob(job, nil)
+		Expect(res.Name).To(Equal(job.Name))
+		Expect(res.Labels).To(Equal(pod.Labels))
+		Expect(res.Status.Phase).To(Equal(corev1.PodPending))
+	})
+
+	It("should return the latest pod of the job with a phase derived from the job", func() {
+		newJobPod := func(name string, created time.Time, phase corev1.PodPhase) corev1.Pod {
+			p := corev1.Pod{}
+			p.Name = name
+			p.CreationTimestamp = metav1.NewTime(created)
+			p.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job"))}
+			p.Status.Phase = phase
+			return p
+		}
+		otherPod := newJobPod("other", time.Now().Add(time.Hour), corev1.PodRunning)
+		otherPod.OwnerReferences[0].UID = types.UID("other-uid")
+		pods := []corev1.Pod{
+			newJobPod("first", time.Now(), corev1.PodFailed),
+			newJobPod("second", time.Now().Add(time.Minute), corev1.PodFailed),
+			otherPod,
+		}
+
+		// the failed pod is retried by the job
+		res := podForJob(job, pods)
+		Expect(res.Name).To(Equal("second"))
+		Expect(res.Status.Phase).To(Equal(corev1.PodRunning))
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		Expect(podForJob(job, pods).Status.Phase).To(Equal(corev1.PodFailed))
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(podForJob(job, pods).Status.Phase).To(Equal(corev1.PodSucceeded))
+	})
+})
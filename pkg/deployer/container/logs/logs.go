@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// LogsDataKey is the key of the secret data that contains a chunk of the logs.
+const LogsDataKey = "logs"
+
+// chunkSize is the maximum size of the logs that are stored in one secret.
+const chunkSize = corev1.MaxSecretSize // 1 MB
+
+// Logs handles the persistence of the logs of the main container of a container deploy item.
+type Logs struct {
+	deployItem lsv1alpha1.ObjectReference
+	// namespace is the namespace where the log secrets should be created.
+	namespace  string
+	kubeClient client.Client
+	podName    string
+}
+
+// New creates a new logs instance for the given pod.
+func New(kubeClient client.Client, namespace string, deployItemKey lsv1alpha1.ObjectReference, podName string) *Logs {
+	return &Logs{
+		deployItem: deployItemKey,
+		namespace:  namespace,
+		kubeClient: kubeClient,
+		podName:    podName,
+	}
+}
+
+// Upload splits the logs into chunks of 1MB and stores the chunks in secrets.
+func (l *Logs) Upload(ctx context.Context, data []byte) ([]lsv1alpha1.ObjectReference, error) {
+	secrets := make([]lsv1alpha1.ObjectReference, 0)
+	for count := 0; count == 0 || len(data) > 0; count++ {
+		chunk := data
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		data = data[len(chunk):]
+
+		secret := &corev1.Secret{}
+		secret.GenerateName = fmt.Sprintf("logs-%s-%s-", l.deployItem.Namespace, l.podName)
+		secret.Namespace = l.namespace
+		secret.Labels = map[string]string{
+			container.ContainerDeployerDeployItemNameLabel:      l.deployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: l.deployItem.Namespace,
+			container.ContainerDeployerTypeLabel:                container.ContainerDeployerTypeLogs,
+		}
+		secret.Annotations = map[string]string{
+			container.ContainerDeployerPodNameAnnotation:  l.podName,
+			container.ContainerDeployerStateNumAnnotation: strconv.Itoa(count),
+		}
+		secret.Data = map[string][]byte{
+			LogsDataKey: chunk,
+		}
+
+		if err := l.kubeClient.Create(ctx, secret); err != nil {
+			return secrets, err
+		}
+		secrets = append(secrets, lsv1alpha1.ObjectReference{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+		})
+	}
+	return secrets, nil
+}
+
+// GarbageCollect deletes the log secrets of all but the latest runs of the deploy item.
+// The number of runs whose logs are kept is defined by the retention.
+func (l *Logs) GarbageCollect(ctx context.Context, retention int) error {
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, l.kubeClient, secretList, read_write_layer.R000129,
+		LogSecretListOptions(l.namespace, l.deployItem)...); err != nil {
+		return err
+	}
+
+	runs := groupByPod(secretList.Items)
+	if len(runs) <= retention {
+		return nil
+	}
+
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	for _, run := range runs[:len(runs)-retention] {
+		for _, secret := range run {
+			if err := l.kubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to delete log secret %s: %w", secret.Name, err)
+			}
+			log.Debug("Successfully garbage collected", lc.KeyResource, secret.Name)
+		}
+	}
+	return nil
+}
+
+// LogSecretListOptions returns the list options for all log secrets of a deploy item.
+func LogSecretListOptions(namespace string, deployItem lsv1alpha1.ObjectReference) []client.ListOption {
+	labelSelector := client.MatchingLabels{
+		container.ContainerDeployerDeployItemNameLabel:      deployItem.Name,
+		container.ContainerDeployerDeployItemNamespaceLabel: deployItem.Namespace,
+		container.ContainerDeployerTypeLabel:                container.ContainerDeployerTypeLogs,
+	}
+	return []client.ListOption{labelSelector, client.InNamespace(namespace)}
+}
+
+// ListLogSecrets returns the references to the log secrets of the given pod in the order of their chunks.
+func ListLogSecrets(ctx context.Context, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference, podName string) ([]lsv1alpha1.ObjectReference, error) {
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, kubeClient, secretList, read_write_layer.R000130,
+		LogSecretListOptions(namespace, deployItem)...); err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*corev1.Secret, 0)
+	for i := range secretList.Items {
+		if secretList.Items[i].Annotations[container.ContainerDeployerPodNameAnnotation] == podName {
+			secrets = append(secrets, &secretList.Items[i])
+		}
+	}
+	sort.Sort(chunkList(secrets))
+
+	refs := make([]lsv1alpha1.ObjectReference, 0, len(secrets))
+	for _, secret := range secrets {
+		refs = append(refs, lsv1alpha1.ObjectReference{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+		})
+	}
+	return refs, nil
+}
+
+// CleanupLogs deletes all log secrets of a deploy item.
+func CleanupLogs(ctx context.Context, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference) error {
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, kubeClient, secretList, read_write_layer.R000131,
+		LogSecretListOptions(namespace, deployItem)...); err != nil {
+		return err
+	}
+	for i := range secretList.Items {
+		if err := kubeClient.Delete(ctx, &secretList.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete log secret %s: %w", secretList.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// ReadTail reads all data from the reader and returns at most the last limitBytes bytes.
+// If data has to be dropped, the result starts at the beginning of a line if possible.
+func ReadTail(r io.Reader, limitBytes int64) ([]byte, error) {
+	var (
+		data      []byte
+		truncated bool
+		buf       = make([]byte, 32*1024)
+	)
+	for {
+		n, err := r.Read(buf)
+		data = append(data, buf[:n]...)
+		if int64(len(data)) > limitBytes {
+			data = data[int64(len(data))-limitBytes:]
+			truncated = true
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if truncated {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 && i < len(data)-1 {
+			data = data[i+1:]
+		}
+	}
+	return data, nil
+}
+
+// groupByPod groups the log secrets by the pod whose logs they contain.
+// The groups are sorted by the creation time of the secrets, the oldest group first.
+func groupByPod(secrets []corev1.Secret) [][]*corev1.Secret {
+	groups := map[string][]*corev1.Secret{}
+	for i := range secrets {
+		podName := secrets[i].Annotations[container.ContainerDeployerPodNameAnnotation]
+		groups[podName] = append(groups[podName], &secrets[i])
+	}
+
+	runs := make([][]*corev1.Secret, 0, len(groups))
+	for _, group := range groups {
+		runs = append(runs, group)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		ti, tj := runs[i][0].CreationTimestamp, runs[j][0].CreationTimestamp
+		if ti.Equal(&tj) {
+			return runs[i][0].Annotations[container.ContainerDeployerPodNameAnnotation] < runs[j][0].Annotations[container.ContainerDeployerPodNameAnnotation]
+		}
+		return ti.Before(&tj)
+	})
+	return runs
+}
+
+type chunkList []*corev1.Secret
+
+func (s chunkList) Len() int { return len(s) }
+
+func (s chunkList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s chunkList) Less(i, j int) bool {
+	numI, _ := strconv.Atoi(s[i].Annotations[container.ContainerDeployerStateNumAnnotation])
+	numJ, _ := strconv.Atoi(s[j].Annotations[container.ContainerDeployerStateNumAnnotation])
+	return numI < numJ
+}
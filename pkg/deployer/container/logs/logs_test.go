@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logs_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/logs"
+	"github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Container Deployer Logs", func() {
+
+	Context("ReadTail", func() {
+		It("should return the complete data if it does not exceed the limit", func() {
+			data, err := logs.ReadTail(strings.NewReader("line 1\nline 2\n"), 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("line 1\nline 2\n"))
+		})
+
+		It("should return the last complete lines within the limit", func() {
+			data, err := logs.ReadTail(strings.NewReader("line 1\nline 2\nline 3\n"), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("line 3\n"))
+		})
+
+		It("should return the last bytes of data that is larger than the read buffer", func() {
+			input := bytes.Repeat([]byte("0123456789"), 10000)
+			data, err := logs.ReadTail(bytes.NewReader(input), 25)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("5678901234567890123456789"))
+		})
+	})
+
+	Context("Persistence", func() {
+		var (
+			testState  *envtest.State
+			deployItem = lsv1alpha1.ObjectReference{
+				Name:      "testname",
+				Namespace: "testns",
+			}
+		)
+
+		BeforeEach(func() {
+			var err error
+			testState, err = testenv.InitState(context.TODO())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(testenv.CleanupState(context.TODO(), testState)).To(Succeed())
+		})
+
+		It("should upload the logs and list the secrets of a pod", func() {
+			ctx := logging.NewContextWithDiscard(context.Background())
+			defer ctx.Done()
+
+			data := bytes.Repeat([]byte("a"), corev1.MaxSecretSize+10)
+			secrets, err := logs.New(testenv.Client, testState.Namespace, deployItem, "pod-a").Upload(ctx, data)
+			utils.ExpectNoError(err)
+			Expect(secrets).To(HaveLen(2))
+
+			refs, err := logs.ListLogSecrets(ctx, testenv.Client, testState.Namespace, deployItem, "pod-a")
+			utils.ExpectNoError(err)
+			Expect(refs).To(Equal(secrets))
+
+			var res []byte
+			for _, ref := range refs {
+				secret := &corev1.Secret{}
+				utils.ExpectNoError(testenv.Client.Get(ctx, ref.NamespacedName(), secret))
+				res = append(res, secret.Data[logs.LogsDataKey]...)
+			}
+			Expect(res).To(Equal(data))
+		})
+
+		It("should garbage collect the logs of old runs", func() {
+			ctx := logging.NewContextWithDiscard(context.Background())
+			defer ctx.Done()
+
+			for _, podName := range []string{"pod-a", "pod-b", "pod-c"} {
+				l := logs.New(testenv.Client, testState.Namespace, deployItem, podName)
+				_, err := l.Upload(ctx, []byte("logs of "+podName))
+				utils.ExpectNoError(err)
+				utils.ExpectNoError(l.GarbageCollect(ctx, 2))
+			}
+
+			refs, err := logs.ListLogSecrets(ctx, testenv.Client, testState.Namespace, deployItem, "pod-a")
+			utils.ExpectNoError(err)
+			Expect(refs).To(BeEmpty())
+
+			secretList := &corev1.SecretList{}
+			utils.ExpectNoError(testenv.Client.List(ctx, secretList, client.InNamespace(testState.Namespace)))
+			Expect(secretList.Items).To(HaveLen(2))
+		})
+
+		It("should cleanup the logs", func() {
+			ctx := logging.NewContextWithDiscard(context.Background())
+			defer ctx.Done()
+
+			_, err := logs.New(testenv.Client, testState.Namespace, deployItem, "pod-a").Upload(ctx, []byte("logs"))
+			utils.ExpectNoError(err)
+			utils.ExpectNoError(logs.CleanupLogs(ctx, testenv.Client, testState.Namespace, deployItem))
+
+			secretList := &corev1.SecretList{}
+			utils.ExpectNoError(testenv.Client.List(ctx, secretList, client.InNamespace(testState.Namespace)))
+			Expect(secretList.Items).To(BeEmpty())
+		})
+	})
+})
@@ -117,6 +117,9 @@ type PodOptions struct {
 	DeployerID string
 
 	ProviderConfiguration             *containerv1alpha1.ProviderConfiguration
+	PodConfiguration                  containerv1alpha1.PodConfiguration
+	LogsConfiguration                 containerv1alpha1.LogsConfiguration
+	StateConfiguration                containerv1alpha1.StateConfiguration
 	InitContainer                     containerv1alpha1.ContainerSpec
 	WaitContainer                     containerv1alpha1.ContainerSpec
 	InitContainerServiceAccountSecret types.NamespacedName
@@ -241,6 +244,11 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		MountPath: container.TargetInitDir,
 	}
 
+	stateConfig, err := json.Marshal(opts.StateConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal state configuration: %w", err)
+	}
+
 	additionalInitEnvVars := []corev1.EnvVar{
 		{
 			Name:  container.ConfigurationPathName,
@@ -262,6 +270,10 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 			Name:  container.OCMConfigPathName,
 			Value: container.OCMConfigPath,
 		},
+		{
+			Name:  container.StateConfigurationName,
+			Value: string(stateConfig),
+		},
 	}
 	additionalSidecarEnvVars := []corev1.EnvVar{
 		{
@@ -272,6 +284,30 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 			Name:  container.DeployItemNamespaceName,
 			Value: opts.DeployItemNamespace,
 		},
+		{
+			Name:  container.OperationName,
+			Value: string(opts.Operation),
+		},
+		{
+			Name:  container.StateConfigurationName,
+			Value: string(stateConfig),
+		},
+	}
+	if !opts.LogsConfiguration.Disable {
+		additionalSidecarEnvVars = append(additionalSidecarEnvVars,
+			corev1.EnvVar{
+				Name:  container.LogsTailLinesName,
+				Value: strconv.FormatInt(opts.LogsConfiguration.TailLines, 10),
+			},
+			corev1.EnvVar{
+				Name:  container.LogsLimitBytesName,
+				Value: strconv.FormatInt(opts.LogsConfiguration.LimitBytes, 10),
+			},
+			corev1.EnvVar{
+				Name:  container.LogsRetentionName,
+				Value: strconv.Itoa(opts.LogsConfiguration.Retention),
+			},
+		)
 	}
 	additionalEnvVars := []corev1.EnvVar{
 		{
@@ -288,8 +324,30 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		ocmConfigVolume,
 		targetVolume,
 	}
+	volumes = append(volumes, opts.ProviderConfiguration.Volumes...)
 
 	initMounts := []corev1.VolumeMount{configurationVolumeMount, ocmConfigVolumeMount, targetInitVolumeMount, initServiceAccountMount, sharedVolumeMount}
+	waitMounts := []corev1.VolumeMount{waitServiceAccountMount, sharedVolumeMount}
+
+	// the state volume is only mounted into the init and wait container so that the main container cannot access
+	// the states of other deploy items.
+	if opts.StateConfiguration.Backend == containerv1alpha1.StateBackendPVC && opts.StateConfiguration.PVC != nil {
+		stateBackendVolume := corev1.Volume{
+			Name: "state-backend",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.StateConfiguration.PVC.ClaimName,
+				},
+			},
+		}
+		stateBackendVolumeMount := corev1.VolumeMount{
+			Name:      stateBackendVolume.Name,
+			MountPath: container.StateBackendPath,
+		}
+		volumes = append(volumes, stateBackendVolume)
+		initMounts = append(initMounts, stateBackendVolumeMount)
+		waitMounts = append(waitMounts, stateBackendVolumeMount)
+	}
 
 	for name, v := range map[string]string{
 		"blueprint-pull-secret": opts.BluePrintPullSecret,
@@ -324,6 +382,7 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          opts.InitContainer.ImagePullPolicy,
 		VolumeMounts:             initMounts,
+		SecurityContext:          restrictedSecurityContext(),
 	}
 
 	waitContainer := corev1.Container{
@@ -335,10 +394,8 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		Resources:                corev1.ResourceRequirements{},
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          opts.WaitContainer.ImagePullPolicy,
-		VolumeMounts: []corev1.VolumeMount{
-			waitServiceAccountMount,
-			sharedVolumeMount,
-		},
+		VolumeMounts:             waitMounts,
+		SecurityContext:          restrictedSecurityContext(),
 	}
 
 	mainContainer := corev1.Container{
@@ -346,11 +403,17 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		Image:                    opts.ProviderConfiguration.Image,
 		Command:                  opts.ProviderConfiguration.Command,
 		Args:                     opts.ProviderConfiguration.Args,
-		Env:                      append(container.DefaultEnvVars, additionalEnvVars...),
+		Env:                      append(append(container.DefaultEnvVars, additionalEnvVars...), opts.ProviderConfiguration.Env...),
 		Resources:                corev1.ResourceRequirements{},
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          corev1.PullIfNotPresent,
-		VolumeMounts:             []corev1.VolumeMount{sharedVolumeMount},
+		VolumeMounts:             append([]corev1.VolumeMount{sharedVolumeMount}, opts.ProviderConfiguration.VolumeMounts...),
+		SecurityContext:          mainContainerSecurityContext(opts.PodConfiguration, opts.ProviderConfiguration),
+	}
+	if opts.ProviderConfiguration.Resources != nil {
+		mainContainer.Resources = *opts.ProviderConfiguration.Resources
+	} else if opts.PodConfiguration.DefaultResources != nil {
+		mainContainer.Resources = *opts.PodConfiguration.DefaultResources
 	}
 
 	if opts.Debug {
@@ -373,6 +436,17 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		RunAsUser:  ptr.To[int64](1000),
 		RunAsGroup: ptr.To[int64](3000),
 		FSGroup:    ptr.To[int64](2000),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+	pod.Spec.NodeSelector = opts.ProviderConfiguration.NodeSelector
+	if len(pod.Spec.NodeSelector) == 0 {
+		pod.Spec.NodeSelector = opts.PodConfiguration.DefaultNodeSelector
+	}
+	pod.Spec.Tolerations = opts.ProviderConfiguration.Tolerations
+	if len(pod.Spec.Tolerations) == 0 {
+		pod.Spec.Tolerations = opts.PodConfiguration.DefaultTolerations
 	}
 	pod.Spec.InitContainers = []corev1.Container{initContainer}
 	pod.Spec.Containers = []corev1.Container{mainContainer, waitContainer}
@@ -386,9 +460,88 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	return pod, nil
 }
 
+// restrictedSecurityContext returns a container security context that complies with the restricted pod security standard.
+func restrictedSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		RunAsNonRoot:             ptr.To(true),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// mainContainerSecurityContext returns the security context of the main container.
+// The default security context of the deployer configuration and the security context of the deploy item
+// are merged onto the restricted security context, so that unset fields keep their restricted values.
+func mainContainerSecurityContext(podConfig containerv1alpha1.PodConfiguration, providerConfig *containerv1alpha1.ProviderConfiguration) *corev1.SecurityContext {
+	sc := restrictedSecurityContext()
+	mergeSecurityContext(sc, podConfig.DefaultSecurityContext)
+	mergeSecurityContext(sc, providerConfig.SecurityContext)
+	return sc
+}
+
+// mergeSecurityContext overwrites the fields of the security context with the fields that are set in overwrite.
+// Added capabilities keep the dropped capabilities unless overwrite drops capabilities itself.
+func mergeSecurityContext(sc, overwrite *corev1.SecurityContext) {
+	if overwrite == nil {
+		return
+	}
+	overwrite = overwrite.DeepCopy()
+	if overwrite.Capabilities != nil {
+		if len(overwrite.Capabilities.Drop) == 0 && sc.Capabilities != nil {
+			overwrite.Capabilities.Drop = sc.Capabilities.Drop
+		}
+		sc.Capabilities = overwrite.Capabilities
+	}
+	if overwrite.Privileged != nil {
+		sc.Privileged = overwrite.Privileged
+	}
+	if overwrite.SELinuxOptions != nil {
+		sc.SELinuxOptions = overwrite.SELinuxOptions
+	}
+	if overwrite.WindowsOptions != nil {
+		sc.WindowsOptions = overwrite.WindowsOptions
+	}
+	if overwrite.RunAsUser != nil {
+		sc.RunAsUser = overwrite.RunAsUser
+	}
+	if overwrite.RunAsGroup != nil {
+		sc.RunAsGroup = overwrite.RunAsGroup
+	}
+	if overwrite.RunAsNonRoot != nil {
+		sc.RunAsNonRoot = overwrite.RunAsNonRoot
+	}
+	if overwrite.ReadOnlyRootFilesystem != nil {
+		sc.ReadOnlyRootFilesystem = overwrite.ReadOnlyRootFilesystem
+	}
+	if overwrite.AllowPrivilegeEscalation != nil {
+		sc.AllowPrivilegeEscalation = overwrite.AllowPrivilegeEscalation
+	}
+	if overwrite.ProcMount != nil {
+		sc.ProcMount = overwrite.ProcMount
+	}
+	if overwrite.SeccompProfile != nil {
+		sc.SeccompProfile = overwrite.SeccompProfile
+	}
+	if overwrite.AppArmorProfile != nil {
+		sc.AppArmorProfile = overwrite.AppArmorProfile
+	}
+}
+
 // getPod returns the latest executed pod.
 // Pods that have no finalizer are ignored.
+// If the operations are run as jobs, the pod of the latest job is returned.
 func (c *Container) getPod(ctx context.Context) (*corev1.Pod, error) {
+	if c.Configuration.Job != nil {
+		pod, job, err := c.getJobPod(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.job = job
+		return pod, nil
+	}
+
 	podList := &corev1.PodList{}
 	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000077,
 		client.InNamespace(c.Configuration.Namespace), client.MatchingLabels{
@@ -524,14 +677,15 @@ func EnsureServiceAccounts(ctx context.Context, hostClient client.Client, deploy
 			// See https://kubernetes.io/docs/reference/access-authn-authz/rbac/
 			// "You cannot restrict create or deletecollection requests by resourceName. For create, this limitation is because the object name is not known at authorization time."
 			// the ait container needs permissions to write secrets for its state.
+			// deletion is needed for the garbage collection of the logs of old runs.
 			{
 				APIGroups: []string{corev1.SchemeGroupVersion.Group},
 				Resources: []string{"secrets"},
-				Verbs:     []string{"create", "update", "get", "list"},
+				Verbs:     []string{"create", "update", "get", "list", "delete"},
 			},
 			{
 				APIGroups: []string{corev1.SchemeGroupVersion.Group},
-				Resources: []string{"pods"},
+				Resources: []string{"pods", "pods/log"},
 				Verbs:     []string{"get"},
 			},
 		}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+var _ = Describe("Pod", func() {
+
+	var opts PodOptions
+
+	BeforeEach(func() {
+		opts = PodOptions{
+			ProviderConfiguration: &containerv1alpha1.ProviderConfiguration{Image: "example.com/image:1.0.0"},
+			Name:                  "my-di",
+			Namespace:             "host",
+			DeployItemName:        "my-di",
+			DeployItemNamespace:   "default",
+			Operation:             container.OperationReconcile,
+		}
+	})
+
+	getMainContainer := func(pod *corev1.Pod) corev1.Container {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == container.MainContainerName {
+				return c
+			}
+		}
+		Fail("the pod has no main container")
+		return corev1.Container{}
+	}
+
+	It("should run the main container with the restricted defaults", func() {
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		main := getMainContainer(pod)
+		Expect(main.SecurityContext).To(Equal(restrictedSecurityContext()))
+		Expect(main.Resources).To(Equal(corev1.ResourceRequirements{}))
+		Expect(pod.Spec.NodeSelector).To(BeEmpty())
+		Expect(pod.Spec.Tolerations).To(BeEmpty())
+	})
+
+	It("should use the defaults of the pod configuration", func() {
+		resources := &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		}
+		opts.PodConfiguration = containerv1alpha1.PodConfiguration{
+			DefaultResources:       resources,
+			DefaultNodeSelector:    map[string]string{"pool": "default"},
+			DefaultTolerations:     []corev1.Toleration{{Key: "default", Operator: corev1.TolerationOpExists}},
+			DefaultSecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+		}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		main := getMainContainer(pod)
+		Expect(main.Resources).To(Equal(*resources))
+		Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"pool": "default"}))
+		Expect(pod.Spec.Tolerations).To(ConsistOf(HaveField("Key", "default")))
+
+		expected := restrictedSecurityContext()
+		expected.ReadOnlyRootFilesystem = ptr.To(true)
+		Expect(main.SecurityContext).To(Equal(expected))
+	})
+
+	It("should prefer the settings of the deploy item over the defaults", func() {
+		opts.PodConfiguration = containerv1alpha1.PodConfiguration{
+			DefaultResources:       &corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}},
+			DefaultNodeSelector:    map[string]string{"pool": "default"},
+			DefaultTolerations:     []corev1.Toleration{{Key: "default", Operator: corev1.TolerationOpExists}},
+			DefaultSecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true), RunAsUser: ptr.To[int64](1000)},
+		}
+		resources := &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+		opts.ProviderConfiguration.Resources = resources
+		opts.ProviderConfiguration.NodeSelector = map[string]string{"pool": "custom"}
+		opts.ProviderConfiguration.Tolerations = []corev1.Toleration{{Key: "custom", Operator: corev1.TolerationOpExists}}
+		opts.ProviderConfiguration.SecurityContext = &corev1.SecurityContext{
+			RunAsUser: ptr.To[int64](2000),
+			Capabilities: &corev1.Capabilities{
+				Add: []corev1.Capability{"NET_BIND_SERVICE"},
+			},
+		}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		main := getMainContainer(pod)
+		Expect(main.Resources).To(Equal(*resources))
+		Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"pool": "custom"}))
+		Expect(pod.Spec.Tolerations).To(ConsistOf(HaveField("Key", "custom")))
+		Expect(main.SecurityContext).To(Equal(&corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			RunAsNonRoot:             ptr.To(true),
+			RunAsUser:                ptr.To[int64](2000),
+			ReadOnlyRootFilesystem:   ptr.To(true),
+			Capabilities: &corev1.Capabilities{
+				Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+				Drop: []corev1.Capability{"ALL"},
+			},
+		}))
+	})
+
+	It("should keep the restricted defaults for an empty security context of the deploy item", func() {
+		opts.ProviderConfiguration.SecurityContext = &corev1.SecurityContext{}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getMainContainer(pod).SecurityContext).To(Equal(restrictedSecurityContext()))
+	})
+
+	It("should always run the init and wait containers with the restricted security context", func() {
+		opts.ProviderConfiguration.SecurityContext = &corev1.SecurityContext{Privileged: ptr.To(true)}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(pod.Spec.InitContainers).To(HaveLen(1))
+		Expect(pod.Spec.InitContainers[0].SecurityContext).To(Equal(restrictedSecurityContext()))
+		for _, c := range pod.Spec.Containers {
+			if c.Name == container.WaitContainerName {
+				Expect(c.SecurityContext).To(Equal(restrictedSecurityContext()))
+			}
+		}
+		Expect(getMainContainer(pod).SecurityContext.Privileged).To(Equal(ptr.To(true)))
+	})
+})
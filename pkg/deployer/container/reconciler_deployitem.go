@@ -8,11 +8,13 @@ import (
 	"context"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	container1alpha1validation "github.com/gardener/landscaper/apis/deployer/container/v1alpha1/validation"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
@@ -29,6 +31,10 @@ func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCache
 	log logging.Logger,
 	config containerv1alpha1.Configuration) (*deployer, error) {
 
+	if err := container1alpha1validation.ValidateStateConfiguration(field.NewPath("state"), &config.State); err != nil {
+		return nil, err
+	}
+
 	dep := &deployer{
 		lsUncachedClient:   lsUncachedClient,
 		lsCachedClient:     lsCachedClient,
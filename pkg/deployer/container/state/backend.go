@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// StateBackend stores the archived state of a deploy item.
+type StateBackend interface {
+	// Save stores the archived state that is read from the given reader and replaces the previously stored state.
+	// The reader is seekable, so that backends are able to determine the size of the state.
+	Save(ctx context.Context, data io.ReadSeeker) error
+	// Load returns a reader for the latest stored state, which has to be closed by the caller.
+	// Nil is returned if no state has been stored yet.
+	Load(ctx context.Context) (io.ReadCloser, error)
+	// Delete deletes all stored states of the deploy item.
+	Delete(ctx context.Context) error
+}
+
+// NewBackend creates the state backend that is defined by the state configuration.
+// The secrets that are referenced in the configuration are read from the given namespace.
+func NewBackend(ctx context.Context, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference, config *containerv1alpha1.StateConfiguration) (StateBackend, error) {
+	switch config.Backend {
+	case "", containerv1alpha1.StateBackendSecret:
+		return NewSecretBackend(kubeClient, namespace, deployItem), nil
+	case containerv1alpha1.StateBackendPVC:
+		return NewPVCBackend(container.StateBackendPath, deployItem), nil
+	case containerv1alpha1.StateBackendObjectStore:
+		if config.ObjectStore == nil {
+			return nil, fmt.Errorf("no object store is configured")
+		}
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Name: config.ObjectStore.CredentialsSecretRef.Name, Namespace: namespace}
+		if err := read_write_layer.GetSecret(ctx, kubeClient, key, secret, read_write_layer.R000135); err != nil {
+			return nil, fmt.Errorf("unable to get credentials of the object store: %w", err)
+		}
+		return NewObjectStoreBackend(config.ObjectStore,
+			string(secret.Data[ObjectStoreAccessKeyIDKey]),
+			string(secret.Data[ObjectStoreSecretAccessKeyKey]),
+			deployItem), nil
+	default:
+		return nil, fmt.Errorf("unknown state backend %q", config.Backend)
+	}
+}
+
+// GetEncryptionKey reads the key that is used to encrypt the state from the given namespace.
+func GetEncryptionKey(ctx context.Context, kubeClient client.Client, namespace string, encryption *containerv1alpha1.StateEncryption) ([]byte, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Name: encryption.KeySecretRef.Name, Namespace: namespace}
+	if err := read_write_layer.GetSecret(ctx, kubeClient, key, secret, read_write_layer.R000136); err != nil {
+		return nil, fmt.Errorf("unable to get state encryption key: %w", err)
+	}
+	data, ok := secret.Data[encryption.KeySecretRef.Key]
+	if !ok {
+		return nil, fmt.Errorf("state encryption key secret %s has no key %q", key.String(), encryption.KeySecretRef.Key)
+	}
+	if err := validateEncryptionKey(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	"github.com/gardener/landscaper/test/utils"
+)
+
+// fakeObjectStore is a minimal in-memory stand-in for a s3 compatible object store with path style addressing.
+type fakeObjectStore struct {
+	mux     sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[r.URL.Path] = data
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+var _ = Describe("Container Deployer State Backends", func() {
+
+	var (
+		ctx        context.Context
+		deployItem = lsv1alpha1.ObjectReference{Name: "testname", Namespace: "testns"}
+		testDir    = "/mystate"
+		testFile   = path.Join(testDir, "my-file")
+		testData   = []byte("text")
+		fs         vfs.FileSystem
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		fs = memoryfs.New()
+		utils.ExpectNoError(fs.MkdirAll(testDir, os.ModePerm))
+		utils.ExpectNoError(vfs.WriteFile(fs, testFile, testData, os.ModePerm))
+	})
+
+	expectRestoredState := func(s *state.State) {
+		resFs := memoryfs.New()
+		utils.ExpectNoError(s.WithFs(resFs).Restore(ctx))
+		resData, err := vfs.ReadFile(resFs, testFile)
+		utils.ExpectNoError(err)
+		Expect(resData).To(Equal(testData))
+	}
+
+	Context("PVC", func() {
+		It("should save, restore and delete the state on the volume", func() {
+			volumeFs := memoryfs.New()
+			backend := state.NewPVCBackend("/state-backend", deployItem).WithFs(volumeFs)
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend)
+
+			utils.ExpectNoError(s.Backup(ctx))
+			_, err := volumeFs.Stat("/state-backend/testns/testname/state")
+			utils.ExpectNoError(err)
+			expectRestoredState(s)
+
+			utils.ExpectNoError(s.Delete(ctx))
+			_, err = volumeFs.Stat("/state-backend/testns/testname")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("should restore nothing if no state has been saved", func() {
+			backend := state.NewPVCBackend("/state-backend", deployItem).WithFs(memoryfs.New())
+			resFs := memoryfs.New()
+			s := state.New(nil, "", deployItem, testDir).WithFs(resFs).WithBackend(backend)
+			utils.ExpectNoError(s.Restore(ctx))
+			files, err := vfs.ReadDir(resFs, testDir)
+			utils.ExpectNoError(err)
+			Expect(files).To(BeEmpty())
+		})
+	})
+
+	Context("Encryption", func() {
+		var (
+			volumeFs vfs.FileSystem
+			backend  *state.PVCBackend
+			key      = []byte("0123456789abcdef0123456789abcdef")
+		)
+
+		BeforeEach(func() {
+			volumeFs = memoryfs.New()
+			backend = state.NewPVCBackend("/state-backend", deployItem).WithFs(volumeFs)
+		})
+
+		It("should encrypt the stored state", func() {
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).WithEncryptionKey(key)
+			utils.ExpectNoError(s.Backup(ctx))
+
+			plain := state.New(nil, "", deployItem, testDir).WithBackend(backend)
+			Expect(plain.WithFs(memoryfs.New()).Restore(ctx)).To(MatchError(ContainSubstring("no encryption key")))
+
+			wrongKey := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithEncryptionKey([]byte("fedcba9876543210"))
+			Expect(wrongKey.WithFs(memoryfs.New()).Restore(ctx)).To(HaveOccurred())
+
+			expectRestoredState(s)
+		})
+
+		It("should restore an unencrypted state after the encryption has been enabled", func() {
+			utils.ExpectNoError(state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).Backup(ctx))
+			expectRestoredState(state.New(nil, "", deployItem, testDir).WithBackend(backend).WithEncryptionKey(key))
+		})
+
+		It("should encrypt and restore states that consist of multiple segments", func() {
+			largeData := make([]byte, 300*1024)
+			_, err := rand.Read(largeData)
+			utils.ExpectNoError(err)
+			utils.ExpectNoError(vfs.WriteFile(fs, path.Join(testDir, "large-file"), largeData, os.ModePerm))
+
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).WithEncryptionKey(key)
+			utils.ExpectNoError(s.Backup(ctx))
+
+			resFs := memoryfs.New()
+			utils.ExpectNoError(s.WithFs(resFs).Restore(ctx))
+			resData, err := vfs.ReadFile(resFs, path.Join(testDir, "large-file"))
+			utils.ExpectNoError(err)
+			Expect(resData).To(Equal(largeData))
+		})
+
+		It("should reject a truncated state", func() {
+			largeData := make([]byte, 300*1024)
+			_, err := rand.Read(largeData)
+			utils.ExpectNoError(err)
+			utils.ExpectNoError(vfs.WriteFile(fs, path.Join(testDir, "large-file"), largeData, os.ModePerm))
+
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).WithEncryptionKey(key)
+			utils.ExpectNoError(s.Backup(ctx))
+
+			stateFile := "/state-backend/testns/testname/state"
+			data, err := vfs.ReadFile(volumeFs, stateFile)
+			utils.ExpectNoError(err)
+			utils.ExpectNoError(vfs.WriteFile(volumeFs, stateFile, data[:len(data)-100*1024], os.ModePerm))
+
+			Expect(s.WithFs(memoryfs.New()).Restore(ctx)).To(MatchError(ContainSubstring("unable to decrypt state")))
+		})
+
+		It("should reject keys with an invalid length", func() {
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).WithEncryptionKey([]byte("short"))
+			Expect(s.Backup(ctx)).To(MatchError(ContainSubstring("invalid state encryption key length")))
+		})
+	})
+
+	Context("Migration", func() {
+		var (
+			previousFs vfs.FileSystem
+			previous   *state.PVCBackend
+			backend    *state.PVCBackend
+		)
+
+		BeforeEach(func() {
+			previousFs = memoryfs.New()
+			previous = state.NewPVCBackend("/previous", deployItem).WithFs(previousFs)
+			backend = state.NewPVCBackend("/state-backend", deployItem).WithFs(memoryfs.New())
+		})
+
+		It("should restore the state from the previous backend and migrate it with the next backup", func() {
+			utils.ExpectNoError(state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(previous).Backup(ctx))
+
+			s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFallbackBackend(previous)
+			expectRestoredState(s)
+
+			utils.ExpectNoError(s.Backup(ctx))
+			data, err := previous.Load(ctx)
+			utils.ExpectNoError(err)
+			Expect(data).To(BeNil())
+			expectRestoredState(state.New(nil, "", deployItem, testDir).WithBackend(backend))
+		})
+
+		It("should prefer the state of the configured backend", func() {
+			utils.ExpectNoError(previousFs.MkdirAll("/previous/testns/testname", os.ModePerm))
+			utils.ExpectNoError(vfs.WriteFile(previousFs, "/previous/testns/testname/state", []byte("invalid"), os.ModePerm))
+			utils.ExpectNoError(state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend).Backup(ctx))
+
+			expectRestoredState(state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFallbackBackend(previous))
+		})
+	})
+
+	Context("ObjectStore", func() {
+		var (
+			store  *fakeObjectStore
+			server *httptest.Server
+			config *containerv1alpha1.ObjectStoreStateBackend
+		)
+
+		BeforeEach(func() {
+			store = &fakeObjectStore{objects: map[string][]byte{}}
+			server = httptest.NewServer(store)
+			config = &containerv1alpha1.ObjectStoreStateBackend{
+				Endpoint:     server.URL,
+				Region:       "us-east-1",
+				Bucket:       "states",
+				Prefix:       "landscaper",
+				UsePathStyle: true,
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should save, restore and delete the state object", func() {
+			backend := state.NewObjectStoreBackend(config, "access", "secret", deployItem)
+			s := state.New(nil, "", deployItem, testDir).WithFs(fs).WithBackend(backend)
+
+			utils.ExpectNoError(s.Backup(ctx))
+			Expect(store.objects).To(HaveKey("/states/landscaper/testns/testname/state"))
+			expectRestoredState(s)
+
+			utils.ExpectNoError(s.Delete(ctx))
+			Expect(store.objects).To(BeEmpty())
+		})
+
+		It("should restore nothing if the state object does not exist", func() {
+			backend := state.NewObjectStoreBackend(config, "access", "secret", deployItem)
+			data, err := backend.Load(ctx)
+			utils.ExpectNoError(err)
+			Expect(data).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// encryptedStateHeader is prepended to encrypted states.
+// It distinguishes them from unencrypted states, which are still restored after the encryption has been enabled.
+var encryptedStateHeader = []byte("landscaper-state-aes-gcm-stream-v1\n")
+
+// encryptionSegmentSize is the size of the segments that are encrypted separately,
+// so that a state can be encrypted and decrypted as a stream.
+const encryptionSegmentSize = 64 * 1024
+
+// noncePrefixSize is the size of the random part of the nonces.
+// The nonce of a segment consists of the random prefix of the state, the number of the segment,
+// and a flag that marks the last segment, so that segments can neither be reordered nor truncated.
+const noncePrefixSize = 7
+
+// validateEncryptionKey checks that the key selects one of AES-128, AES-192 or AES-256.
+func validateEncryptionKey(key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	default:
+		return fmt.Errorf("invalid state encryption key length %d, expected 16, 24 or 32 bytes", len(key))
+	}
+}
+
+// isEncrypted checks whether the state of the given reader has been encrypted.
+func isEncrypted(r *bufio.Reader) bool {
+	header, _ := r.Peek(len(encryptedStateHeader))
+	return bytes.Equal(header, encryptedStateHeader)
+}
+
+// encryptingWriter encrypts the written state with AES-GCM.
+// The result consists of the header, the random nonce prefix and the sealed segments.
+type encryptingWriter struct {
+	gcm     cipher.AEAD
+	w       io.Writer
+	prefix  []byte
+	counter uint32
+	buf     []byte
+}
+
+// newEncryptingWriter writes the header of an encrypted state to the given writer
+// and returns a writer that encrypts the state. The writer has to be closed to write the last segment.
+func newEncryptingWriter(key []byte, w io.Writer) (io.WriteCloser, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	if _, err := w.Write(append(append([]byte{}, encryptedStateHeader...), prefix...)); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		gcm:    gcm,
+		w:      w,
+		prefix: prefix,
+		buf:    make([]byte, 0, encryptionSegmentSize),
+	}, nil
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	// a full segment is only sealed if more data follows, as the last segment is sealed differently.
+	offset := 0
+	for len(e.buf)-offset > encryptionSegmentSize {
+		if err := e.writeSegment(e.buf[offset:offset+encryptionSegmentSize], false); err != nil {
+			return 0, err
+		}
+		offset += encryptionSegmentSize
+	}
+	e.buf = append(e.buf[:0], e.buf[offset:]...)
+	return len(p), nil
+}
+
+// Close writes the last segment.
+func (e *encryptingWriter) Close() error {
+	return e.writeSegment(e.buf, true)
+}
+
+func (e *encryptingWriter) writeSegment(plaintext []byte, last bool) error {
+	if e.counter == math.MaxUint32 {
+		return errors.New("the state is too large to be encrypted")
+	}
+	if _, err := e.w.Write(e.gcm.Seal(nil, segmentNonce(e.prefix, e.counter, last), plaintext, nil)); err != nil {
+		return err
+	}
+	e.counter++
+	return nil
+}
+
+// decryptingReader decrypts a state that has been encrypted by the encryptingWriter.
+type decryptingReader struct {
+	gcm     cipher.AEAD
+	r       *bufio.Reader
+	prefix  []byte
+	counter uint32
+	// sealed is the buffer for the current segment.
+	sealed []byte
+	// plaintext is the unread part of the current decrypted segment.
+	plaintext []byte
+	last      bool
+}
+
+// newDecryptingReader reads the header of an encrypted state and returns a reader for the decrypted state.
+func newDecryptingReader(key []byte, r *bufio.Reader) (io.Reader, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, len(encryptedStateHeader)+noncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("encrypted state is too short")
+	}
+	if !bytes.HasPrefix(header, encryptedStateHeader) {
+		return nil, fmt.Errorf("the state is not encrypted")
+	}
+	return &decryptingReader{
+		gcm:    gcm,
+		r:      r,
+		prefix: header[len(encryptedStateHeader):],
+		sealed: make([]byte, encryptionSegmentSize+gcm.Overhead()),
+	}, nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.last {
+			return 0, io.EOF
+		}
+		if err := d.readSegment(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) readSegment() error {
+	n, err := io.ReadFull(d.r, d.sealed)
+	switch {
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		d.last = true
+	case err != nil:
+		return fmt.Errorf("unable to read encrypted state: %w", err)
+	default:
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			d.last = true
+		} else if err != nil {
+			return fmt.Errorf("unable to read encrypted state: %w", err)
+		}
+	}
+
+	// the segment is decrypted in place
+	plaintext, err := d.gcm.Open(d.sealed[:0], segmentNonce(d.prefix, d.counter, d.last), d.sealed[:n], nil)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt state: %w", err)
+	}
+	d.plaintext = plaintext
+	d.counter++
+	return nil
+}
+
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if err := validateEncryptionKey(key); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+const (
+	// ObjectStoreAccessKeyIDKey is the key of the access key in the credentials secret of the object store.
+	ObjectStoreAccessKeyIDKey = "accessKeyID"
+	// ObjectStoreSecretAccessKeyKey is the key of the secret key in the credentials secret of the object store.
+	ObjectStoreSecretAccessKeyKey = "secretAccessKey"
+)
+
+// objectStoreStateObjectName is the name of the object that contains the state of a deploy item.
+const objectStoreStateObjectName = "state"
+
+// ObjectStoreBackend stores the state of a deploy item as object in a s3 compatible object store.
+type ObjectStoreBackend struct {
+	client *s3.Client
+	bucket string
+	// key is the key of the state object of the deploy item.
+	key string
+}
+
+var _ StateBackend = &ObjectStoreBackend{}
+
+// NewObjectStoreBackend creates a new state backend for the configured object store.
+func NewObjectStoreBackend(config *containerv1alpha1.ObjectStoreStateBackend, accessKeyID, secretAccessKey string, deployItem lsv1alpha1.ObjectReference) *ObjectStoreBackend {
+	opts := s3.Options{
+		Region:       config.Region,
+		Credentials:  credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+		UsePathStyle: config.UsePathStyle,
+		// s3 compatible object stores do not necessarily support the default checksums of the aws sdk.
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	}
+	if len(config.Endpoint) != 0 {
+		opts.BaseEndpoint = aws.String(config.Endpoint)
+	}
+	return &ObjectStoreBackend{
+		client: s3.New(opts),
+		bucket: config.Bucket,
+		key:    path.Join(config.Prefix, deployItem.Namespace, deployItem.Name, objectStoreStateObjectName),
+	}
+}
+
+// Save uploads the state object and replaces the previous one.
+func (b *ObjectStoreBackend) Save(ctx context.Context, data io.ReadSeeker) error {
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key),
+		Body:   data,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upload state object %s: %w", b.key, err)
+	}
+	return nil
+}
+
+// Load returns the body of the state object, which is downloaded while it is read.
+func (b *ObjectStoreBackend) Load(ctx context.Context) (io.ReadCloser, error) {
+	out, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key),
+	})
+	if err != nil {
+		var noSuchKey *s3types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to download state object %s: %w", b.key, err)
+	}
+	return out.Body, nil
+}
+
+// Delete deletes the state object.
+func (b *ObjectStoreBackend) Delete(ctx context.Context) error {
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete state object %s: %w", b.key, err)
+	}
+	return nil
+}
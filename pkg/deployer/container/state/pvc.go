@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// pvcStateFileName is the name of the file that contains the state of a deploy item on the volume.
+const pvcStateFileName = "state"
+
+// PVCBackend stores the state of a deploy item in a file on a persistent volume
+// that is mounted into the init and wait containers.
+type PVCBackend struct {
+	fs vfs.FileSystem
+	// path is the directory of the deploy item on the volume.
+	path string
+}
+
+var _ StateBackend = &PVCBackend{}
+
+// NewPVCBackend creates a new state backend that stores the state below the given path.
+func NewPVCBackend(basePath string, deployItem lsv1alpha1.ObjectReference) *PVCBackend {
+	return &PVCBackend{
+		fs:   osfs.New(),
+		path: filepath.Join(basePath, deployItem.Namespace, deployItem.Name),
+	}
+}
+
+// WithFs sets the fs of the backend.
+func (b *PVCBackend) WithFs(fs vfs.FileSystem) *PVCBackend {
+	b.fs = fs
+	return b
+}
+
+// Save writes the state to a temporary file and renames it afterwards,
+// so that the previous state is kept if the write fails.
+func (b *PVCBackend) Save(_ context.Context, data io.ReadSeeker) error {
+	if err := b.fs.MkdirAll(b.path, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create state directory %q: %w", b.path, err)
+	}
+	tmpPath := filepath.Join(b.path, pvcStateFileName+".tmp")
+	file, err := b.fs.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("unable to write state: %w", err)
+	}
+	if _, err := io.Copy(file, data); err != nil {
+		file.Close()
+		return fmt.Errorf("unable to write state: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to write state: %w", err)
+	}
+	if err := b.fs.Rename(tmpPath, filepath.Join(b.path, pvcStateFileName)); err != nil {
+		return fmt.Errorf("unable to write state: %w", err)
+	}
+	return nil
+}
+
+// Load reads the state from the volume.
+func (b *PVCBackend) Load(_ context.Context) (io.ReadCloser, error) {
+	file, err := b.fs.Open(filepath.Join(b.path, pvcStateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read state: %w", err)
+	}
+	return file, nil
+}
+
+// Delete removes the state directory of the deploy item from the volume.
+func (b *PVCBackend) Delete(_ context.Context) error {
+	return b.fs.RemoveAll(b.path)
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2020 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// SecretBackend stores the state of a deploy item in chunks of 1MB in secrets in the host cluster.
+type SecretBackend struct {
+	deployItem lsv1alpha1.ObjectReference
+	// namespace is the namespace where the state secrets should be created.
+	namespace  string
+	kubeClient client.Client
+}
+
+var _ StateBackend = &SecretBackend{}
+
+// NewSecretBackend creates a new state backend that stores the state in secrets in the given namespace.
+func NewSecretBackend(kubeClient client.Client, namespace string, deployItemKey lsv1alpha1.ObjectReference) *SecretBackend {
+	return &SecretBackend{
+		deployItem: deployItemKey,
+		namespace:  namespace,
+		kubeClient: kubeClient,
+	}
+}
+
+// StateSecretListOptions returns the list options for all state secrets of a deploy item
+func StateSecretListOptions(namespace string, deployItem lsv1alpha1.ObjectReference) []client.ListOption {
+	labelSelector := client.MatchingLabels{
+		container.ContainerDeployerDeployItemNameLabel:      deployItem.Name,
+		container.ContainerDeployerDeployItemNamespaceLabel: deployItem.Namespace,
+		container.ContainerDeployerTypeLabel:                container.ContainerDeployerTypeState,
+	}
+	return []client.ListOption{labelSelector, client.InNamespace(namespace)}
+}
+
+// Save splits the state in chunks of 1MB (Secret size limit)
+// and uploads the chunks as secrets to the configured k8s cluster.
+// The secrets of previous states are garbage collected with the next restore.
+func (b *SecretBackend) Save(ctx context.Context, data io.ReadSeeker) error {
+	const chunkSize = corev1.MaxSecretSize // 1 MB
+	uuidString := uuid.New().String()
+	buf := make([]byte, chunkSize)
+	for count := 0; ; count++ {
+		n, err := io.ReadFull(data, buf)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("unable to read state: %w", err)
+		}
+		// the chunk is copied as the buffer is reused for the next chunk
+		chunk := bytes.Clone(buf[:n])
+
+		secret := &corev1.Secret{}
+		secret.GenerateName = fmt.Sprintf("state-%s-%s-", b.deployItem.Namespace, b.deployItem.Name)
+		secret.Namespace = b.namespace
+		secret.Labels = map[string]string{
+			container.ContainerDeployerDeployItemNameLabel:      b.deployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: b.deployItem.Namespace,
+			container.ContainerDeployerTypeLabel:                container.ContainerDeployerTypeState,
+		}
+		secret.Annotations = map[string]string{
+			container.ContainerDeployerStateUUIDAnnotation: uuidString,
+			container.ContainerDeployerStateNumAnnotation:  strconv.Itoa(count),
+		}
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: chunk,
+		}
+
+		if err := b.kubeClient.Create(ctx, secret); err != nil {
+			return err
+		}
+		if n < chunkSize {
+			return nil
+		}
+	}
+}
+
+// Load reads the latest state from the k8s cluster and garbage collects the secrets of older states.
+func (b *SecretBackend) Load(ctx context.Context) (io.ReadCloser, error) {
+	if len(b.deployItem.Name) == 0 || len(b.deployItem.Namespace) == 0 {
+		return nil, fmt.Errorf("a deployitem has to be defined")
+	}
+	if len(b.namespace) == 0 {
+		return nil, fmt.Errorf("a target namespace has to be defined")
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, b.kubeClient, secretList, read_write_layer.R000078,
+		StateSecretListOptions(b.namespace, b.deployItem)...); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	// the secrets are grouped by uuid and sorted by their creation date
+	log.Info("Restoring state from secrets", "secretCount", len(secretList.Items))
+	secrets := map[string][]*corev1.Secret{}
+	var newest *corev1.Secret
+	for _, secret := range secretList.Items {
+		if newest == nil || newest.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			newest = &secret
+		}
+		uuidStr := secret.Annotations[container.ContainerDeployerStateUUIDAnnotation]
+		secrets[uuidStr] = append(secrets[uuidStr], &secret)
+	}
+	if newest == nil {
+		return nil, nil
+	}
+	newestUuid := newest.Annotations[container.ContainerDeployerStateUUIDAnnotation]
+	data, err := readFromSecrets(secrets[newestUuid])
+	if err != nil {
+		return nil, err
+	}
+
+	// garbage collect the old states
+	wg := sync.WaitGroup{}
+	for uuidStr, sc := range secrets {
+		if uuidStr == newestUuid {
+			continue
+		}
+		wg.Add(1)
+		go func(ctx context.Context, secrets []*corev1.Secret) {
+			defer wg.Done()
+			b.gcOldSecrets(ctx, secrets)
+		}(ctx, sc)
+	}
+	wg.Wait()
+
+	return io.NopCloser(data), nil
+}
+
+// Delete deletes all state secrets of the deploy item.
+func (b *SecretBackend) Delete(ctx context.Context) error {
+	return CleanupState(ctx, logging.FromContextOrDiscard(ctx), b.kubeClient, b.namespace, b.deployItem)
+}
+
+func readFromSecrets(secrets []*corev1.Secret) (io.Reader, error) {
+	sort.Sort(stateSecretsList(secrets))
+
+	chunks := make([]io.Reader, 0, len(secrets))
+	for _, secret := range secrets {
+		chunk, ok := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
+		if !ok {
+			return nil, fmt.Errorf("expected chunk in secret %s", secret.Name)
+		}
+		chunks = append(chunks, bytes.NewReader(chunk))
+	}
+	return io.MultiReader(chunks...), nil
+}
+
+func (b *SecretBackend) gcOldSecrets(ctx context.Context, secrets []*corev1.Secret) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	for _, secret := range secrets {
+		if err := b.kubeClient.Delete(ctx, secret); err != nil {
+			log.Error(err, "Unable to delete old state secret %s in namespace %s", secret.Name, secret.Namespace)
+		}
+		log.Info("Successfully garbage collected", lc.KeyResource, secret.Name)
+	}
+}
+
+type stateSecretsList []*corev1.Secret
+
+func (s stateSecretsList) Len() int { return len(s) }
+
+func (s stateSecretsList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s stateSecretsList) Less(i, j int) bool {
+	numI, _ := strconv.Atoi(s[i].Annotations[container.ContainerDeployerStateNumAnnotation])
+	numJ, _ := strconv.Atoi(s[j].Annotations[container.ContainerDeployerStateNumAnnotation])
+	return numI < numJ
+}
+
+// CleanupState deletes all state secrets for a deployitem
+func CleanupState(ctx context.Context, log logging.Logger, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference) error {
+	secretList := &corev1.SecretList{}
+	if err := read_write_layer.ListSecrets(ctx, kubeClient, secretList, read_write_layer.R000079,
+		StateSecretListOptions(namespace, deployItem)...); err != nil {
+		return nil
+	}
+
+	bo := wait.Backoff{
+		Duration: 10 * time.Second,
+		Factor:   1.2,
+		Jitter:   0,
+		Steps:    math.MaxInt32,
+		Cap:      10 * time.Minute,
+	}
+	return wait.ExponentialBackoff(bo, func() (done bool, err error) {
+		completed := true
+		for _, secret := range secretList.Items {
+			if err := kubeClient.Delete(ctx, &secret); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				log.Error(err, "Unable to delete state secret")
+			}
+			completed = false
+		}
+		return completed, nil
+	})
+}
@@ -5,63 +5,101 @@
 package state
 
 import (
-	"bytes"
+	"bufio"
 	"context"
 	"fmt"
 	"io"
-	"math"
 	"os"
-	"sort"
-	"strconv"
-	"sync"
-	"time"
 
-	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
-
-	"github.com/google/uuid"
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
-	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
-	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/components/model/tar"
 )
 
 // State handles the backup and restore of state of container deploy item.
+// The state directory is archived as tar.gz, optionally encrypted and stored in a state backend.
 type State struct {
-	deployItem lsv1alpha1.ObjectReference
-	// namespace is the namespace where the state secrets should be created.
-	namespace  string
-	kubeClient client.Client
-	fs         vfs.FileSystem
-	path       string
+	backend StateBackend
+	// fallback is the backend that has stored the state before the configured backend has been introduced.
+	// The state is restored from the fallback if the configured backend has no state
+	// and it is removed from the fallback once it has been stored in the configured backend.
+	fallback StateBackend
+	// encryptionKey is the key that is used to encrypt the state.
+	// The state is not encrypted if no key is set.
+	encryptionKey []byte
+	fs            vfs.FileSystem
+	path          string
 }
 
-// New creates a new state instance.
+// New creates a new state instance that stores the state in secrets.
 func New(kubeClient client.Client, namespace string, deployItemKey lsv1alpha1.ObjectReference, statePath string) *State {
 	return &State{
-		deployItem: deployItemKey,
-		namespace:  namespace,
-		kubeClient: kubeClient,
-		fs:         osfs.New(),
-		path:       statePath,
+		backend: NewSecretBackend(kubeClient, namespace, deployItemKey),
+		fs:      osfs.New(),
+		path:    statePath,
 	}
 }
 
+// NewFromConfiguration creates a new state instance with the backend and encryption of the given state configuration.
+// The state is stored in secrets if no configuration is given.
+// If another backend is configured, states that have been stored in secrets before are migrated to that backend.
+func NewFromConfiguration(ctx context.Context, kubeClient client.Client, namespace string, deployItemKey lsv1alpha1.ObjectReference, statePath string, config *containerv1alpha1.StateConfiguration) (*State, error) {
+	s := New(kubeClient, namespace, deployItemKey, statePath)
+	if config == nil {
+		return s, nil
+	}
+
+	backend, err := NewBackend(ctx, kubeClient, namespace, deployItemKey, config)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := backend.(*SecretBackend); !ok {
+		s.fallback = s.backend
+	}
+	s.backend = backend
+
+	if config.Encryption != nil {
+		key, err := GetEncryptionKey(ctx, kubeClient, namespace, config.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		s.encryptionKey = key
+	}
+	return s, nil
+}
+
 // WithFs sets the fs for the state
 func (s *State) WithFs(fs vfs.FileSystem) *State {
 	s.fs = fs
 	return s
 }
 
-// Backup tars the content of the State directory and stores it in a secrets in the cluster.
+// WithBackend sets the backend that stores the state.
+func (s *State) WithBackend(backend StateBackend) *State {
+	s.backend = backend
+	return s
+}
+
+// WithFallbackBackend sets the backend from which the state is migrated to the backend of the state.
+func (s *State) WithFallbackBackend(backend StateBackend) *State {
+	s.fallback = backend
+	return s
+}
+
+// WithEncryptionKey sets the key that is used to encrypt the state.
+func (s *State) WithEncryptionKey(key []byte) *State {
+	s.encryptionKey = key
+	return s
+}
+
+// Backup tars the content of the State directory and stores it in the state backend.
+// The archive is staged in a temporary file, so that the state is never held in memory as a whole.
 func (s *State) Backup(ctx context.Context) error {
 	// do nothing if there is no State to persist
 	files, err := vfs.ReadDir(s.fs, s.path)
@@ -74,51 +112,53 @@ func (s *State) Backup(ctx context.Context) error {
 		return nil
 	}
 
-	// tar and gzip the State content
-
-	// todo: check if it would be possible to have the whole State in memory.
 	tmpFile, err := vfs.TempFile(s.fs, s.fs.FSTempDir(), "state-")
 	if err != nil {
 		return err
 	}
-	if err := tar.BuildTarGzip(s.fs, s.path, tmpFile); err != nil {
-		tmpFile.Close()
-		return errors.Wrap(err, "unable to tar and gzip State")
-	}
-	tmpFile.Close()
 	defer func() {
-		if err := os.Remove(tmpFile.Name()); err != nil {
+		tmpFile.Close()
+		if err := s.fs.Remove(tmpFile.Name()); err != nil {
 			log.Error(err, "Unable to remove tmp State file")
 		}
 	}()
 
-	// split the file in chunks of 1MB (Secret size limit)
-	_, err = s.splitFileAndUploadChunks(ctx, tmpFile.Name())
-	if err != nil {
+	// tar, gzip and optionally encrypt the State content
+	if err := s.archive(tmpFile); err != nil {
+		return err
+	}
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := s.backend.Save(ctx, tmpFile); err != nil {
 		return err
 	}
+
+	if s.fallback != nil {
+		if err := s.fallback.Delete(ctx); err != nil {
+			return errors.Wrap(err, "unable to delete the State of the previous state backend")
+		}
+	}
 	return nil
 }
 
-// StateSecretListOptions returns the list options for all state secrets of a deploy item
-func StateSecretListOptions(namespace string, deployItem lsv1alpha1.ObjectReference) []client.ListOption {
-	labelSelector := client.MatchingLabels{
-		container.ContainerDeployerDeployItemNameLabel:      deployItem.Name,
-		container.ContainerDeployerDeployItemNamespaceLabel: deployItem.Namespace,
-		container.ContainerDeployerTypeLabel:                "state",
+func (s *State) archive(w io.Writer) error {
+	if s.encryptionKey == nil {
+		return errors.Wrap(tar.BuildTarGzip(s.fs, s.path, w), "unable to tar and gzip State")
 	}
-	return []client.ListOption{labelSelector, client.InNamespace(namespace)}
-}
 
-// Restore restores the latest state from the k8s cluster to the configured state path.
-func (s *State) Restore(ctx context.Context) error {
-	if len(s.deployItem.Name) == 0 || len(s.deployItem.Namespace) == 0 {
-		return fmt.Errorf("a deployitem has to be defined")
+	encWriter, err := newEncryptingWriter(s.encryptionKey, w)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt State")
 	}
-	if len(s.namespace) == 0 {
-		return fmt.Errorf("a target namespace has to be defined")
+	if err := tar.BuildTarGzip(s.fs, s.path, encWriter); err != nil {
+		return errors.Wrap(err, "unable to tar and gzip State")
 	}
+	return errors.Wrap(encWriter.Close(), "unable to encrypt State")
+}
 
+// Restore restores the latest state from the state backend to the configured state path.
+func (s *State) Restore(ctx context.Context) error {
 	if _, err := s.fs.Stat(s.path); err != nil {
 		if !os.IsNotExist(err) {
 			return fmt.Errorf("unable to read from filesystem: %w", err)
@@ -128,168 +168,40 @@ func (s *State) Restore(ctx context.Context) error {
 		}
 	}
 
-	secretList := &corev1.SecretList{}
-	if err := read_write_layer.ListSecrets(ctx, s.kubeClient, secretList, read_write_layer.R000078,
-		StateSecretListOptions(s.namespace, s.deployItem)...); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
-		}
+	data, err := s.backend.Load(ctx)
+	if err != nil {
 		return err
 	}
-
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-
-	// the secrets are grouped by uuid and sorted by their creation date
-	log.Info("Restoring state from secrets", "secretCount", len(secretList.Items))
-	secrets := map[string][]*corev1.Secret{}
-	var newest *corev1.Secret
-	for _, secret := range secretList.Items {
-		if newest == nil || newest.CreationTimestamp.Before(&secret.CreationTimestamp) {
-			newest = &secret
+	if data == nil && s.fallback != nil {
+		// the state has not been migrated yet, which happens with the next backup.
+		data, err = s.fallback.Load(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to load the state from the previous state backend: %w", err)
 		}
-		uuidStr := secret.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-		secrets[uuidStr] = append(secrets[uuidStr], &secret)
 	}
-	if newest == nil {
+	if data == nil {
 		return nil
 	}
-	newestUuid := newest.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-	if err := s.restoreFromSecrets(secrets[newestUuid]); err != nil {
-		return err
-	}
-
-	// garbage collect the old states
-	wg := sync.WaitGroup{}
-	for uuidStr, sc := range secrets {
-		if uuidStr == newestUuid {
-			continue
-		}
-		wg.Add(1)
-		go func(ctx context.Context, secrets []*corev1.Secret) {
-			defer wg.Done()
-			s.gcOldSecrets(ctx, secrets)
-		}(ctx, sc)
-	}
-	wg.Wait()
-
-	return nil
-}
-
-func (s *State) restoreFromSecrets(secrets []*corev1.Secret) error {
-	sort.Sort(stateSecretsList(secrets))
-
-	// todo: need to write to filesystem
-	var data bytes.Buffer
-	for _, secret := range secrets {
-		chunk, ok := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
-		if !ok {
-			return fmt.Errorf("expected chunk in secret %s", secret.Name)
-		}
-		data.Write(chunk)
-	}
-
-	return tar.ExtractTarGzip(context.TODO(), &data, s.fs, tar.ToPath(s.path))
-}
-
-func (s *State) gcOldSecrets(ctx context.Context, secrets []*corev1.Secret) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-	for _, secret := range secrets {
-		if err := s.kubeClient.Delete(ctx, secret); err != nil {
-			log.Error(err, "Unable to delete old state secret %s in namespace %s", secret.Name, secret.Namespace)
-		}
-		log.Info("Successfully garbage collected", lc.KeyResource, secret.Name)
-	}
-}
-
-// splitFileAndUploadChunks splits the file given in the filepath into chunks of 1MB
-// and uploads the chunks as secrets to the configured k8s cluster as secrets
-func (s *State) splitFileAndUploadChunks(ctx context.Context, filePath string) ([]lsv1alpha1.ObjectReference, error) {
-	const bufSize = corev1.MaxSecretSize // 1 MB
-	file, err := s.fs.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+	defer data.Close()
 
-	secrets := make([]lsv1alpha1.ObjectReference, 0)
-	uuidString := uuid.New().String()
-	buffer := make([]byte, bufSize)
-	count := 0
-	for {
-		if _, err := file.Read(buffer); err != nil {
-			if err == io.EOF {
-				return secrets, nil
-			}
-			return nil, err
-		}
-		// remove NULL characters
-		buffer = bytes.Trim(buffer, "\x00")
-
-		secret := &corev1.Secret{}
-		secret.GenerateName = fmt.Sprintf("state-%s-%s-", s.deployItem.Namespace, s.deployItem.Name)
-		secret.Namespace = s.namespace
-		secret.Labels = map[string]string{
-			container.ContainerDeployerDeployItemNameLabel:      s.deployItem.Name,
-			container.ContainerDeployerDeployItemNamespaceLabel: s.deployItem.Namespace,
-			container.ContainerDeployerTypeLabel:                "state", // todo: make const
+	// unencrypted states are restored even if the encryption is enabled,
+	// so that the encryption can be enabled for existing deploy items.
+	bufReader := bufio.NewReader(data)
+	var reader io.Reader = bufReader
+	if isEncrypted(bufReader) {
+		if s.encryptionKey == nil {
+			return fmt.Errorf("the state is encrypted but no encryption key is configured")
 		}
-		secret.Annotations = map[string]string{
-			container.ContainerDeployerStateUUIDAnnotation: uuidString,
-			container.ContainerDeployerStateNumAnnotation:  strconv.Itoa(count),
+		reader, err = newDecryptingReader(s.encryptionKey, bufReader)
+		if err != nil {
+			return err
 		}
-		secret.Data = map[string][]byte{
-			lsv1alpha1.DataObjectSecretDataKey: buffer,
-		}
-
-		if err := s.kubeClient.Create(ctx, secret); err != nil {
-			return secrets, err
-		}
-		secrets = append(secrets, lsv1alpha1.ObjectReference{
-			Name:      secret.Name,
-			Namespace: secret.Namespace,
-		})
-		count++
 	}
-}
-
-type stateSecretsList []*corev1.Secret
-
-func (s stateSecretsList) Len() int { return len(s) }
-
-func (s stateSecretsList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
-func (s stateSecretsList) Less(i, j int) bool {
-	numI, _ := strconv.Atoi(s[i].Annotations[container.ContainerDeployerStateNumAnnotation])
-	numJ, _ := strconv.Atoi(s[j].Annotations[container.ContainerDeployerStateNumAnnotation])
-	return numI < numJ
+	return tar.ExtractTarGzip(ctx, reader, s.fs, tar.ToPath(s.path))
 }
 
-// CleanupState deletes all state secrets for a deployitem
-func CleanupState(ctx context.Context, log logging.Logger, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference) error {
-	secretList := &corev1.SecretList{}
-	if err := read_write_layer.ListSecrets(ctx, kubeClient, secretList, read_write_layer.R000079,
-		StateSecretListOptions(namespace, deployItem)...); err != nil {
-		return nil
-	}
-
-	bo := wait.Backoff{
-		Duration: 10 * time.Second,
-		Factor:   1.2,
-		Jitter:   0,
-		Steps:    math.MaxInt32,
-		Cap:      10 * time.Minute,
-	}
-	return wait.ExponentialBackoff(bo, func() (done bool, err error) {
-		completed := true
-		for _, secret := range secretList.Items {
-			if err := kubeClient.Delete(ctx, &secret); err != nil {
-				if apierrors.IsNotFound(err) {
-					continue
-				}
-				log.Error(err, "Unable to delete state secret")
-			}
-			completed = false
-		}
-		return completed, nil
-	})
+// Delete deletes the state from the state backend.
+func (s *State) Delete(ctx context.Context) error {
+	return s.backend.Delete(ctx)
 }
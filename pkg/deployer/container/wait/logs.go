@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/logs"
+)
+
+// PersistLogs reads the tail of the logs of the main container and stores them in secrets in the host cluster.
+// Afterwards the logs of old runs of the deploy item are garbage collected.
+func PersistLogs(ctx context.Context, restConfig *rest.Config, kubeClient client.Client, opts *options) error {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("unable to build kubernetes clientset: %w", err)
+	}
+
+	stream, err := clientset.CoreV1().Pods(opts.podNamespace).GetLogs(opts.podName, &corev1.PodLogOptions{
+		Container: container.MainContainerName,
+		TailLines: &opts.LogsTailLines,
+	}).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to read logs of the main container: %w", err)
+	}
+	defer stream.Close()
+
+	data, err := logs.ReadTail(stream, opts.LogsLimitBytes)
+	if err != nil {
+		return fmt.Errorf("unable to read logs of the main container: %w", err)
+	}
+
+	l := logs.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.podName)
+	secrets, err := l.Upload(ctx, data)
+	if err != nil {
+		return fmt.Errorf("unable to upload logs of the main container: %w", err)
+	}
+	log.Info("Persisted logs of the main container", "secretCount", len(secrets), "size", len(data))
+
+	if err := l.GarbageCollect(ctx, opts.LogsRetention); err != nil {
+		return fmt.Errorf("unable to garbage collect logs of old runs: %w", err)
+	}
+	return nil
+}
@@ -5,9 +5,11 @@
 package wait
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -15,13 +17,15 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 )
 
 type options struct {
 	DefaultBackoff wait.Backoff
 
-	ExportFilePath string
-	StatePath      string
+	ExportFilePath          string
+	SensitiveExportFilePath string
+	StatePath               string
 
 	podName      string
 	podNamespace string
@@ -30,11 +34,24 @@ type options struct {
 	deployItemName      string
 	deployItemNamespace string
 	DeployItemKey       lsv1alpha1.ObjectReference
+
+	// LogsTailLines is the number of lines of the logs of the main container that are persisted.
+	// The logs are not persisted if it is 0.
+	LogsTailLines  int64
+	LogsLimitBytes int64
+	LogsRetention  int
+
+	Operation container.OperationType
+	// StateConfiguration defines the backend of the state.
+	// It is decoded from the raw json configuration during the validation.
+	StateConfiguration    *containerv1alpha1.StateConfiguration
+	rawStateConfiguration string
 }
 
 // Setup reads necessary options from the expected sources.
 func (o *options) Setup() {
 	o.ExportFilePath = os.Getenv(container.ExportsPathName)
+	o.SensitiveExportFilePath = os.Getenv(container.SensitiveExportsPathName)
 	o.StatePath = os.Getenv(container.StatePathName)
 
 	o.podName = os.Getenv(container.PodName)
@@ -45,6 +62,17 @@ func (o *options) Setup() {
 	o.deployItemNamespace = os.Getenv(container.DeployItemNamespaceName)
 	o.DeployItemKey = lsv1alpha1.ObjectReference{Name: o.deployItemName, Namespace: o.deployItemNamespace}
 
+	o.Operation = container.OperationType(os.Getenv(container.OperationName))
+	o.rawStateConfiguration = os.Getenv(container.StateConfigurationName)
+
+	// invalid values are treated as unset which disables the persistence of the logs
+	o.LogsTailLines, _ = strconv.ParseInt(os.Getenv(container.LogsTailLinesName), 10, 64)
+	o.LogsLimitBytes, _ = strconv.ParseInt(os.Getenv(container.LogsLimitBytesName), 10, 64)
+	o.LogsRetention, _ = strconv.Atoi(os.Getenv(container.LogsRetentionName))
+	if o.LogsLimitBytes <= 0 || o.LogsRetention <= 0 {
+		o.LogsTailLines = 0
+	}
+
 	// todo: create own backoff method with timeout to gracefully handle timeouts
 	o.DefaultBackoff = wait.Backoff{
 		Duration: 10 * time.Second,
@@ -69,5 +97,13 @@ func (o *options) Validate() error {
 	if len(o.deployItemNamespace) == 0 {
 		err = multierror.Append(err, fmt.Errorf("%s has to be defined", container.DeployItemNamespaceName))
 	}
+	if len(o.rawStateConfiguration) != 0 {
+		stateConfig := &containerv1alpha1.StateConfiguration{}
+		if decodeErr := json.Unmarshal([]byte(o.rawStateConfiguration), stateConfig); decodeErr != nil {
+			err = multierror.Append(err, fmt.Errorf("unable to decode %s: %w", container.StateConfigurationName, decodeErr))
+		} else {
+			o.StateConfiguration = stateConfig
+		}
+	}
 	return err.ErrorOrNil()
 }
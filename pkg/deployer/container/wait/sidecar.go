@@ -12,6 +12,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
@@ -50,22 +52,45 @@ func Run(ctx context.Context) error {
 
 	// wait for the main container to finish.
 	// event if the exitcode != 0, the state is still backed up.
-	if err := WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName()); err != nil {
+	terminated, err := WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName())
+	if err != nil {
 		return withTerminationLog(log, err)
 	}
 
-	// backup state
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath).Backup(ctx); err != nil {
+	// persist the logs of the main container.
+	// the logs are only needed for debugging, so that a failure does not fail the deploy item.
+	if opts.LogsTailLines > 0 {
+		if err := PersistLogs(ctx, restConfig, kubeClient, opts); err != nil {
+			log.Error(err, "Unable to persist logs of the main container")
+		}
+	}
+
+	st, err := state.NewFromConfiguration(ctx, kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath, opts.StateConfiguration)
+	if err != nil {
+		return withTerminationLog(log, err)
+	}
+	if opts.Operation == container.OperationDelete && terminated.ExitCode == 0 && isPVCStateBackend(opts.StateConfiguration) {
+		// the state is not needed anymore after a successful delete operation.
+		// A state on a persistent volume is deleted here as the deployer is not able to access it.
+		// The states of the other backends are deleted by the deployer together with the deploy item.
+		if err := st.Delete(ctx); err != nil {
+			return withTerminationLog(log, err)
+		}
+	} else if err := st.Backup(ctx); err != nil {
 		return withTerminationLog(log, err)
 	}
 
 	// upload exports
-	if err := UploadExport(ctx, kubeClient, opts.DeployItemKey, opts.PodKey, opts.ExportFilePath); err != nil {
+	if err := UploadExport(ctx, kubeClient, opts.DeployItemKey, opts.PodKey, opts.ExportFilePath, opts.SensitiveExportFilePath); err != nil {
 		return withTerminationLog(log, err)
 	}
 	return nil
 }
 
+func isPVCStateBackend(config *containerv1alpha1.StateConfiguration) bool {
+	return config != nil && config.Backend == containerv1alpha1.StateBackendPVC
+}
+
 func withTerminationLog(log logging.Logger, err error) error {
 	if err == nil {
 		return nil
@@ -23,9 +23,9 @@ import (
 	containeractuator "github.com/gardener/landscaper/pkg/deployer/container"
 )
 
-// UploadExport reads the export config from the given path and stores
+// UploadExport reads the export config and the sensitive export config from the given paths and stores
 // the data as secret in the host cluster
-func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, podKey lsv1alpha1.ObjectReference, exportFilePath string) error {
+func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey lsv1alpha1.ObjectReference, podKey lsv1alpha1.ObjectReference, exportFilePath, sensitiveExportFilePath string) error {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	pod := &corev1.Pod{}
 	if err := read_write_layer.GetPod(ctx, kubeClient, podKey.NamespacedName(), pod, read_write_layer.R000040); err != nil {
@@ -43,28 +43,39 @@ func UploadExport(ctx context.Context, kubeClient client.Client, deployItemKey l
 		return fmt.Errorf("main container exists with %d", mainContainerStatus.State.Terminated.ExitCode)
 	}
 
-	exportData, err := os.ReadFile(exportFilePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			log.Info("No export config found. Skip upload.")
-			return nil
+	data := map[string][]byte{}
+	for key, path := range map[string]string{
+		lsv1alpha1.DataObjectSecretDataKey: exportFilePath,
+		container.SensitiveExportsDataKey:  sensitiveExportFilePath,
+	} {
+		if len(path) == 0 {
+			continue
 		}
-		return err
+		exportData, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		data[key] = exportData
+	}
+	if len(data) == 0 {
+		log.Info("No export config found. Skip upload.")
+		return nil
 	}
 
-	return createOrUpdateExport(ctx, kubeClient, deployItemKey.Name, deployItemKey.Namespace, podKey.Namespace, exportData)
+	return createOrUpdateExport(ctx, kubeClient, deployItemKey.Name, deployItemKey.Namespace, podKey.Namespace, data)
 }
 
-func createOrUpdateExport(ctx context.Context, kubeClient client.Client, deployItemName, deployItemNamespace, namespace string, data []byte) error {
+func createOrUpdateExport(ctx context.Context, kubeClient client.Client, deployItemName, deployItemNamespace, namespace string, data map[string][]byte) error {
 	secret := &corev1.Secret{}
 	secret.Name = containeractuator.ExportSecretName(deployItemNamespace, deployItemName)
 	secret.Namespace = namespace
 
 	_, err := controllerutil.CreateOrUpdate(ctx, kubeClient, secret, func() error {
 		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerNameLabel, deployItemName)
-		secret.Data = map[string][]byte{
-			lsv1alpha1.DataObjectSecretDataKey: data,
-		}
+		secret.Data = data
 		return nil
 	})
 	if err != nil {
@@ -19,12 +19,13 @@ import (
 	"github.com/gardener/landscaper/pkg/version"
 )
 
-// AddDeployerToManager adds a new helm deployers to a controller manager.
+// AddDeployerToManager adds a new helm deployer to a controller manager.
+// It returns the drift detection job of the deployer, which has to be started together with the managers.
 func AddDeployerToManager(
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	finishedObjectCache *utils.FinishedObjectCache,
 	logger logging.Logger, lsMgr, hostMgr manager.Manager,
-	config helmv1alpha1.Configuration, callerName, controllerName string) error {
+	config helmv1alpha1.Configuration, callerName, controllerName string) (*deployerlib.DriftDetectionJob, error) {
 	log := logger.WithName("helm")
 
 	lockingEnabled := config.HPAConfiguration != nil && config.HPAConfiguration.MaxReplicas > 1
@@ -36,13 +37,13 @@ func AddDeployerToManager(
 	// check if allowed to access
 	problemHandler := utils.GetCriticalProblemsHandler()
 	if err := problemHandler.AccessAllowed(context.Background(), hostUncachedClient); err != nil {
-		return err
+		return nil, err
 	}
 	log.Info("access to critical problems allowed")
 
 	d, err := NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient, lsMgr.GetConfig(), log, config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	options := controller.Options{
@@ -52,7 +53,7 @@ func AddDeployerToManager(
 		options.CacheSyncTimeout = config.Controller.CacheSyncTimeout.Duration
 	}
 
-	return deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+	if err := deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
 			Name:            Name,
@@ -62,5 +63,16 @@ func AddDeployerToManager(
 			Deployer:        d,
 			TargetSelectors: config.TargetSelector,
 			Options:         options,
-		}, config.Controller.Workers, lockingEnabled, callerName, controllerName)
+		}, config.Controller.Workers, lockingEnabled, callerName, controllerName); err != nil {
+		return nil, err
+	}
+
+	detector, ok := d.(deployerlib.DriftDetector)
+	if !ok {
+		return nil, fmt.Errorf("the helm deployer does not support drift detection")
+	}
+	if err := deployerlib.IndexDeployItemsByDeployerType(context.Background(), lsMgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("unable to index deploy items for the drift detection: %w", err)
+	}
+	return deployerlib.NewDriftDetectionJob(lsUncachedClient, lsCachedClient, log, detector, Type, config.TargetSelector), nil
 }
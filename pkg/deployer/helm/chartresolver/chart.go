@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/mandelsoft/filepath/pkg/filepath"
@@ -44,13 +45,15 @@ import (
 var NoChartDefinedError = errors.New("no chart was provided") //nolint:staticcheck
 
 // GetChart resolves the chart based on a chart access configuration.
+// If a keyring is given, the provenance of the chart is verified with the keyring, and the chart cache is not used.
 func GetChart(ctx context.Context,
 	chartConfig *helmv1alpha1.Chart,
 	lsClient client.Client,
 	contextObj *lsv1alpha1.Context,
 	registryPullSecrets []corev1.Secret,
 	ociConfig *config.OCIConfiguration,
-	useChartCache bool) (*chart.Chart, error) {
+	useChartCache bool,
+	keyring []byte) (*chart.Chart, error) {
 
 	var ocmConfig *corev1.ConfigMap
 	if contextObj.OCMConfig != nil {
@@ -63,6 +66,10 @@ func GetChart(ctx context.Context,
 		}
 	}
 
+	if keyring != nil {
+		return getVerifiedChart(ctx, chartConfig, ocmConfig, lsClient, contextObj, registryPullSecrets, ociConfig, keyring)
+	}
+
 	if chartConfig.Archive != nil {
 		return getChartFromArchive(chartConfig.Archive)
 	}
@@ -215,25 +222,37 @@ func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Char
 		return ch, err
 	}
 	if archiveConfig.Remote != nil {
-		res, err := http.Get(archiveConfig.Remote.URL)
+		data, err := fetchRemoteFile(archiveConfig.Remote.URL)
 		if err != nil {
-			return nil, fmt.Errorf("unable to fetch helm chart from %q: %w", archiveConfig.Remote.URL, err)
-		}
-		if res.StatusCode < 200 || res.StatusCode > 299 {
-			return nil, fmt.Errorf("unable to fetch helm chart from %q: %s", archiveConfig.Remote.URL, res.Status)
+			return nil, err
 		}
-		ch, err := chartloader.LoadArchive(res.Body)
+		ch, err := chartloader.LoadArchive(bytes.NewBuffer(data))
 		if err != nil {
 			return nil, fmt.Errorf("unable to load chart from %q: %w", archiveConfig.Remote.URL, err)
 		}
-		if err := res.Body.Close(); err != nil {
-			return nil, fmt.Errorf("unable to close remote stream from %q: %w", archiveConfig.Remote.URL, err)
-		}
 		return ch, err
 	}
 	return nil, NoChartDefinedError
 }
 
+// fetchRemoteFile fetches a chart archive or a provenance file from an url.
+func fetchRemoteFile(rawURL string) ([]byte, error) {
+	res, err := http.Get(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %q: %w", rawURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("unable to fetch %q: %s", rawURL, res.Status)
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %q: %w", rawURL, err)
+	}
+	return data, nil
+}
+
 func getChartFromOCIRef(ctx context.Context,
 	ocmConfig *corev1.ConfigMap,
 	contextObj *lsv1alpha1.Context,
@@ -15,6 +15,7 @@ import (
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	"github.com/gardener/landscaper/apis/utils"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/metrics"
 )
 
 const (
@@ -30,6 +31,9 @@ type HelmChartCache struct {
 
 	maxSizeInByte          int64
 	removeOutdatedDuration time.Duration
+
+	// diskCache is an optional second tier of the cache that is used if a chart is not found in memory.
+	diskCache *diskChartCache
 }
 
 type cacheEntry struct {
@@ -85,8 +89,15 @@ func (c *HelmChartCache) getChart(ociRef string, helmRepo *helmv1alpha1.HelmChar
 	}
 
 	chartBytesCompressed := c.getChartBytesCompressed(hash)
+	metrics.RecordHelmChartCacheLookup(metrics.CacheTierMemory, len(chartBytesCompressed) != 0)
 	if len(chartBytesCompressed) == 0 {
-		return nil, nil
+		chartBytesCompressed, err = c.getChartBytesCompressedFromDisk(hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(chartBytesCompressed) == 0 {
+			return nil, nil
+		}
 	}
 
 	chartBytesUncompressed, err := utils.Gunzip(chartBytesCompressed)
@@ -115,6 +126,58 @@ func (c *HelmChartCache) getChartBytesCompressed(hash string) []byte {
 	return entry.chartBytesCompressed
 }
 
+// getChartBytesCompressedFromDisk returns the compressed chart bytes from the disk cache, if it is enabled.
+// Charts that are found on disk are added to the in-memory cache.
+func (c *HelmChartCache) getChartBytesCompressedFromDisk(hash string) ([]byte, error) {
+	diskCache := c.getDiskCache()
+	if diskCache == nil {
+		return nil, nil
+	}
+
+	chartBytesCompressed, err := diskCache.get(hash)
+	if err != nil {
+		return nil, err
+	}
+	metrics.RecordHelmChartCacheLookup(metrics.CacheTierDisk, len(chartBytesCompressed) != 0)
+	if len(chartBytesCompressed) == 0 {
+		return nil, nil
+	}
+
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	if c.chartCache[hash] == nil {
+		c.chartCache[hash] = &cacheEntry{
+			chartBytesCompressed: chartBytesCompressed,
+			timestamp:            time.Now(),
+		}
+		c.currentSizeInByte += int64(len(chartBytesCompressed))
+		for c.currentSizeInByte > c.maxSizeInByte {
+			c.removeOldest()
+		}
+	}
+
+	return chartBytesCompressed, nil
+}
+
+// EnableDiskCache adds a cache on the filesystem as second tier to the in-memory cache.
+func (c *HelmChartCache) EnableDiskCache(log logging.Logger, config *helmv1alpha1.ChartCacheConfiguration) error {
+	diskCache, err := newDiskChartCache(log, config)
+	if err != nil {
+		return err
+	}
+
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.diskCache = diskCache
+	return nil
+}
+
+func (c *HelmChartCache) getDiskCache() *diskChartCache {
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	return c.diskCache
+}
+
 func (c *HelmChartCache) HasKey(ociRef string, helmRepo *helmv1alpha1.HelmChartRepo, ocmKey string) (bool, error) {
 	hash, err := c.getHash(ociRef, helmRepo, ocmKey)
 
@@ -145,18 +208,40 @@ func (c *HelmChartCache) addOrUpdateChart(ctx context.Context, ociRef string, he
 		return err
 	}
 
+	entry, added, err := c.addOrUpdateEntry(ctx, hash, chart)
+	if err != nil {
+		return err
+	}
+
+	// the disk cache is written without holding the lock, so that the in-memory cache is not blocked by the disk io.
+	if diskCache := c.getDiskCache(); added && diskCache != nil {
+		if err := diskCache.add(hash, entry.chartBytesCompressed); err != nil {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Error(err, "unable to add chart to disk cache")
+		}
+	}
+
+	return nil
+}
+
+// addOrUpdateEntry adds the chart to the in-memory cache and returns its entry.
+// It returns whether the entry has been newly added.
+func (c *HelmChartCache) addOrUpdateEntry(ctx context.Context, hash string, chart *chart.Chart) (*cacheEntry, bool, error) {
 	c.rwLock.Lock()
 	defer c.rwLock.Unlock()
 
+	added := false
 	entry := c.chartCache[hash]
 	if entry == nil {
+		var err error
 		entry, err = c.createEntry(chart)
 		if err != nil {
-			return err
+			return nil, false, err
 		}
 
 		c.chartCache[hash] = entry
 		c.currentSizeInByte += int64(len(entry.chartBytesCompressed))
+		added = true
 	} else {
 		entry.timestamp = time.Now()
 	}
@@ -170,7 +255,7 @@ func (c *HelmChartCache) addOrUpdateChart(ctx context.Context, ociRef string, he
 		c.lastCleanup = time.Now()
 	}
 
-	return nil
+	return entry, added, nil
 }
 
 func (c *HelmChartCache) Clear() {
@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/metrics"
+)
+
+const (
+	// diskCacheBlobPrefix is the prefix of the files that contain the compressed chart bytes.
+	// The files are named by the sha256 digest of their content.
+	diskCacheBlobPrefix = "blob-"
+	// diskCacheRefPrefix is the prefix of the files that map the hash of a chart reference to the digest of a blob.
+	diskCacheRefPrefix = "ref-"
+	// diskCacheTmpPrefix is the prefix of the files that are being written.
+	diskCacheTmpPrefix = "tmp-"
+)
+
+// diskChartCache stores compressed charts content-addressed on a filesystem.
+// Several deployer replicas might share the filesystem. Therefore, files are written under a temporary name
+// and renamed afterwards, so that other replicas never read a partially written file.
+// The content of a blob is additionally verified against its digest, so that corrupted files are never used.
+// The garbage collection determines the size of the cache from the files in the directory instead of an in-memory
+// index, so that it also accounts for the files that were written by other replicas.
+type diskChartCache struct {
+	log logging.Logger
+	fs  vfs.FileSystem
+
+	// size is the maximum size of the files in bytes. The cache is not limited if the size is 0.
+	size            int64
+	gcHighThreshold float64
+	gcLowThreshold  float64
+
+	// gcMux prevents concurrent garbage collections of the same replica.
+	gcMux sync.Mutex
+}
+
+// newDiskChartCache creates a disk cache in the configured directory.
+func newDiskChartCache(log logging.Logger, config *helmv1alpha1.ChartCacheConfiguration) (*diskChartCache, error) {
+	if err := os.MkdirAll(config.Path, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("unable to create chart cache directory %q: %w", config.Path, err)
+	}
+
+	baseFs, err := projectionfs.New(osfs.New(), config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create chart cache filesystem: %w", err)
+	}
+
+	return newDiskChartCacheFromFilesystem(log, baseFs, config)
+}
+
+func newDiskChartCacheFromFilesystem(log logging.Logger, baseFs vfs.FileSystem,
+	config *helmv1alpha1.ChartCacheConfiguration) (*diskChartCache, error) {
+
+	c := &diskChartCache{
+		log:             log,
+		fs:              &atomicFileSystem{FileSystem: baseFs},
+		gcHighThreshold: config.GCHighThreshold,
+		gcLowThreshold:  config.GCLowThreshold,
+	}
+
+	if len(config.Size) != 0 {
+		size, err := resource.ParseQuantity(config.Size)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse size of chart cache: %w", err)
+		}
+		c.size = size.Value()
+	}
+
+	// the initial garbage collection determines the usage of an existing cache directory
+	c.runGarbageCollection()
+	return c, nil
+}
+
+// get returns the compressed chart bytes that are stored for the given hash of a chart reference.
+// It returns nil if no valid entry exists.
+func (c *diskChartCache) get(hash string) ([]byte, error) {
+	refName := diskCacheRefPrefix + hash
+	digest, err := c.readFile(refName)
+	if err != nil || digest == nil {
+		return nil, err
+	}
+
+	blobName := diskCacheBlobPrefix + string(digest)
+	data, err := c.readFile(blobName)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	if computeDigest(data) != string(digest) {
+		// the entry is corrupted. It is not removed, as another replica might just be replacing it,
+		// and is overwritten when the chart is added again.
+		return nil, nil
+	}
+
+	// the modification time marks the files as recently used, so that they are garbage collected last
+	c.touch(refName)
+	c.touch(blobName)
+	return data, nil
+}
+
+// add stores the compressed chart bytes for the given hash of a chart reference.
+// The blob is written before the reference, so that a reference never points to a missing blob.
+func (c *diskChartCache) add(hash string, data []byte) error {
+	digest := computeDigest(data)
+	if err := c.writeFile(diskCacheBlobPrefix+digest, data); err != nil {
+		return err
+	}
+	if err := c.writeFile(diskCacheRefPrefix+hash, []byte(digest)); err != nil {
+		return err
+	}
+
+	c.runGarbageCollection()
+	return nil
+}
+
+// runGarbageCollection determines the size of the files in the cache directory and removes the least recently used
+// files if the size exceeds the high threshold, until it falls below the low threshold.
+// As the files are listed on every run, the files of all replicas that share the directory are taken into account.
+func (c *diskChartCache) runGarbageCollection() {
+	c.gcMux.Lock()
+	defer c.gcMux.Unlock()
+
+	files, err := vfs.ReadDir(c.fs, "/")
+	if err != nil {
+		c.log.Error(err, "unable to read chart cache directory")
+		return
+	}
+
+	var usage int64
+	cachedFiles := make([]os.FileInfo, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), diskCacheTmpPrefix) {
+			continue
+		}
+		usage += file.Size()
+		cachedFiles = append(cachedFiles, file)
+	}
+	defer func() {
+		metrics.HelmChartCacheDiskItems.Set(float64(len(cachedFiles)))
+		metrics.HelmChartCacheDiskUsage.Set(float64(usage))
+	}()
+
+	if c.size == 0 || float64(usage) < c.gcHighThreshold*float64(c.size) {
+		return
+	}
+
+	sort.SliceStable(cachedFiles, func(i, j int) bool {
+		return cachedFiles[i].ModTime().Before(cachedFiles[j].ModTime())
+	})
+	for len(cachedFiles) != 0 && float64(usage) > c.gcLowThreshold*float64(c.size) {
+		file := cachedFiles[0]
+		if err := c.remove(file.Name()); err != nil {
+			c.log.Error(err, "unable to remove chart cache file", "file", file.Name())
+		}
+		usage -= file.Size()
+		cachedFiles = cachedFiles[1:]
+	}
+}
+
+func (c *diskChartCache) readFile(name string) ([]byte, error) {
+	file, err := c.fs.OpenFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		if vfs.IsErrNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to open chart cache file %q: %w", name, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chart cache file %q: %w", name, err)
+	}
+	return data, nil
+}
+
+func (c *diskChartCache) writeFile(name string, data []byte) error {
+	exists, err := vfs.FileExists(c.fs, name)
+	if err != nil {
+		return fmt.Errorf("unable to check chart cache file %q: %w", name, err)
+	}
+	if exists {
+		existing, err := c.readFile(name)
+		if err == nil && bytes.Equal(existing, data) {
+			c.touch(name)
+			return nil
+		}
+		if err := c.remove(name); err != nil {
+			return err
+		}
+	}
+
+	file, err := c.fs.Create(name)
+	if err != nil {
+		return fmt.Errorf("unable to create chart cache file %q: %w", name, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("unable to write chart cache file %q: %w", name, err)
+	}
+	// the file is renamed to its name when it is closed
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to write chart cache file %q: %w", name, err)
+	}
+	return nil
+}
+
+// atomicFileSystem creates files under a temporary name and renames them to their actual name when they are closed.
+type atomicFileSystem struct {
+	vfs.FileSystem
+}
+
+func (fs *atomicFileSystem) Create(name string) (vfs.File, error) {
+	file, err := vfs.TempFile(fs.FileSystem, "/", diskCacheTmpPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &atomicFile{File: file, fs: fs.FileSystem, name: name}, nil
+}
+
+// atomicFile is a temporary file that is renamed to its name when it is closed.
+// It is removed instead if a write has failed.
+type atomicFile struct {
+	vfs.File
+	fs     vfs.FileSystem
+	name   string
+	failed bool
+}
+
+func (f *atomicFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	if err != nil {
+		f.failed = true
+	}
+	return n, err
+}
+
+func (f *atomicFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		f.failed = true
+	}
+	if f.failed {
+		_ = f.fs.Remove(f.File.Name())
+		return fmt.Errorf("unable to write chart cache file %q", f.name)
+	}
+	return f.fs.Rename(f.File.Name(), f.name)
+}
+
+// touch sets the modification time of a file to the current time.
+// Errors are ignored, as the file might have been removed by another replica in the meantime.
+func (c *diskChartCache) touch(name string) {
+	now := time.Now()
+	if err := c.fs.Chtimes(name, now, now); err != nil && !vfs.IsErrNotExist(err) {
+		c.log.Debug("unable to update modification time of chart cache file", "file", name, "error", err.Error())
+	}
+}
+
+func (c *diskChartCache) remove(name string) error {
+	if err := c.fs.Remove(name); err != nil && !vfs.IsErrNotExist(err) {
+		return fmt.Errorf("unable to remove chart cache file %q: %w", name, err)
+	}
+	return nil
+}
+
+func computeDigest(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+var _ = Describe("Disk Chart Cache", func() {
+
+	var (
+		fs        vfs.FileSystem
+		diskCache *diskChartCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		fs = memoryfs.New()
+		diskCache, err = newDiskChartCacheFromFilesystem(logging.Discard(), fs, &helmv1alpha1.ChartCacheConfiguration{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return stored charts", func() {
+		Expect(diskCache.add("key", []byte("chart"))).To(Succeed())
+
+		data, err := diskCache.get("key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([]byte("chart")))
+	})
+
+	It("should return nil for unknown charts", func() {
+		data, err := diskCache.get("unknown")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(BeNil())
+	})
+
+	It("should store identical charts only once", func() {
+		Expect(diskCache.add("key1", []byte("chart"))).To(Succeed())
+		Expect(diskCache.add("key2", []byte("chart"))).To(Succeed())
+
+		files, err := vfs.ReadDir(fs, "/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(3))
+	})
+
+	It("should not return corrupted charts and replace them when they are added again", func() {
+		Expect(diskCache.add("key", []byte("chart"))).To(Succeed())
+		Expect(vfs.WriteFile(fs, diskCacheBlobPrefix+computeDigest([]byte("chart")), []byte("char"), 0o644)).To(Succeed())
+
+		data, err := diskCache.get("key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(BeNil())
+
+		Expect(diskCache.add("key", []byte("chart"))).To(Succeed())
+		data, err = diskCache.get("key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([]byte("chart")))
+	})
+
+	It("should not leave temporary files", func() {
+		Expect(diskCache.add("key", []byte("chart"))).To(Succeed())
+		Expect(diskCache.add("key", []byte("other chart"))).To(Succeed())
+
+		files, err := vfs.ReadDir(fs, "/")
+		Expect(err).ToNot(HaveOccurred())
+		names := make([]string, 0, len(files))
+		for _, file := range files {
+			names = append(names, file.Name())
+		}
+		Expect(names).To(ConsistOf(
+			diskCacheRefPrefix+"key",
+			diskCacheBlobPrefix+computeDigest([]byte("chart")),
+			diskCacheBlobPrefix+computeDigest([]byte("other chart")),
+		))
+	})
+
+	It("should load the charts of an existing cache directory", func() {
+		Expect(diskCache.add("key", []byte("chart"))).To(Succeed())
+
+		otherCache, err := newDiskChartCacheFromFilesystem(logging.Discard(), fs, &helmv1alpha1.ChartCacheConfiguration{})
+		Expect(err).ToNot(HaveOccurred())
+		data, err := otherCache.get("key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([]byte("chart")))
+	})
+	Context("garbage collection", func() {
+
+		// chartData returns chart bytes that result in 100 bytes on disk together with the 64 bytes of the reference.
+		chartData := func(name string) []byte {
+			return []byte(fmt.Sprintf("%-36s", name))
+		}
+
+		// setModTime sets the modification time of the files of a chart.
+		setModTime := func(key string, modTime time.Time) {
+			digest := computeDigest(chartData(key))
+			Expect(fs.Chtimes(diskCacheRefPrefix+key, modTime, modTime)).To(Succeed())
+			Expect(fs.Chtimes(diskCacheBlobPrefix+digest, modTime, modTime)).To(Succeed())
+		}
+
+		newLimitedCache := func() *diskChartCache {
+			c, err := newDiskChartCacheFromFilesystem(logging.Discard(), fs, &helmv1alpha1.ChartCacheConfiguration{
+				Size:            "300",
+				GCHighThreshold: 0.85,
+				GCLowThreshold:  0.7,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return c
+		}
+
+		It("should remove the least recently used charts of all replicas that share the directory", func() {
+			replica1 := newLimitedCache()
+			replica2 := newLimitedCache()
+
+			Expect(replica1.add("key1", chartData("key1"))).To(Succeed())
+			Expect(replica1.add("key2", chartData("key2"))).To(Succeed())
+			setModTime("key1", time.Now().Add(-2*time.Hour))
+			setModTime("key2", time.Now().Add(-1*time.Hour))
+
+			// the lookup marks the chart as recently used
+			data, err := replica1.get("key1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(chartData("key1")))
+
+			// the second replica exceeds the high threshold with the charts of the first replica
+			Expect(replica2.add("key3", chartData("key3"))).To(Succeed())
+
+			for _, key := range []string{"key1", "key3"} {
+				data, err := replica2.get(key)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(Equal(chartData(key)))
+			}
+			data, err = replica2.get("key2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(BeNil())
+		})
+
+		It("should not remove charts below the high threshold", func() {
+			c := newLimitedCache()
+			Expect(c.add("key1", chartData("key1"))).To(Succeed())
+			Expect(c.add("key2", chartData("key2"))).To(Succeed())
+
+			files, err := vfs.ReadDir(fs, "/")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(4))
+		})
+	})
+})
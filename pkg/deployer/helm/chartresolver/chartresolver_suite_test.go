@@ -108,7 +108,7 @@ var _ = Describe("GetChart", func() {
 
 			chart1, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			cacheEntries1, size1, _ := helmChartCache.GetEntries()
@@ -125,7 +125,7 @@ var _ = Describe("GetChart", func() {
 
 			chart2, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			chart1.Raw = nil
@@ -149,7 +149,7 @@ var _ = Describe("GetChart", func() {
 
 			chart3, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(reflect.DeepEqual(chart2, chart3)).To(BeTrue())
@@ -166,7 +166,7 @@ var _ = Describe("GetChart", func() {
 
 			chart4, err := GetChart(ctx, chartAccess4, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(chart4).ToNot(BeNil())
 
@@ -193,7 +193,7 @@ var _ = Describe("GetChart", func() {
 
 			_, err = GetChart(ctx, chartAccess5, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 			Expect(err).ToNot(HaveOccurred())
 			cacheEntries5, size5, _ := helmChartCache.GetEntries()
 			Expect(len(cacheEntries5)).To(Equal(2))
@@ -218,7 +218,7 @@ var _ = Describe("GetChart", func() {
 
 			_, _ = GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 
 			outdatedDuration := time.Since(timeBefore) + time.Duration(500)*time.Millisecond
 			helmChartCache.SetOutdatedDuration(outdatedDuration)
@@ -226,7 +226,7 @@ var _ = Describe("GetChart", func() {
 
 			_, _ = GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{}},
-				nil, nil, true)
+				nil, nil, true, nil)
 
 			contained, err = helmChartCache.HasKey(chartAccess1.Ref, chartAccess1.HelmChartRepo, chartAccess1.ResourceRef)
 			Expect(err).ToNot(HaveOccurred())
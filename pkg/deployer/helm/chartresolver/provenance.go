@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck
+	"helm.sh/helm/v3/pkg/chart"
+	chartloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/provenance"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/registries"
+)
+
+// KeyringSecretDefaultKey is the default key of a keyring in a secret.
+const KeyringSecretDefaultKey = "keyring"
+
+// provenanceFileSuffix is the suffix that is added to the url of a chart archive to get its provenance file.
+const provenanceFileSuffix = ".prov"
+
+// VerifyProvenance verifies that the provenance file is signed by one of the keys of the keyring,
+// and that it contains the digest of the chart archive with the given file name.
+func VerifyProvenance(archiveName string, archive, provenanceFile, keyring []byte) (*provenance.Verification, error) {
+	keys, err := openpgp.ReadKeyRing(bytes.NewReader(keyring))
+	if err != nil {
+		keys, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read keyring: %w", err)
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "chart-verification-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create directory for chart verification: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	archivePath := filepath.Join(dir, archiveName)
+	if err := os.WriteFile(archivePath, archive, 0o600); err != nil {
+		return nil, fmt.Errorf("unable to write chart archive: %w", err)
+	}
+	provenancePath := archivePath + provenanceFileSuffix
+	if err := os.WriteFile(provenancePath, provenanceFile, 0o600); err != nil {
+		return nil, fmt.Errorf("unable to write provenance file: %w", err)
+	}
+
+	signatory := &provenance.Signatory{KeyRing: keys}
+	return signatory.Verify(archivePath, provenancePath)
+}
+
+// getVerifiedChart resolves a chart archive together with its provenance file in the same way as the chart itself,
+// verifies the provenance with the given keyring, and loads the chart from the verified archive.
+func getVerifiedChart(ctx context.Context,
+	chartConfig *helmv1alpha1.Chart,
+	ocmConfig *corev1.ConfigMap,
+	lsClient client.Client,
+	contextObj *lsv1alpha1.Context,
+	registryPullSecrets []corev1.Secret,
+	ociConfig *config.OCIConfiguration,
+	keyring []byte) (*chart.Chart, error) {
+
+	var (
+		archive *model.HelmChartArchive
+		err     error
+	)
+	switch {
+	case chartConfig.Archive != nil && len(chartConfig.Archive.Raw) == 0 && chartConfig.Archive.Remote != nil:
+		archive, err = getRemoteChartArchive(chartConfig.Archive.Remote.URL)
+	case chartConfig.Archive == nil && len(chartConfig.Ref) != 0:
+		var resource model.TypedResourceProvider
+		resource, err = registries.GetFactory().NewHelmOCIResource(ctx, nil, ocmConfig, chartConfig.Ref, registryPullSecrets, ociConfig)
+		if err == nil {
+			archive, err = getChartArchive(ctx, resource, keyring)
+		}
+	case chartConfig.Archive == nil && chartConfig.HelmChartRepo != nil:
+		var resource model.TypedResourceProvider
+		resource, err = registries.GetFactory().NewHelmRepoResource(ctx, ocmConfig, chartConfig.HelmChartRepo, lsClient, contextObj)
+		if err == nil {
+			archive, err = getChartArchive(ctx, resource, keyring)
+		}
+	default:
+		return nil, errors.New("the provenance can only be verified for charts defined by ref, helmChartRepo or archive.remote")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(archive.Provenance) == 0 {
+		return nil, fmt.Errorf("chart %q has no provenance file", archive.Name)
+	}
+
+	verification, err := VerifyProvenance(archive.Name, archive.Data, archive.Provenance, keyring)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify provenance of chart %q: %w", archive.Name, err)
+	}
+
+	ch, err := chartloader.LoadArchive(bytes.NewReader(archive.Data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load chart from archive %q: %w", verification.FileName, err)
+	}
+	return ch, nil
+}
+
+// getChartArchive returns the chart archive and its provenance file of a chart resource.
+func getChartArchive(ctx context.Context, resource model.TypedResourceProvider, keyring []byte) (*model.HelmChartArchive, error) {
+	archiveProvider, ok := resource.(model.HelmChartArchiveProvider)
+	if !ok {
+		return nil, fmt.Errorf("resource of type %T does not provide a chart archive", resource)
+	}
+	return archiveProvider.GetChartArchive(ctx, keyring)
+}
+
+// getRemoteChartArchive fetches a chart archive and its provenance file from an url.
+func getRemoteChartArchive(archiveURL string) (*model.HelmChartArchive, error) {
+	u, err := url.Parse(archiveURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse url %q: %w", archiveURL, err)
+	}
+
+	data, err := fetchRemoteFile(archiveURL)
+	if err != nil {
+		return nil, err
+	}
+	provenanceFile, err := fetchRemoteFile(archiveURL + provenanceFileSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.HelmChartArchive{
+		Name:       path.Base(u.Path),
+		Data:       data,
+		Provenance: provenanceFile,
+	}, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck
+	chartloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/provenance"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	helmv1alpha1helper "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/helper"
+)
+
+var _ = Describe("Provenance", func() {
+
+	var (
+		archiveName    string
+		archive        []byte
+		provenanceFile []byte
+		keyring        []byte
+	)
+
+	newKeyring := func(entity *openpgp.Entity) []byte {
+		buf := &bytes.Buffer{}
+		Expect(entity.Serialize(buf)).To(Succeed())
+		return buf.Bytes()
+	}
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+
+		ch, err := chartloader.Load("./testdata/testchart")
+		Expect(err).ToNot(HaveOccurred())
+		archivePath, err := chartutil.Save(ch, dir)
+		Expect(err).ToNot(HaveOccurred())
+		archiveName = filepath.Base(archivePath)
+		archive, err = os.ReadFile(archivePath)
+		Expect(err).ToNot(HaveOccurred())
+
+		entity, err := openpgp.NewEntity("signer", "", "signer@example.com", nil)
+		Expect(err).ToNot(HaveOccurred())
+		sig, err := (&provenance.Signatory{Entity: entity}).ClearSign(archivePath)
+		Expect(err).ToNot(HaveOccurred())
+		provenanceFile = []byte(sig)
+		keyring = newKeyring(entity)
+	})
+
+	It("should verify a chart that is signed by a key of the keyring", func() {
+		verification, err := VerifyProvenance(archiveName, archive, provenanceFile, keyring)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verification.SignedBy).ToNot(BeNil())
+	})
+
+	It("should reject a chart that is signed by an unknown key", func() {
+		otherEntity, err := openpgp.NewEntity("other", "", "other@example.com", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = VerifyProvenance(archiveName, archive, provenanceFile, newKeyring(otherEntity))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a modified chart", func() {
+		modified := append([]byte{}, archive...)
+		modified[len(modified)-1] ^= 0xff
+
+		_, err := VerifyProvenance(archiveName, modified, provenanceFile, keyring)
+		Expect(err).To(MatchError(ContainSubstring("sha256 sum does not match")))
+	})
+
+	It("should load a verified chart from a remote archive", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/" + archiveName:
+				_, _ = w.Write(archive)
+			case "/" + archiveName + provenanceFileSuffix:
+				_, _ = w.Write(provenanceFile)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		chartConfig := &helmv1alpha1.Chart{
+			Archive: &helmv1alpha1.ArchiveAccess{
+				Remote: &helmv1alpha1.RemoteArchiveAccess{URL: server.URL + "/" + archiveName},
+			},
+		}
+		Expect(helmv1alpha1helper.IsChartVerifiable(chartConfig)).To(BeTrue())
+
+		ch, err := getVerifiedChart(context.Background(), chartConfig, nil, nil, nil, nil, nil, keyring)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ch.Metadata.Name).To(Equal("testchart"))
+	})
+
+	It("should not verify inline archives", func() {
+		Expect(helmv1alpha1helper.IsChartVerifiable(&helmv1alpha1.Chart{Archive: &helmv1alpha1.ArchiveAccess{Raw: "abc"}})).To(BeFalse())
+		Expect(helmv1alpha1helper.IsChartVerifiable(&helmv1alpha1.Chart{ResourceRef: "abc"})).To(BeFalse())
+	})
+})
@@ -6,6 +6,7 @@ package helm
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"k8s.io/client-go/rest"
@@ -14,10 +15,13 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 )
@@ -41,6 +45,13 @@ func NewDeployer(
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client, lsRestConfig *rest.Config,
 	log logging.Logger, config helmv1alpha1.Configuration) (deployerlib.Deployer, error) {
 
+	if config.ChartCache != nil {
+		if err := chartresolver.GetHelmChartCache(chartresolver.MaxSizeInByteDefault,
+			chartresolver.RemoveOutdatedDurationDefault).EnableDiskCache(log, config.ChartCache); err != nil {
+			return nil, fmt.Errorf("unable to enable helm chart disk cache: %w", err)
+		}
+	}
+
 	dep := &deployer{
 		lsUncachedClient:   lsUncachedClient,
 		lsCachedClient:     lsCachedClient,
@@ -101,7 +112,7 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 		return err
 	}
 
-	return helm.ApplyFiles(ctx, filesForManifestDeployer, crdsForManifestDeployer, exports, ch)
+	return helm.ApplyFiles(ctx, filesForManifestDeployer, crdsForManifestDeployer, values, exports, ch)
 }
 
 func (d *deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
@@ -147,3 +158,19 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetectionSpec(di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error) {
+	helm, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.lsRestConfig, d.config, di, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return helm.ProviderConfiguration.DriftDetection, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) ([]driftdetection.ResourceDrift, error) {
+	helm, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.lsRestConfig, d.config, di, rt, lsCtx)
+	if err != nil {
+		return nil, err
+	}
+	return helm.DetectDrift(ctx)
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
+)
+
+// isDiffOnly returns whether the release must neither be installed nor upgraded.
+func (h *Helm) isDiffOnly() bool {
+	return h.ProviderConfiguration.Diff != nil && h.ProviderConfiguration.Diff.DiffOnly
+}
+
+// diffRelease compares the manifest of the deployed release with the rendered chart.
+// Hooks are not compared, because they are not part of the manifest of a release.
+func (h *Helm) diffRelease(ctx context.Context, realHelmDeployer *realhelmdeployer.RealHelmDeployer, ch *chart.Chart,
+	values map[string]interface{}) (*helmv1alpha1.ReleaseDiff, error) {
+
+	currOp := "DiffRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	rel, err := realHelmDeployer.GetDeployedRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	files, lsErr := h.renderFiles(currOp, ch, values)
+	if lsErr != nil {
+		return nil, lsErr
+	}
+
+	rendered, err := decodeRenderedFiles(logger, files)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DecodeRenderedFiles", err.Error())
+	}
+
+	revision := 0
+	deployed := []*unstructured.Unstructured{}
+	if rel != nil {
+		revision = rel.Version
+		deployed, err = kutil.DecodeObjects(logger, "release", []byte(rel.Manifest))
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, currOp, "DecodeReleaseManifest", err.Error())
+		}
+	}
+
+	diff := DiffManifests(deployed, rendered)
+	diff.Revision = revision
+	logger.Info("computed diff of helm release", "revision", revision, "summary", diff.Summary)
+	return diff, nil
+}
+
+// decodeRenderedFiles decodes the rendered templates of a chart in a stable order, and skips notes and hooks.
+func decodeRenderedFiles(logger logging.Logger, files map[string]string) ([]*unstructured.Unstructured, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if _, file := filepath.Split(name); file == "NOTES.txt" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	objects := []*unstructured.Unstructured{}
+	for _, name := range names {
+		decoded, err := kutil.DecodeObjects(logger, name, []byte(files[name]))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode file %q: %w", name, err)
+		}
+		for _, obj := range decoded {
+			if _, isHook := obj.GetAnnotations()[release.HookAnnotation]; isHook {
+				continue
+			}
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+// DiffManifests compares the objects of a deployed release with the objects of a rendered chart.
+// Objects are matched by group, kind, namespace and name. The resulting resources are sorted by kind, namespace and name.
+func DiffManifests(deployed, rendered []*unstructured.Unstructured) *helmv1alpha1.ReleaseDiff {
+	deployedObjects := map[objectKey]*unstructured.Unstructured{}
+	for _, obj := range deployed {
+		deployedObjects[keyOf(obj)] = obj
+	}
+
+	diff := &helmv1alpha1.ReleaseDiff{}
+	renderedKeys := map[objectKey]bool{}
+	for _, obj := range rendered {
+		key := keyOf(obj)
+		renderedKeys[key] = true
+
+		old, ok := deployedObjects[key]
+		if !ok {
+			diff.Resources = append(diff.Resources, helmv1alpha1.ResourceDiff{
+				Resource: objectReference(obj),
+				Change:   helmv1alpha1.ResourceAdded,
+			})
+			continue
+		}
+
+		if fields := diffValues("", old.Object, obj.Object, nil); len(fields) != 0 {
+			sort.Strings(fields)
+			diff.Resources = append(diff.Resources, helmv1alpha1.ResourceDiff{
+				Resource: objectReference(obj),
+				Change:   helmv1alpha1.ResourceChanged,
+				Fields:   fields,
+			})
+		}
+	}
+
+	for _, obj := range deployed {
+		if !renderedKeys[keyOf(obj)] {
+			diff.Resources = append(diff.Resources, helmv1alpha1.ResourceDiff{
+				Resource: objectReference(obj),
+				Change:   helmv1alpha1.ResourceRemoved,
+			})
+		}
+	}
+
+	sort.SliceStable(diff.Resources, func(i, j int) bool {
+		a, b := diff.Resources[i].Resource, diff.Resources[j].Resource
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	counts := map[helmv1alpha1.ResourceChange]int{}
+	for _, r := range diff.Resources {
+		counts[r.Change]++
+	}
+	diff.Summary = fmt.Sprintf("%d added, %d changed, %d removed",
+		counts[helmv1alpha1.ResourceAdded], counts[helmv1alpha1.ResourceChanged], counts[helmv1alpha1.ResourceRemoved])
+
+	return diff
+}
+
+type objectKey struct {
+	groupKind schema.GroupKind
+	namespace string
+	name      string
+}
+
+func keyOf(obj *unstructured.Unstructured) objectKey {
+	return objectKey{
+		groupKind: obj.GroupVersionKind().GroupKind(),
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+	}
+}
+
+func objectReference(obj *unstructured.Unstructured) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// diffValues returns the paths of all fields that differ between the two values.
+// Only the paths are reported, so that no values, e.g. of secrets, are exposed.
+func diffValues(path string, oldValue, newValue interface{}, fields []string) []string {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := map[string]bool{}
+		for key := range oldMap {
+			keys[key] = true
+		}
+		for key := range newMap {
+			keys[key] = true
+		}
+		for key := range keys {
+			fields = diffValues(joinPath(path, key), oldMap[key], newMap[key], fields)
+		}
+		return fields
+	}
+
+	oldList, oldIsList := oldValue.([]interface{})
+	newList, newIsList := newValue.([]interface{})
+	if oldIsList && newIsList && len(oldList) == len(newList) {
+		for i := range oldList {
+			fields = diffValues(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i], fields)
+		}
+		return fields
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		return append(fields, path)
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
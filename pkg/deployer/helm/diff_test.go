@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Release Diff", func() {
+
+	configMap := func(name string, data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+			"data": data,
+		}}
+	}
+
+	It("should report added, changed and removed resources", func() {
+		deployed := []*unstructured.Unstructured{
+			configMap("unchanged", map[string]interface{}{"key": "value"}),
+			configMap("changed", map[string]interface{}{"key": "old", "removed": "value"}),
+			configMap("removed", map[string]interface{}{"key": "value"}),
+		}
+		rendered := []*unstructured.Unstructured{
+			configMap("unchanged", map[string]interface{}{"key": "value"}),
+			configMap("changed", map[string]interface{}{"key": "new", "added": "value"}),
+			configMap("added", map[string]interface{}{"key": "value"}),
+		}
+
+		diff := helm.DiffManifests(deployed, rendered)
+		Expect(diff.Summary).To(Equal("1 added, 1 changed, 1 removed"))
+		Expect(diff.Resources).To(HaveLen(3))
+
+		Expect(diff.Resources[0].Resource.Name).To(Equal("added"))
+		Expect(diff.Resources[0].Change).To(Equal(helmv1alpha1.ResourceAdded))
+
+		Expect(diff.Resources[1].Resource.Name).To(Equal("changed"))
+		Expect(diff.Resources[1].Change).To(Equal(helmv1alpha1.ResourceChanged))
+		Expect(diff.Resources[1].Fields).To(Equal([]string{"data.added", "data.key", "data.removed"}))
+
+		Expect(diff.Resources[2].Resource.Name).To(Equal("removed"))
+		Expect(diff.Resources[2].Change).To(Equal(helmv1alpha1.ResourceRemoved))
+	})
+
+	It("should report all resources as added if the release is not installed", func() {
+		diff := helm.DiffManifests(nil, []*unstructured.Unstructured{configMap("a", nil), configMap("b", nil)})
+		Expect(diff.Summary).To(Equal("2 added, 0 changed, 0 removed"))
+		Expect(diff.Resources).To(HaveLen(2))
+	})
+
+	It("should match resources whose api version has changed", func() {
+		deployed := configMap("cm", map[string]interface{}{"key": "value"})
+		rendered := deployed.DeepCopy()
+		rendered.SetAPIVersion("v2")
+
+		diff := helm.DiffManifests([]*unstructured.Unstructured{deployed}, []*unstructured.Unstructured{rendered})
+		Expect(diff.Resources).To(HaveLen(1))
+		Expect(diff.Resources[0].Change).To(Equal(helmv1alpha1.ResourceChanged))
+		Expect(diff.Resources[0].Fields).To(Equal([]string{"apiVersion"}))
+	})
+
+	It("should report no changes for identical manifests", func() {
+		objects := []*unstructured.Unstructured{configMap("cm", map[string]interface{}{"key": "value"})}
+		diff := helm.DiffManifests(objects, objects)
+		Expect(diff.Summary).To(Equal("0 added, 0 changed, 0 removed"))
+		Expect(diff.Resources).To(BeEmpty())
+	})
+})
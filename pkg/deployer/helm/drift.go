@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+
+	"k8s.io/utils/ptr"
+
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+)
+
+// DetectDrift compares the managed resources in the target cluster with the templated chart.
+// If helm is used as deployment mechanism, only the managed resources recorded in the provider status are compared.
+func (h *Helm) DetectDrift(ctx context.Context) ([]driftdetection.ResourceDrift, error) {
+	currOp := "DetectDrift"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if h.ProviderStatus == nil || len(h.ProviderStatus.ManagedResources) == 0 {
+		return nil, nil
+	}
+
+	files, crds, values, ch, lsErr := h.Template(ctx)
+	if lsErr != nil {
+		return nil, lsErr
+	}
+
+	if ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true) {
+		// the chart is only templated for the manifest helm deployer
+		files, lsErr = h.renderFiles(currOp, ch, values)
+		if lsErr != nil {
+			return nil, lsErr
+		}
+		for _, crd := range ch.CRDObjects() {
+			crds[crd.Filename] = string(crd.File.Data[:])
+		}
+	}
+
+	manifests, err := h.parseManifests(logger, currOp, files, crds)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := driftdetection.DecodeManifests(manifests)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DecodeManifests", err.Error())
+	}
+
+	return driftdetection.Detect(ctx, h.targetAccess.TargetClient(), h.ProviderStatus.ManagedResources, desired)
+}
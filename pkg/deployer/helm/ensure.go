@@ -38,7 +38,7 @@ import (
 
 // ApplyFiles applies the helm templated files to the target cluster.
 func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsForManifestDeployer map[string]string,
-	exports map[string]interface{}, ch *chart.Chart) error {
+	values, exports map[string]interface{}, ch *chart.Chart) error {
 
 	currOp := "ApplyFile"
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
@@ -58,20 +58,36 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 	}
 
 	var deployErr error
+	var realHelmDeployer *realhelmdeployer.RealHelmDeployer
 
 	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
 
 	if shouldUseRealHelmDeployer {
 		// Apply helm install/upgrade. Afterwards get the list of deployed resources by helm get release.
-		// The list is filtered, i.e. it contains only the resources that are needed for the default readiness check.
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.targetAccess, h.DeployItem)
-		deployErr = realHelmDeployer.Deploy(ctx)
-		if deployErr == nil {
-			managedResourceStatusList, err := realHelmDeployer.GetManagedResourcesStatus(ctx)
+		// The list is filtered, i.e. it contains only the resources that are needed for the default readiness check
+		// (or all resources if the generic readiness check is enabled).
+		realHelmDeployer = realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.values, h.targetAccess, h.DeployItem)
+
+		h.ProviderStatus.Diff = nil
+		if h.ProviderConfiguration.Diff != nil {
+			diff, err := h.diffRelease(ctx, realHelmDeployer, ch, values)
 			if err != nil {
 				return err
 			}
-			h.ProviderStatus.ManagedResources = managedResourceStatusList
+			h.ProviderStatus.Diff = diff
+		}
+
+		if h.isDiffOnly() {
+			logger.Info("release is neither installed nor upgraded, because only a diff is configured")
+		} else {
+			deployErr = realHelmDeployer.Deploy(ctx)
+			if deployErr == nil {
+				managedResourceStatusList, err := realHelmDeployer.GetManagedResourcesStatus(ctx)
+				if err != nil {
+					return err
+				}
+				h.ProviderStatus.ManagedResources = managedResourceStatusList
+			}
 		}
 
 	} else {
@@ -103,6 +119,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
 	}
 
+	if shouldUseRealHelmDeployer && h.isDiffOnly() {
+		// nothing has been deployed, so that there is nothing to check or export
+		h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		return nil
+	}
+
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmBeforeReadinessCheck); err != nil {
 		return err
 	}
@@ -111,6 +133,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return err
 	}
 
+	if shouldUseRealHelmDeployer && h.ProviderConfiguration.HelmDeploymentConfig != nil && h.ProviderConfiguration.HelmDeploymentConfig.RunTests {
+		if err := h.runTests(ctx, currOp, realHelmDeployer); err != nil {
+			return err
+		}
+	}
+
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmBeforeReadingExportValues); err != nil {
 		return err
 	}
@@ -124,12 +152,39 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 	return nil
 }
 
+// runTests runs the tests of the release, unless they have already succeeded for the deployed revision of the release
+// or for the revision from which the release has been upgraded without changes.
+// The tests run after the readiness checks, so that they do not fail because the release is not yet ready.
+func (h *Helm) runTests(ctx context.Context, currOp string, realHelmDeployer *realhelmdeployer.RealHelmDeployer) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if realHelmDeployer.NeedsTests(h.ProviderStatus.TestedRevision) {
+		if err := realHelmDeployer.RunTests(ctx); err != nil {
+			return err
+		}
+	} else {
+		logger.Info("tests are skipped, because they have already succeeded for the unchanged release", "revision", realHelmDeployer.Revision())
+	}
+
+	// an upgrade without changes keeps the release tested, so that the tests are skipped for further upgrades without changes
+	h.ProviderStatus.TestedRevision = realHelmDeployer.Revision()
+	var err error
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	return nil
+}
+
 func (h *Helm) applyManifests(ctx context.Context, manifests []managedresource.Manifest) error {
 
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmStartApplyManifests); err != nil {
 		return err
 	}
 
+	// like helm itself, the helm deployer deletes all resources that have been removed from the chart
+	pruneProtection := &managedresource.PruneProtection{DisableDefaultProtectedResources: true}
+
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
 		KubeClient:       h.targetAccess.TargetClient(),
@@ -138,12 +193,14 @@ func (h *Helm) applyManifests(ctx context.Context, manifests []managedresource.M
 		DeployItemName:   h.DeployItem.Name,
 		DeployItem:       h.DeployItem,
 		UpdateStrategy:   manifestv1alpha2.UpdateStrategy(h.ProviderConfiguration.UpdateStrategy),
+		ServerSideApply:  h.ProviderConfiguration.ServerSideApply,
 		Manifests:        manifests,
 		ManagedResources: h.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
 			helmv1alpha1.ManagedDeployItemLabel: h.DeployItem.Name,
 		},
 		DeletionGroupsDuringUpdate: h.ProviderConfiguration.DeletionGroupsDuringUpdate,
+		PruneProtection:            pruneProtection,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
 		LsUncachedClient:           h.lsUncachedClient,
 		LsRestConfig:               h.lsRestConfig,
@@ -162,6 +219,11 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		return nil, err
 	}
 
+	return h.parseManifests(logger, currOp, files, crds)
+}
+
+// parseManifests creates the manifests for the applier from the templated files and crds of the chart.
+func (h *Helm) parseManifests(logger logging.Logger, currOp string, files, crds map[string]string) ([]managedresource.Manifest, error) {
 	objects, err := kutil.ParseFilesToRawExtension(logger, files)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err,
@@ -231,6 +293,7 @@ func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, fa
 			ManagedResources:    h.ProviderStatus.ManagedResources.TypedObjectReferenceList(),
 			FailOnMissingObject: failOnMissingObject,
 			InterruptionChecker: interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+			Generic:             h.ProviderConfiguration.ReadinessChecks.Generic,
 		}
 		err := defaultReadinessCheck.CheckResourcesReady()
 		if err != nil {
@@ -356,7 +419,7 @@ func (h *Helm) deleteManifestsWithRealHelmDeployer(ctx context.Context, di *lsv1
 		return err
 	}
 
-	realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(nil, h.ProviderConfiguration, h.targetAccess, di)
+	realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(nil, h.ProviderConfiguration, nil, h.targetAccess, di)
 
 	err := realHelmDeployer.Undeploy(ctx)
 	if err != nil {
@@ -376,7 +439,7 @@ func (h *Helm) constructExportsFromValues(values map[string]interface{}) (map[st
 		exportDefs = append(exportDefs, h.ProviderConfiguration.Exports.Exports...)
 	}
 	for _, export := range exportDefs {
-		if export.FromResource != nil {
+		if export.FromResource != nil || len(export.Expression) != 0 {
 			continue
 		}
 
@@ -12,7 +12,6 @@ import (
 	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/engine"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,6 +25,7 @@ import (
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/utils"
 )
@@ -63,6 +63,10 @@ type Helm struct {
 	ProviderStatus        *helmv1alpha1.ProviderStatus
 
 	targetAccess *lib.TargetAccess
+
+	// values are the inline values merged with the values of all sources.
+	// They are resolved when the chart is templated, and used for the install or upgrade of the release.
+	values map[string]interface{}
 }
 
 // New creates a new internal helm item
@@ -141,8 +145,14 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 
 	useChartCache := helper.HasCacheHelmChartsAnnotation(&h.DeployItem.ObjectMeta)
 
+	keyring, err := h.getChartVerificationKeyring(ctx)
+	if err != nil {
+		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "GetChartVerificationKeyring", err.Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+
 	ch, err := chartresolver.GetChart(ctx, &h.ProviderConfiguration.Chart, h.lsUncachedClient, h.Context,
-		registryPullSecrets, h.Configuration.OCI, useChartCache)
+		registryPullSecrets, h.Configuration.OCI, useChartCache, keyring)
 	if err != nil {
 		if h.isDownloadInfoError(err) {
 			return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "GetHelmChart", err.Error(), lsv1alpha1.ErrorForInfoOnly)
@@ -158,12 +168,12 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 		IsInstall: true,
 	}
 
-	values := make(map[string]interface{})
-	if err := yaml.Unmarshal(h.ProviderConfiguration.Values, &values); err != nil {
-		return nil, nil, nil, nil, lserrors.NewWrappedError(
-			err, currOp, "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	var lsErr lserrors.LsError
+	h.values, lsErr = h.resolveValues(ctx)
+	if lsErr != nil {
+		return nil, nil, nil, nil, lsErr
 	}
-	values, err = chartutil.ToRenderValues(ch, values, options, nil)
+	values, err := chartutil.ToRenderValues(ch, h.values, options, nil)
 	if err != nil {
 		return nil, nil, nil, nil, lserrors.NewWrappedError(
 			err, currOp, "PrepareHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
@@ -174,10 +184,9 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 	crdsForManifestDeployer := map[string]string{}
 	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
 	if !shouldUseRealHelmDeployer {
-		filesForManifestDeployer, err = engine.RenderWithClient(ch, values, h.targetAccess.TargetRestConfig())
-		if err != nil {
-			return nil, nil, nil, nil, lserrors.NewWrappedError(
-				err, currOp, "RenderHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		filesForManifestDeployer, lsErr = h.renderFiles(currOp, ch, values)
+		if lsErr != nil {
+			return nil, nil, nil, nil, lsErr
 		}
 
 		for _, crd := range ch.CRDObjects() {
@@ -188,6 +197,22 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 	return filesForManifestDeployer, crdsForManifestDeployer, values, ch, nil
 }
 
+// renderFiles renders the templates of the chart, and applies the post-renderer if one is configured.
+func (h *Helm) renderFiles(currOp string, ch *chart.Chart, values map[string]interface{}) (map[string]string, lserrors.LsError) {
+	files, err := engine.RenderWithClient(ch, values, h.targetAccess.TargetRestConfig())
+	if err != nil {
+		return nil, lserrors.NewWrappedError(
+			err, currOp, "RenderHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	files, err = postrenderer.PostRenderFiles(postrenderer.New(h.ProviderConfiguration.PostRenderer), files)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(
+			err, currOp, "PostRenderHelmFiles", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return files, nil
+}
+
 func (h *Helm) isDownloadInfoError(err error) bool {
 	msg := err.Error()
 	return strings.Contains(msg, "no chart name found") ||
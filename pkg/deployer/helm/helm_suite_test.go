@@ -153,11 +153,12 @@ var _ = Describe("Template", func() {
 			callerName := fmt.Sprintf("helmintegration%s", counter)
 			controllerName := fmt.Sprintf("helm-testcontroller-%s", counter)
 
-			Expect(helm.AddDeployerToManager(
+			_, err = helm.AddDeployerToManager(
 				mgr.GetClient(), mgr.GetClient(), mgr.GetClient(), mgr.GetClient(),
 				lsutils.NewFinishedObjectCache(),
 				logging.Wrap(simplelogger.NewIOLogger(GinkgoWriter)), mgr, mgr, helmv1alpha1.Configuration{},
-				callerName, controllerName)).To(Succeed())
+				callerName, controllerName)
+			Expect(err).ToNot(HaveOccurred())
 
 			timeout.ActivateStandardTimeoutChecker()
 
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/lib/kustomize"
+)
+
+const (
+	// PostRenderedFileName is the name of the file that contains all post-rendered resources.
+	PostRenderedFileName = "post-rendered.yaml"
+
+	resourcesFileName = "resources.yaml"
+)
+
+// KustomizePostRenderer applies the patches and image rewrites of a post-renderer configuration with kustomize.
+type KustomizePostRenderer struct {
+	config *helmv1alpha1.PostRendererConfiguration
+}
+
+var _ postrender.PostRenderer = &KustomizePostRenderer{}
+
+// New creates a post-renderer for the given configuration. It returns nil if no post-renderer is configured,
+// so that the result can directly be used as post-renderer of helm actions.
+func New(config *helmv1alpha1.PostRendererConfiguration) postrender.PostRenderer {
+	if config == nil {
+		return nil
+	}
+	return &KustomizePostRenderer{config: config}
+}
+
+// Run applies the post-renderer configuration to the rendered manifests.
+func (r *KustomizePostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	if len(strings.TrimSpace(renderedManifests.String())) == 0 {
+		return renderedManifests, nil
+	}
+
+	fs := filesys.MakeFsInMemory()
+	if err := fs.WriteFile(filepath.Join("/", resourcesFileName), renderedManifests.Bytes()); err != nil {
+		return nil, fmt.Errorf("unable to write rendered manifests: %w", err)
+	}
+
+	resMap, err := kustomize.Build(fs, r.kustomization())
+	if err != nil {
+		return nil, fmt.Errorf("unable to post-render manifests: %w", err)
+	}
+
+	result, err := resMap.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode post-rendered manifests: %w", err)
+	}
+	return bytes.NewBuffer(result), nil
+}
+
+func (r *KustomizePostRenderer) kustomization() *types.Kustomization {
+	k := kustomize.NewKustomization([]string{resourcesFileName}, r.config.Patches)
+
+	for _, image := range r.config.Images {
+		k.Images = append(k.Images, types.Image{
+			Name:    image.Name,
+			NewName: image.NewName,
+			NewTag:  image.NewTag,
+			Digest:  image.Digest,
+		})
+	}
+
+	if len(r.config.Labels) != 0 {
+		k.Labels = []types.Label{{Pairs: r.config.Labels}}
+	}
+
+	return k
+}
+
+// PostRenderFiles applies the post-renderer to rendered chart files, as helm does before it deploys a release.
+// The files are concatenated in a stable order, and the result contains all post-rendered resources
+// in a single file. Notes are not post-rendered and remain unchanged.
+func PostRenderFiles(postRenderer postrender.PostRenderer, files map[string]string) (map[string]string, error) {
+	if postRenderer == nil {
+		return files, nil
+	}
+
+	result := map[string]string{}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if _, file := filepath.Split(name); file == "NOTES.txt" {
+			result[name] = files[name]
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	for _, name := range names {
+		if len(strings.TrimSpace(files[name])) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "---\n# Source: %s\n%s\n", name, files[name])
+	}
+
+	postRendered, err := postRenderer.Run(buf)
+	if err != nil {
+		return nil, err
+	}
+	result[PostRenderedFileName] = postRendered.String()
+	return result, nil
+}
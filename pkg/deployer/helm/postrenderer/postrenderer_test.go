@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/kustomize"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
+)
+
+const deployment = `# Source: chart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+      - name: app
+        image: docker.io/library/nginx:1.25
+`
+
+var _ = Describe("Post-Renderer", func() {
+
+	render := func(config *helmv1alpha1.PostRendererConfiguration, manifests string) *appsv1.Deployment {
+		result, err := postrenderer.New(config).Run(bytes.NewBufferString(manifests))
+		Expect(err).ToNot(HaveOccurred())
+		deploy := &appsv1.Deployment{}
+		Expect(yaml.Unmarshal(result.Bytes(), deploy)).To(Succeed())
+		return deploy
+	}
+
+	It("should return nil if no post-renderer is configured", func() {
+		Expect(postrenderer.New(nil)).To(BeNil())
+	})
+
+	It("should apply a strategic merge patch", func() {
+		deploy := render(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []kustomize.Patch{{
+				Patch: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+spec:
+  template:
+    spec:
+      tolerations:
+      - key: dedicated
+        operator: Exists
+`,
+			}},
+		}, deployment)
+		Expect(deploy.Spec.Template.Spec.Tolerations).To(HaveLen(1))
+		Expect(deploy.Spec.Template.Spec.Tolerations[0].Key).To(Equal("dedicated"))
+		Expect(deploy.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("should apply a JSON6902 patch", func() {
+		deploy := render(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []kustomize.Patch{{
+				Patch: `
+- op: add
+  path: /metadata/annotations
+  value:
+    patched: "true"
+`,
+				Target: &kustomize.PatchTarget{Kind: "Deployment", Name: "app"},
+			}},
+		}, deployment)
+		Expect(deploy.Annotations).To(HaveKeyWithValue("patched", "true"))
+	})
+
+	It("should rewrite images and add labels", func() {
+		deploy := render(&helmv1alpha1.PostRendererConfiguration{
+			Images: []helmv1alpha1.ImageRewrite{{
+				Name:    "docker.io/library/nginx",
+				NewName: "registry.example.com/nginx",
+				NewTag:  "1.26",
+			}},
+			Labels: map[string]string{"team": "a"},
+		}, deployment)
+		Expect(deploy.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.example.com/nginx:1.26"))
+		Expect(deploy.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(deploy.Spec.Selector.MatchLabels).ToNot(HaveKey("team"))
+	})
+
+	It("should post-render chart files into a single file and keep the notes", func() {
+		files := map[string]string{
+			"chart/templates/deployment.yaml": deployment,
+			"chart/templates/_helpers.tpl":    "",
+			"chart/templates/NOTES.txt":       "some notes",
+		}
+		result, err := postrenderer.PostRenderFiles(postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+			Labels: map[string]string{"team": "a"},
+		}), files)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(HaveLen(2))
+		Expect(result).To(HaveKeyWithValue("chart/templates/NOTES.txt", "some notes"))
+		Expect(result[postrenderer.PostRenderedFileName]).To(ContainSubstring("team: a"))
+	})
+})
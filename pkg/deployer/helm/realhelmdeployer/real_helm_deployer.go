@@ -5,17 +5,19 @@
 package realhelmdeployer
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
@@ -28,7 +30,6 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
-	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
@@ -36,6 +37,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
@@ -46,74 +48,132 @@ const (
 	TimeoutCheckpointHelmBeforeInstallingRelease = "helm deployer: before installing release"
 	TimeoutCheckpointHelmBeforeUpgradingRelease  = "helm deployer: before upgrading release"
 	TimeoutCheckpointHelmBeforeDeletingRelease   = "helm deployer: before deleting release"
+	TimeoutCheckpointHelmBeforeRunningTests      = "helm deployer: before running tests"
 )
 
+// maxTestLogSize is the maximal number of bytes of test pod logs that are added to the error of failed tests.
+const maxTestLogSize = 4096
+
 type RealHelmDeployer struct {
-	chart              *chart.Chart
-	decoder            runtime.Decoder
-	releaseName        string
-	defaultNamespace   string
-	rawValues          json.RawMessage
-	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
-	createNamespace    bool
-	targetRestConfig   *rest.Config
-	apiResourceHandler *resourcemanager.ApiResourceHandler
-	helmSecretManager  *HelmSecretManager
-	di                 *lsv1alpha1.DeployItem
-	messages           []string
-	mutex              sync.RWMutex
+	chart                 *chart.Chart
+	decoder               runtime.Decoder
+	releaseName           string
+	defaultNamespace      string
+	values                map[string]interface{}
+	helmConfig            *helmv1alpha1.HelmDeploymentConfiguration
+	postRenderer          postrender.PostRenderer
+	createNamespace       bool
+	genericReadinessCheck bool
+	targetRestConfig      *rest.Config
+	apiResourceHandler    *resourcemanager.ApiResourceHandler
+	helmSecretManager     *HelmSecretManager
+	di                    *lsv1alpha1.DeployItem
+	messages              []string
+	mutex                 sync.RWMutex
+
+	// revision is the revision of the release that has been installed or upgraded by Deploy.
+	revision int
+	// previousRevision is the revision of the release before it has been upgraded by Deploy.
+	previousRevision int
+	// changed is false if Deploy has upgraded the release without changing its chart, values, manifests or hooks.
+	changed bool
 }
 
-func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration,
+// NewRealHelmDeployer creates a deployer for the release of the given provider configuration.
+// The values are the resolved values of the release; they are only required to install or upgrade the release.
+func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration, values map[string]interface{},
 	targetAccess *lib.TargetAccess, di *lsv1alpha1.DeployItem) *RealHelmDeployer {
 
 	return &RealHelmDeployer{
-		chart:              ch,
-		decoder:            serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
-		releaseName:        providerConfig.Name,
-		defaultNamespace:   providerConfig.Namespace,
-		rawValues:          providerConfig.Values,
-		helmConfig:         providerConfig.HelmDeploymentConfig,
-		createNamespace:    providerConfig.CreateNamespace,
-		targetRestConfig:   targetAccess.TargetRestConfig(),
-		apiResourceHandler: resourcemanager.CreateApiResourceHandler(targetAccess.TargetClientSet()),
-		helmSecretManager:  nil,
-		di:                 di,
-		messages:           make([]string, 0),
+		chart:                 ch,
+		decoder:               serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
+		releaseName:           providerConfig.Name,
+		defaultNamespace:      providerConfig.Namespace,
+		values:                values,
+		helmConfig:            providerConfig.HelmDeploymentConfig,
+		postRenderer:          postrenderer.New(providerConfig.PostRenderer),
+		createNamespace:       providerConfig.CreateNamespace,
+		genericReadinessCheck: providerConfig.ReadinessChecks.Generic,
+		targetRestConfig:      targetAccess.TargetRestConfig(),
+		apiResourceHandler:    resourcemanager.CreateApiResourceHandler(targetAccess.TargetClientSet()),
+		helmSecretManager:     nil,
+		di:                    di,
+		messages:              make([]string, 0),
 	}
 }
 
 func (c *RealHelmDeployer) Deploy(ctx context.Context) error {
 	op := "RealHelmDeployer.Deploy"
-	values := make(map[string]interface{})
-	if err := yaml.Unmarshal(c.rawValues, &values); err != nil {
-		return lserrors.NewWrappedError(
-			err, "Deploy", "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	values := c.values
+	if values == nil {
+		values = make(map[string]interface{})
 	}
 
-	_, err := c.getRelease(ctx)
+	previous, err := c.getRelease(ctx)
 	if err != nil && c.isReleaseNotFoundErr(err) {
-		_, err = c.installRelease(ctx, values)
+		rel, err := c.installRelease(ctx, values)
 
 		if err != nil {
 			helmMsg := c.getMessages()
 			helmMsg = helmMsg + "\n" + err.Error()
 			return lserrors.NewWrappedError(err, op, "installRelease", helmMsg)
 		}
+		c.revision = rel.Version
+		c.changed = true
 		return nil
 	} else if err != nil {
 		return err
 	} else {
-		_, err = c.upgradeRelease(ctx, values)
+		rel, err := c.upgradeRelease(ctx, values)
 		if err != nil {
 			helmMsg := c.getMessages()
 			helmMsg = helmMsg + "\n" + err.Error()
 			return lserrors.NewWrappedError(err, op, "upgradeRelease", helmMsg)
 		}
+		c.revision = rel.Version
+		c.previousRevision = previous.Version
+		c.changed = releaseChanged(previous, rel)
 		return nil
 	}
 }
 
+// releaseChanged checks whether an upgrade has changed the chart, the values, the manifests or the hooks of a release.
+func releaseChanged(previous, current *release.Release) bool {
+	if previous.Manifest != current.Manifest || !reflect.DeepEqual(previous.Config, current.Config) {
+		return true
+	}
+	if previous.Chart == nil || current.Chart == nil || !reflect.DeepEqual(previous.Chart.Metadata, current.Chart.Metadata) {
+		return true
+	}
+	if len(previous.Hooks) != len(current.Hooks) {
+		return true
+	}
+	for i := range previous.Hooks {
+		if previous.Hooks[i].Name != current.Hooks[i].Name || previous.Hooks[i].Manifest != current.Hooks[i].Manifest {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsTests checks whether the tests have to be run for the release that has been deployed by Deploy.
+// The given revision is the revision whose tests have succeeded last. The tests are not needed if the release
+// still has this revision, or if it has been upgraded from this revision without any change.
+func (c *RealHelmDeployer) NeedsTests(testedRevision int) bool {
+	if testedRevision == 0 || c.revision == 0 {
+		return true
+	}
+	if c.revision == testedRevision {
+		return false
+	}
+	return c.changed || c.previousRevision != testedRevision
+}
+
+// Revision returns the revision of the release that has been installed or upgraded by Deploy.
+func (c *RealHelmDeployer) Revision() int {
+	return c.revision
+}
+
 func (c *RealHelmDeployer) Undeploy(ctx context.Context) error {
 	return c.deleteRelease(ctx)
 }
@@ -143,6 +203,18 @@ func (c *RealHelmDeployer) getRelease(ctx context.Context) (*release.Release, er
 	return rls, err
 }
 
+// GetDeployedRelease returns the deployed release, or nil if the release is not installed.
+func (c *RealHelmDeployer) GetDeployedRelease(ctx context.Context) (*release.Release, error) {
+	rls, err := c.getRelease(ctx)
+	if err != nil {
+		if c.isReleaseNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rls, nil
+}
+
 // installRelease creates a helm release
 func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string]interface{}) (*release.Release, error) {
 	currOp := "InstallHelmRelease"
@@ -166,6 +238,7 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.CreateNamespace = c.createNamespace
 	install.Atomic = installConfig.Atomic
 	install.Force = installConfig.Force
+	install.PostRenderer = c.postRenderer
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeInstallingRelease)
 	if err != nil {
@@ -219,6 +292,7 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.MaxHistory = 10
 	upgrade.Atomic = upgradeConfig.Atomic
 	upgrade.Force = upgradeConfig.Force
+	upgrade.PostRenderer = c.postRenderer
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeUpgradingRelease)
 	if err != nil {
@@ -253,6 +327,59 @@ func (c *RealHelmDeployer) isHelmUpgradeMessage(message string) bool {
 		strings.Contains(message, "YAML parse error on")
 }
 
+// RunTests runs the test hooks of the deployed release, and waits until they are finished or the deploy item times out.
+// If a test fails, the returned error contains the end of the logs of the test pods.
+func (c *RealHelmDeployer) RunTests(ctx context.Context) error {
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+	return c.runTests(ctx, actionConfig)
+}
+
+func (c *RealHelmDeployer) runTests(ctx context.Context, actionConfig *action.Configuration) error {
+	currOp := "RunHelmTests"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	logger.Info(fmt.Sprintf("running tests of release %s", c.releaseName))
+
+	releaseTesting := action.NewReleaseTesting(actionConfig)
+	releaseTesting.Namespace = c.defaultNamespace
+
+	timeout, lsErr := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeRunningTests)
+	if lsErr != nil {
+		return lsErr
+	}
+	releaseTesting.Timeout = timeout
+
+	rel, err := releaseTesting.Run(c.releaseName)
+	if err != nil {
+		message := fmt.Sprintf("tests of helm chart release failed: %s", err.Error())
+		if rel != nil {
+			logs := &bytes.Buffer{}
+			if logErr := releaseTesting.GetPodLogs(logs, rel); logErr != nil {
+				logger.Info("unable to get logs of test pods", lc.KeyError, logErr.Error())
+			}
+			if logs.Len() > 0 {
+				message = message + "\n" + tail(logs.String(), maxTestLogSize)
+			}
+		}
+		logger.Info(message)
+		return lserrors.NewWrappedError(err, currOp, "RunTests", message)
+	}
+
+	logger.Info(fmt.Sprintf("tests of release %s succeeded", c.releaseName))
+	return nil
+}
+
+// tail returns the last bytes of the given string, at most maxSize.
+func tail(s string, maxSize int) string {
+	if len(s) <= maxSize {
+		return s
+	}
+	return "..." + s[len(s)-maxSize:]
+}
+
 func (c *RealHelmDeployer) deleteRelease(ctx context.Context) error {
 	currOp := "DeleteHelmRelease"
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
@@ -428,7 +555,7 @@ func (c *RealHelmDeployer) GetManagedResourcesStatus(ctx context.Context) ([]man
 			continue
 		}
 
-		if !readinesscheck.IsRelevantForDefaultReadinessCheck(obj.groupVersionKind().GroupKind()) {
+		if !readinesscheck.IsRelevantForReadinessCheck(obj.groupVersionKind().GroupKind(), c.genericReadinessCheck) {
 			continue
 		}
 
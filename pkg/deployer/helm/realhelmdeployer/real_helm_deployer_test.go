@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/client-go/rest"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+)
+
+var _ = Describe("Real Helm Deployer", func() {
+
+	newRelease := func(version int, manifest string) *release.Release {
+		return &release.Release{
+			Name:      "test",
+			Namespace: "test-ns",
+			Version:   version,
+			Info:      &release.Info{Status: release.StatusDeployed},
+			Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "test", Version: "1.0.0"}},
+			Config:    map[string]interface{}{"key": "value"},
+			Manifest:  manifest,
+			Hooks: []*release.Hook{{
+				Name:     "test-pod",
+				Kind:     "Pod",
+				Path:     "test/templates/test.yaml",
+				Manifest: "kind: Pod",
+				Events:   []release.HookEvent{release.HookTest},
+			}},
+		}
+	}
+
+	Context("Tests", func() {
+
+		var (
+			ctx          context.Context
+			deployer     *RealHelmDeployer
+			actionConfig *action.Configuration
+			logs         string
+			server       *httptest.Server
+		)
+
+		BeforeEach(func() {
+			timeout.ActivateIgnoreTimeoutChecker()
+			ctx = logging.NewContextWithDiscard(context.Background())
+
+			logs = ""
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/test-ns/pods/test-pod/log" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, logs)
+			}))
+
+			deployer = &RealHelmDeployer{
+				releaseName:      "test",
+				defaultNamespace: "test-ns",
+				di:               &lsv1alpha1.DeployItem{},
+			}
+			actionConfig = &action.Configuration{
+				RESTClientGetter: newRemoteRESTClientGetter(&rest.Config{Host: server.URL}, "test-ns"),
+				Releases:         storage.Init(driver.NewMemory()),
+				KubeClient:       &kubefake.PrintingKubeClient{Out: io.Discard},
+				Log:              func(_ string, _ ...interface{}) {},
+			}
+			Expect(actionConfig.Releases.Create(newRelease(1, ""))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			server.Close()
+			timeout.ActivateStandardTimeoutChecker()
+		})
+
+		It("should succeed if the tests succeed", func() {
+			Expect(deployer.runTests(ctx, actionConfig)).To(Succeed())
+
+			rel, err := actionConfig.Releases.Last("test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rel.Hooks[0].LastRun.Phase).To(Equal(release.HookPhaseSucceeded))
+		})
+
+		It("should fail with the end of the logs of the test pods if a test fails", func() {
+			lines := make([]string, 0, 1000)
+			for i := 0; i < 1000; i++ {
+				lines = append(lines, fmt.Sprintf("log line %d", i))
+			}
+			logs = strings.Join(lines, "\n")
+			actionConfig.KubeClient = &kubefake.FailingKubeClient{
+				PrintingKubeClient:   kubefake.PrintingKubeClient{Out: io.Discard},
+				WatchUntilReadyError: errors.New("test pod failed"),
+			}
+
+			err := deployer.runTests(ctx, actionConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("tests of helm chart release failed: test pod failed"))
+			Expect(err.Error()).To(ContainSubstring("log line 999"))
+			Expect(err.Error()).ToNot(ContainSubstring("log line 0\n"))
+			Expect(err.Error()).ToNot(ContainSubstring("POD LOGS: test-pod"))
+
+			rel, err := actionConfig.Releases.Last("test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rel.Hooks[0].LastRun.Phase).To(Equal(release.HookPhaseFailed))
+		})
+
+		It("should fail without logs if the logs cannot be read", func() {
+			deployer.defaultNamespace = "other-ns"
+			actionConfig.KubeClient = &kubefake.FailingKubeClient{
+				PrintingKubeClient:   kubefake.PrintingKubeClient{Out: io.Discard},
+				WatchUntilReadyError: errors.New("test pod failed"),
+			}
+
+			err := deployer.runTests(ctx, actionConfig)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("tests of helm chart release failed: test pod failed"))
+		})
+	})
+
+	Context("tail", func() {
+		It("should return short strings unchanged", func() {
+			Expect(tail("abc", 3)).To(Equal("abc"))
+		})
+
+		It("should return the end of long strings", func() {
+			Expect(tail("abcdef", 3)).To(Equal("...def"))
+		})
+	})
+
+	Context("NeedsTests", func() {
+		It("should need tests if no revision has been tested", func() {
+			deployer := &RealHelmDeployer{revision: 1, changed: true}
+			Expect(deployer.NeedsTests(0)).To(BeTrue())
+		})
+
+		It("should not need tests for the tested revision", func() {
+			deployer := &RealHelmDeployer{revision: 2}
+			Expect(deployer.NeedsTests(2)).To(BeFalse())
+		})
+
+		It("should need tests if the tested revision has been changed by an upgrade", func() {
+			deployer := &RealHelmDeployer{revision: 3, previousRevision: 2, changed: true}
+			Expect(deployer.NeedsTests(2)).To(BeTrue())
+		})
+
+		It("should not need tests if the tested revision has been upgraded without changes", func() {
+			deployer := &RealHelmDeployer{revision: 3, previousRevision: 2, changed: false}
+			Expect(deployer.NeedsTests(2)).To(BeFalse())
+		})
+
+		It("should need tests if an untested revision has been upgraded", func() {
+			deployer := &RealHelmDeployer{revision: 3, previousRevision: 2, changed: false}
+			Expect(deployer.NeedsTests(1)).To(BeTrue())
+		})
+	})
+
+	Context("releaseChanged", func() {
+		It("should detect upgrades without changes", func() {
+			Expect(releaseChanged(newRelease(1, "manifest"), newRelease(2, "manifest"))).To(BeFalse())
+		})
+
+		It("should detect changes of the manifests, values, chart and hooks", func() {
+			previous := newRelease(1, "manifest")
+			Expect(releaseChanged(previous, newRelease(2, "other manifest"))).To(BeTrue())
+
+			current := newRelease(2, "manifest")
+			current.Config = map[string]interface{}{"key": "other value"}
+			Expect(releaseChanged(previous, current)).To(BeTrue())
+
+			current = newRelease(2, "manifest")
+			current.Chart.Metadata.Version = "1.1.0"
+			Expect(releaseChanged(previous, current)).To(BeTrue())
+
+			current = newRelease(2, "manifest")
+			current.Hooks[0].Manifest = "kind: Job"
+			Expect(releaseChanged(previous, current)).To(BeTrue())
+		})
+	})
+})
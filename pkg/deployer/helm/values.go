@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/apis/mediatype"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// resolveValues parses the inline values of the provider configuration, and merges the values of all
+// configured sources on top of them in the given order.
+func (h *Helm) resolveValues(ctx context.Context) (map[string]interface{}, lserrors.LsError) {
+	currOp := "ResolveHelmValues"
+
+	values := make(map[string]interface{})
+	if err := yaml.Unmarshal(h.ProviderConfiguration.Values, &values); err != nil {
+		return nil, lserrors.NewWrappedError(
+			err, currOp, "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	for i := range h.ProviderConfiguration.ValuesFrom {
+		source := &h.ProviderConfiguration.ValuesFrom[i]
+
+		content, err := h.readValuesFromSource(ctx, source)
+		if err != nil {
+			msg := fmt.Sprintf("unable to read values of valuesFrom[%d]: %s", i, err.Error())
+			return nil, lserrors.NewWrappedError(err, currOp, "ReadValuesFrom", msg)
+		}
+
+		sourceValues, err := ValuesFromContent(content, source.JSONPath)
+		if err != nil {
+			msg := fmt.Sprintf("unable to parse values of valuesFrom[%d]: %s", i, err.Error())
+			return nil, lserrors.NewWrappedError(err, currOp, "ParseValuesFrom", msg, lsv1alpha1.ErrorConfigurationProblem)
+		}
+
+		values = utils.MergeMaps(values, sourceValues)
+	}
+
+	return values, nil
+}
+
+// ValuesFromContent converts the content of a values source into helm values.
+// Without json path, the content must be a yaml or json object. With json path, the content is inserted at the path:
+// objects and lists are inserted as parsed values, and all other content as string, so that for example a password
+// from a secret keeps its exact value.
+func ValuesFromContent(content []byte, jsonPath string) (map[string]interface{}, error) {
+	if len(jsonPath) == 0 {
+		values := make(map[string]interface{})
+		if err := yaml.Unmarshal(content, &values); err != nil {
+			return nil, fmt.Errorf("content is no yaml or json object: %w", err)
+		}
+		return values, nil
+	}
+
+	var value interface{} = string(content)
+	var parsed interface{}
+	if err := yaml.Unmarshal(content, &parsed); err == nil {
+		switch parsed.(type) {
+		case map[string]interface{}, []interface{}:
+			value = parsed
+		}
+	}
+	return jsonpath.Construct(jsonPath, value)
+}
+
+// readValuesFromSource reads the raw content of a values source.
+func (h *Helm) readValuesFromSource(ctx context.Context, source *helmv1alpha1.ValuesFromSource) ([]byte, error) {
+	switch {
+	case source.SecretRef != nil:
+		cl, key, err := h.getValuesFromClient(ctx, source.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		secret := &corev1.Secret{}
+		if err := read_write_layer.GetSecret(ctx, cl, key, secret, read_write_layer.R000124); err != nil {
+			return nil, fmt.Errorf("unable to get secret %s: %w", key.String(), err)
+		}
+		data, ok := secret.Data[source.SecretRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key %q", key.String(), source.SecretRef.Key)
+		}
+		return data, nil
+
+	case source.ConfigMapRef != nil:
+		cl, key, err := h.getValuesFromClient(ctx, source.ConfigMapRef)
+		if err != nil {
+			return nil, err
+		}
+		configMap := &corev1.ConfigMap{}
+		if err := read_write_layer.GetObject(ctx, cl, key, configMap, read_write_layer.R000125); err != nil {
+			return nil, fmt.Errorf("unable to get config map %s: %w", key.String(), err)
+		}
+		if data, ok := configMap.Data[source.ConfigMapRef.Key]; ok {
+			return []byte(data), nil
+		}
+		if data, ok := configMap.BinaryData[source.ConfigMapRef.Key]; ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf("config map %s has no key %q", key.String(), source.ConfigMapRef.Key)
+
+	case source.ResourceRef != nil:
+		return h.readValuesFromResource(ctx, source.ResourceRef)
+	}
+
+	return nil, errors.New("no source of values defined")
+}
+
+// getValuesFromClient returns the client and the key of a referenced secret or config map.
+// Objects in the landscaper cluster are read from the namespace of the deploy item.
+func (h *Helm) getValuesFromClient(ctx context.Context, ref *helmv1alpha1.ValuesFromObjectReference) (client.Client, client.ObjectKey, error) {
+	if ref.TargetName == nil {
+		return h.lsUncachedClient, kutil.ObjectKey(ref.Name, h.DeployItem.Namespace), nil
+	}
+
+	targetClient, err := lib.GetTargetClientConsideringSecondaryTarget(ctx, h.targetAccess.TargetClient(), h.lsUncachedClient,
+		h.DeployItem, ref.TargetName, h.lsRestConfig)
+	if err != nil {
+		return nil, client.ObjectKey{}, err
+	}
+	return targetClient, kutil.ObjectKey(ref.Name, ref.Namespace), nil
+}
+
+// readValuesFromResource reads the content of a resource of a component version.
+func (h *Helm) readValuesFromResource(ctx context.Context, ref *helmv1alpha1.ValuesFromResourceReference) ([]byte, error) {
+	content, err := lib.GetComponentResourceContent(ctx, h.lsUncachedClient, h.Context, h.Configuration.OCI,
+		&ref.ComponentDescriptorDefinition, ref.ResourceName, mediatype.HelmValuesType)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := content.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("received content of type %T but expected type []byte", content)
+	}
+	return data, nil
+}
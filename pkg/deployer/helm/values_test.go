@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Values From", func() {
+
+	It("should parse an object without json path", func() {
+		values, err := helm.ValuesFromContent([]byte("image:\n  tag: v1\nreplicas: 2\n"), "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal(map[string]interface{}{
+			"image":    map[string]interface{}{"tag": "v1"},
+			"replicas": int64(2),
+		}))
+	})
+
+	It("should fail for content that is no object without json path", func() {
+		_, err := helm.ValuesFromContent([]byte("password"), "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should insert an object at the json path", func() {
+		values, err := helm.ValuesFromContent([]byte(`{"user": "admin"}`), ".database.credentials")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal(map[string]interface{}{
+			"database": map[string]interface{}{
+				"credentials": map[string]interface{}{"user": "admin"},
+			},
+		}))
+	})
+
+	It("should insert other content as string at the json path", func() {
+		values, err := helm.ValuesFromContent([]byte("0123"), "database.password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal(map[string]interface{}{
+			"database": map[string]interface{}{"password": "0123"},
+		}))
+	})
+})
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	helmv1alpha1helper "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// getChartVerificationKeyring returns the keyring to verify the provenance of the chart,
+// or nil if the provenance must not be verified.
+func (h *Helm) getChartVerificationKeyring(ctx context.Context) ([]byte, error) {
+	return GetChartVerificationKeyring(ctx, h.lsUncachedClient, h.Configuration.ChartVerification,
+		&h.ProviderConfiguration.Chart, h.DeployItem.Namespace)
+}
+
+// GetChartVerificationKeyring returns the keyring to verify the provenance of the chart,
+// or nil if the provenance must not be verified.
+// The enforcement policy of the deployer configuration decides whether the verification is required, optional, or disabled.
+// If the verification is enforced, charts from sources that cannot be verified are rejected, and the charts are
+// always verified with the keyring of the deployer configuration. A keyring secret of the deploy item is rejected then,
+// because otherwise everyone who can create a deploy item could pass the verification with an own key.
+func GetChartVerificationKeyring(ctx context.Context, lsClient client.Client,
+	config *helmv1alpha1.ChartVerificationConfiguration, chartConfig *helmv1alpha1.Chart, namespace string) ([]byte, error) {
+
+	verification := chartConfig.Verification
+
+	policy := helmv1alpha1.ChartVerificationDoNotEnforce
+	keyringFile := ""
+	if config != nil {
+		policy = config.EnforcementPolicy
+		keyringFile = config.KeyringFile
+	}
+
+	switch policy {
+	case helmv1alpha1.ChartVerificationDisabled:
+		return nil, nil
+	case helmv1alpha1.ChartVerificationEnforce:
+		if !helmv1alpha1helper.IsChartVerifiable(chartConfig) {
+			return nil, errors.New("the provenance of the chart must be verified, " +
+				"but verification is only supported for charts defined by ref, helmChartRepo or archive.remote")
+		}
+		if verification != nil && verification.KeyringSecretRef != nil {
+			return nil, errors.New("the provenance of the chart is verified with the keyring of the deployer, " +
+				"a keyring secret must not be set if the verification is enforced")
+		}
+	case helmv1alpha1.ChartVerificationDoNotEnforce, "":
+		if verification == nil {
+			return nil, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown chart verification enforcement policy %q", policy)
+	}
+
+	if verification != nil && verification.KeyringSecretRef != nil {
+		key := kutil.ObjectKey(verification.KeyringSecretRef.Name, namespace)
+		secret := &corev1.Secret{}
+		if err := read_write_layer.GetSecret(ctx, lsClient, key, secret, read_write_layer.R000126); err != nil {
+			return nil, fmt.Errorf("unable to get keyring secret %s: %w", key.String(), err)
+		}
+
+		keyringKey := verification.KeyringSecretRef.Key
+		if len(keyringKey) == 0 {
+			keyringKey = chartresolver.KeyringSecretDefaultKey
+		}
+		keyring, ok := secret.Data[keyringKey]
+		if !ok {
+			return nil, fmt.Errorf("keyring secret %s has no key %q", key.String(), keyringKey)
+		}
+		return keyring, nil
+	}
+
+	if len(keyringFile) == 0 {
+		return nil, errors.New("the provenance of the chart must be verified, but no keyring is configured")
+	}
+	keyring, err := os.ReadFile(keyringFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring file %q: %w", keyringFile, err)
+	}
+	return keyring, nil
+}
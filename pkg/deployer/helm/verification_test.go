@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Chart Verification Keyring", func() {
+
+	var (
+		ctx         context.Context
+		kubeClient  client.Client
+		keyringFile string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-keyring", Namespace: "default"},
+			Data:       map[string][]byte{"keyring": []byte("deploy item keyring")},
+		}).Build()
+
+		keyringFile = filepath.Join(GinkgoT().TempDir(), "keyring")
+		Expect(os.WriteFile(keyringFile, []byte("deployer keyring"), 0o600)).To(Succeed())
+	})
+
+	newChart := func(keyringSecretRef *lsv1alpha1.LocalSecretReference) *helmv1alpha1.Chart {
+		return &helmv1alpha1.Chart{
+			Ref:          "example.com/charts/mychart:1.0.0",
+			Verification: &helmv1alpha1.ChartVerification{KeyringSecretRef: keyringSecretRef},
+		}
+	}
+
+	newConfig := func(policy helmv1alpha1.ChartVerificationEnforcementPolicy) *helmv1alpha1.ChartVerificationConfiguration {
+		return &helmv1alpha1.ChartVerificationConfiguration{EnforcementPolicy: policy, KeyringFile: keyringFile}
+	}
+
+	It("should use the keyring secret of the deploy item if the verification is not enforced", func() {
+		keyring, err := helm.GetChartVerificationKeyring(ctx, kubeClient, newConfig(helmv1alpha1.ChartVerificationDoNotEnforce),
+			newChart(&lsv1alpha1.LocalSecretReference{Name: "my-keyring"}), "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(keyring)).To(Equal("deploy item keyring"))
+	})
+
+	It("should use the keyring of the deployer if the verification is enforced", func() {
+		keyring, err := helm.GetChartVerificationKeyring(ctx, kubeClient, newConfig(helmv1alpha1.ChartVerificationEnforce),
+			&helmv1alpha1.Chart{Ref: "example.com/charts/mychart:1.0.0"}, "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(keyring)).To(Equal("deployer keyring"))
+	})
+
+	It("should reject the keyring secret of the deploy item if the verification is enforced", func() {
+		_, err := helm.GetChartVerificationKeyring(ctx, kubeClient, newConfig(helmv1alpha1.ChartVerificationEnforce),
+			newChart(&lsv1alpha1.LocalSecretReference{Name: "my-keyring"}), "default")
+		Expect(err).To(MatchError(ContainSubstring("a keyring secret must not be set if the verification is enforced")))
+	})
+
+	It("should not verify the chart if the verification is disabled", func() {
+		keyring, err := helm.GetChartVerificationKeyring(ctx, kubeClient, newConfig(helmv1alpha1.ChartVerificationDisabled),
+			newChart(&lsv1alpha1.LocalSecretReference{Name: "my-keyring"}), "default")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyring).To(BeNil())
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/yaml"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -43,6 +45,7 @@ type DefaultOptions struct {
 
 	configPath   string
 	LsKubeconfig string
+	metricsPort  int
 
 	Log     logging.Logger
 	LsMgr   manager.Manager
@@ -63,6 +66,7 @@ func NewDefaultOptions(deployerScheme *runtime.Scheme) *DefaultOptions {
 func (o *DefaultOptions) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.configPath, "config", "", "Specify the path to the configuration file")
 	fs.StringVar(&o.LsKubeconfig, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+	fs.IntVar(&o.metricsPort, "metrics-port", 0, "Specify the port on which the deployer exposes its metrics (0 disables the metrics endpoint)")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -89,6 +93,11 @@ func (o *DefaultOptions) Complete() error {
 		Cache:          cache.Options{SyncPeriod: ptr.To[time.Duration](time.Hour * 24 * 1000)},
 	}
 
+	if o.metricsPort != 0 {
+		opts.Metrics.BindAddress = fmt.Sprintf(":%d", o.metricsPort)
+		metrics.RegisterDeployItemMetrics(controllerruntimeMetrics.Registry)
+	}
+
 	hostRestConfig, err := ctrl.GetConfig()
 	if err != nil {
 		return fmt.Errorf("unable to get host kubeconfig: %w", err)
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/deployerlegacy"
+)
+
+// GetComponentResourceContent reads the typed content of a resource of a component version, which must be of the given type.
+// The component version is read with the ocm config and the registry pull secrets of the landscaper context.
+// If the component descriptor definition contains no repository context, the repository context of the landscaper context is used.
+func GetComponentResourceContent(ctx context.Context, lsUncachedClient client.Client, lsCtx *lsv1alpha1.Context,
+	ociConfig *config.OCIConfiguration, cdDef *lsv1alpha1.ComponentDescriptorDefinition,
+	resourceName, resourceType string) (interface{}, error) {
+
+	if lsCtx == nil {
+		return nil, fmt.Errorf("no context defined for deploy item")
+	}
+
+	var ocmConfig *corev1.ConfigMap
+	if lsCtx.OCMConfig != nil {
+		ocmConfig = &corev1.ConfigMap{}
+		if err := lsUncachedClient.Get(ctx, kutil.ObjectKey(lsCtx.OCMConfig.Name, lsCtx.Namespace), ocmConfig); err != nil {
+			return nil, fmt.Errorf("unable to get ocm config: %w", err)
+		}
+	}
+
+	registryPullSecrets, err := kutil.ResolveSecrets(ctx, lsUncachedClient, GetRegistryPullSecretsFromContext(lsCtx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve registry pull secrets: %w", err)
+	}
+
+	registryAccess, err := registries.GetFactory().NewRegistryAccess(ctx, &model.RegistryAccessOptions{
+		OcmConfig:         ocmConfig,
+		Secrets:           registryPullSecrets,
+		OciRegistryConfig: ociConfig,
+		InlineCd:          cdDef.Inline,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create registry access: %w", err)
+	}
+
+	cdRef := deployerlegacy.GetReferenceFromComponentDescriptorDefinition(cdDef)
+	if cdRef.RepositoryContext == nil {
+		cdRef = cdRef.DeepCopy()
+		cdRef.RepositoryContext = lsCtx.RepositoryContext
+	}
+
+	componentVersion, err := registryAccess.GetComponentVersion(ctx, cdRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component version %s:%s: %w", cdRef.ComponentName, cdRef.Version, err)
+	}
+
+	resource, err := componentVersion.GetResource(resourceName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get resource %q: %w", resourceName, err)
+	}
+	if resource.GetType() != resourceType {
+		return nil, fmt.Errorf("resource %q is of type %q, but type %q is expected", resourceName, resource.GetType(), resourceType)
+	}
+
+	content, err := resource.GetTypedContent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get content of resource %q: %w", resourceName, err)
+	}
+	return content.Resource, nil
+}
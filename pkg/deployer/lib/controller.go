@@ -36,6 +36,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
@@ -233,6 +234,12 @@ func (c *controller) innerReconcile(ctx context.Context, req reconcile.Request)
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if lsv1alpha1helper.IsSuspended(metadata.ObjectMeta) {
+		// the deploy item is processed again when the suspended label is removed
+		logger.Info("deploy item not reconciled because it is suspended")
+		return reconcile.Result{}, nil
+	}
+
 	// this check is only for compatibility reasons
 	rt, responsible, targetNotFound, err := CheckResponsibility(ctx, c.lsUncachedClient, metadata, c.deployerType, c.targetSelectors)
 	if err != nil {
@@ -293,6 +300,11 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 		return reconcile.Result{}, nil
 	}
 
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveDeployItemReconcile(di, time.Since(startTime))
+	}()
+
 	if hasTestReconcileAnnotation {
 		if err := c.removeTestReconcileAnnotation(ctx, di); err != nil {
 			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -317,9 +329,11 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 			if di.Spec.UpdateOnChangeOnly &&
 				di.GetGeneration() == di.Status.ObservedGeneration &&
 				di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded &&
-				!hasTestReconcileAnnotation {
+				!hasTestReconcileAnnotation &&
+				!lsv1alpha1helper.IsDrifted(di.ObjectMeta) {
 
-				// deployitem is unchanged and succeeded, and no reconcile desired in this case
+				// deployitem is unchanged and succeeded, and no reconcile desired in this case.
+				// Drifted deployitems are always reconciled to correct the drift.
 				c.initStatus(ctx, di)
 				err := c.handleReconcileResult(ctx, nil, old, di)
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -327,17 +341,18 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 			// initialize deployitem for reconcile
 			logger.Debug("Setting deployitem to phase 'Init'", "updateOnChangeOnly", di.Spec.UpdateOnChangeOnly, lc.KeyGeneration, di.GetGeneration(), lc.KeyObservedGeneration, di.Status.ObservedGeneration, lc.KeyDeployItemPhase, di.Status.Phase)
-			di.Status.Phase = lsv1alpha1.DeployItemPhases.Init
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, lsv1alpha1.DeployItemPhases.Init); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		} else {
 			// initialize deployitem for delete
-			di.Status.Phase = lsv1alpha1.DeployItemPhases.InitDelete
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, lsv1alpha1.DeployItemPhases.InitDelete); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		}
+
+		// the initialized status has been written, so that later phase changes are compared against it
+		old = di.DeepCopy()
 	}
 
 	// Create OCM context
@@ -471,13 +486,17 @@ func (c *controller) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(c.lsUncachedClient)
 }
 
-func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem, phase lsv1alpha1.DeployItemPhase) error {
+	oldPhase := di.Status.Phase
+	di.Status.Phase = phase
 	c.initStatus(ctx, di)
 
 	if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000004, di); err != nil {
 		return err
 	}
 
+	metrics.RecordDeployItemPhase(di)
+	lsutil.RecordPhaseChangedEvent(c.lsEventRecorder, di, oldPhase, di.Status.Phase)
 	return nil
 }
 
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	goerrors "errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"ocm.software/ocm/api/datacontext"
+	"ocm.software/ocm/api/ocm"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultDriftDetectionPeriod is the interval in which the drift detection job checks which deploy items are due.
+const DefaultDriftDetectionPeriod = time.Minute
+
+const (
+	// DriftDetectedReason is the reason of a Drifted condition with status true.
+	DriftDetectedReason = "DriftDetected"
+	// NoDriftReason is the reason of a Drifted condition with status false.
+	NoDriftReason = "NoDrift"
+	// DriftDetectionFailedReason is the reason of a Drifted condition with status unknown.
+	DriftDetectionFailedReason = "DriftDetectionFailed"
+)
+
+// DriftDetector is implemented by deployers that support drift detection.
+type DriftDetector interface {
+	// DriftDetectionSpec returns the drift detection configuration of the deploy item,
+	// or nil if drift detection is not configured.
+	DriftDetectionSpec(di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error)
+	// DetectDrift compares the managed resources of the deploy item in the target cluster with their desired state.
+	DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) ([]driftdetection.ResourceDrift, error)
+}
+
+// deployerTypeIndexKey is the key of the index of the cached deploy item metadata by their deployer type annotation.
+const deployerTypeIndexKey = "metadata.annotations.deployerType"
+
+// DriftDetectionJob periodically compares the resources of succeeded deploy items with their desired state.
+// It reports the result in the Drifted condition and label of the deploy items, and triggers a reconcile of
+// drifted deploy items if auto-correction is enabled.
+type DriftDetectionJob struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	detector         DriftDetector
+	deployerType     lsv1alpha1.DeployItemType
+	targetSelectors  []lsv1alpha1.TargetSelector
+	period           time.Duration
+
+	mux        sync.Mutex
+	lastChecks map[types.UID]time.Time
+}
+
+// IndexDeployItemsByDeployerType adds an index of the cached deploy item metadata by their deployer type annotation,
+// which is used by the drift detection job to list only the deploy items of its type.
+// Deploy items without the annotation are indexed with an empty type.
+func IndexDeployItemsByDeployerType(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, lsutil.EmptyDeployItemMetadata(), deployerTypeIndexKey, func(obj client.Object) []string {
+		return []string{obj.GetAnnotations()[lsv1alpha1.DeployerTypeAnnotation]}
+	})
+}
+
+// NewDriftDetectionJob creates a new drift detection job for the deploy items of the given type.
+// The cached client must have an index of the deploy item metadata that is added by IndexDeployItemsByDeployerType.
+func NewDriftDetectionJob(lsUncachedClient, lsCachedClient client.Client, log logging.Logger, detector DriftDetector,
+	deployerType lsv1alpha1.DeployItemType, targetSelectors []lsv1alpha1.TargetSelector) *DriftDetectionJob {
+	return &DriftDetectionJob{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              log.WithName("driftDetection"),
+		detector:         detector,
+		deployerType:     deployerType,
+		targetSelectors:  targetSelectors,
+		period:           DefaultDriftDetectionPeriod,
+		lastChecks:       map[types.UID]time.Time{},
+	}
+}
+
+func (j *DriftDetectionJob) StartDeployerJob(ctx context.Context) error {
+	j.log.Info("DriftDetection: starting drift detection")
+
+	wait.UntilWithContext(ctx, j.DetectDrift, j.period)
+	return nil
+}
+
+// DetectDrift checks all deploy items of the deployer type for which a drift detection is due.
+// The deploy items are preselected by their cached metadata, so that only the candidates are read from the cluster.
+func (j *DriftDetectionJob) DetectDrift(ctx context.Context) {
+	ctx = logging.NewContext(ctx, j.log)
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	seen := map[types.UID]bool{}
+	// deploy items without deployer type annotation are checked by the spec type, like in the type predicate of the controller
+	for _, deployerType := range []string{string(j.deployerType), ""} {
+		metadataList := lsutil.EmptyDeployItemMetadataList()
+		if err := read_write_layer.ListMetaData(ctx, j.lsCachedClient, metadataList, read_write_layer.R000115,
+			client.MatchingFields{deployerTypeIndexKey: deployerType}); err != nil {
+			logger.Error(err, "unable to list deploy items")
+			return
+		}
+
+		for i := range metadataList.Items {
+			metadata := &metadataList.Items[i]
+			seen[metadata.UID] = true
+			if !metadata.DeletionTimestamp.IsZero() || lsv1alpha1helper.IsSuspended(metadata.ObjectMeta) {
+				continue
+			}
+
+			diLogger, diCtx := logger.WithValuesAndContext(ctx, lc.KeyResource, client.ObjectKeyFromObject(metadata).String())
+			di := &lsv1alpha1.DeployItem{}
+			if err := read_write_layer.GetDeployItem(diCtx, j.lsUncachedClient, client.ObjectKeyFromObject(metadata), di,
+				read_write_layer.R000137); err != nil {
+				if !apierrors.IsNotFound(err) {
+					diLogger.Error(err, "unable to get deploy item")
+				}
+				continue
+			}
+			if di.Spec.Type != j.deployerType {
+				continue
+			}
+
+			if err := j.detectDrift(diCtx, di); err != nil {
+				diLogger.Error(err, "drift detection failed")
+			}
+		}
+	}
+
+	j.forgetRemovedDeployItems(seen)
+}
+
+func (j *DriftDetectionJob) detectDrift(ctx context.Context, di *lsv1alpha1.DeployItem) (err error) {
+	if !di.DeletionTimestamp.IsZero() || lsv1alpha1helper.IsSuspended(di.ObjectMeta) || di.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded ||
+		di.Status.JobID != di.Status.JobIDFinished || lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.TestReconcileOperation) {
+		return nil
+	}
+
+	spec, err := j.detector.DriftDetectionSpec(di)
+	if err != nil {
+		return err
+	}
+	if spec == nil || spec.Every == nil || !j.isDue(di, spec.Every.Duration, time.Now()) {
+		return nil
+	}
+
+	metadata := &metav1.PartialObjectMetadata{ObjectMeta: di.ObjectMeta}
+	rt, responsible, targetNotFound, lsErr := CheckResponsibility(ctx, j.lsUncachedClient, metadata, j.deployerType, j.targetSelectors)
+	if lsErr != nil {
+		return lsErr
+	}
+	if !responsible || targetNotFound {
+		return nil
+	}
+
+	lsCtx := &lsv1alpha1.Context{}
+	contextName := di.Spec.Context
+	if len(contextName) == 0 {
+		contextName = lsv1alpha1.DefaultContextName
+	}
+	if err := read_write_layer.GetContext(ctx, j.lsUncachedClient, kutil.ObjectKey(contextName, di.Namespace), lsCtx,
+		read_write_layer.R000116); err != nil {
+		return err
+	}
+
+	j.setLastCheck(di.UID, time.Now())
+
+	// Create OCM context
+	octx := ocm.New(datacontext.MODE_EXTENDED)
+	defer func() {
+		err = goerrors.Join(err, octx.Finalize())
+	}()
+	ctx = octx.BindTo(ctx)
+
+	drifts, err := j.detector.DetectDrift(ctx, lsCtx, di, rt)
+	if err != nil {
+		di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DriftedCondition,
+			lsv1alpha1.ConditionUnknown, DriftDetectionFailedReason, err.Error())
+		if updateErr := j.writer().UpdateDeployItemStatus(ctx, read_write_layer.W000165, di); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	return j.reportDrift(ctx, di, spec, drifts)
+}
+
+// reportDrift updates the Drifted condition and label of the deploy item and triggers the auto-correction.
+func (j *DriftDetectionJob) reportDrift(ctx context.Context, di *lsv1alpha1.DeployItem, spec *dd.DriftDetectionSpec,
+	drifts []driftdetection.ResourceDrift) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	drifted := len(drifts) != 0
+
+	status := lsv1alpha1.ConditionFalse
+	reason := NoDriftReason
+	if drifted {
+		status = lsv1alpha1.ConditionTrue
+		reason = DriftDetectedReason
+		logger.Info("drift detected", "drift", driftdetection.Message(drifts))
+	}
+
+	oldCondition := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DriftedCondition)
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DriftedCondition,
+		status, reason, driftdetection.Message(drifts))
+	if !reflect.DeepEqual(oldCondition, lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DriftedCondition)) {
+		if err := j.writer().UpdateDeployItemStatus(ctx, read_write_layer.W000165, di); err != nil {
+			return err
+		}
+	}
+
+	if lsv1alpha1helper.IsDrifted(di.ObjectMeta) != drifted {
+		if drifted {
+			metav1.SetMetaDataLabel(&di.ObjectMeta, lsv1alpha1.DriftedLabel, "true")
+		} else {
+			delete(di.Labels, lsv1alpha1.DriftedLabel)
+		}
+		if err := j.writer().UpdateDeployItem(ctx, read_write_layer.W000166, di); err != nil {
+			return err
+		}
+	}
+
+	if drifted && spec.AutoCorrect {
+		// a new job id triggers a reconcile of the deploy item, like a new job of the installation
+		logger.Info("reconciling deploy item to correct the drift")
+		di.Status.SetJobID(uuid.New().String())
+		di.Status.TransitionTimes = lsutil.NewTransitionTimes()
+		return j.writer().UpdateDeployItemStatus(ctx, read_write_layer.W000177, di)
+	}
+	return nil
+}
+
+// isDue returns whether the drift detection of the deploy item is due.
+// A drift detection is due if the interval has passed since the last check, or if the deploy item has been
+// reconciled since then.
+func (j *DriftDetectionJob) isDue(di *lsv1alpha1.DeployItem, every time.Duration, now time.Time) bool {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	lastCheck, ok := j.lastChecks[di.UID]
+	if !ok {
+		return true
+	}
+	if di.Status.LastReconcileTime != nil && lastCheck.Before(di.Status.LastReconcileTime.Time) {
+		return true
+	}
+	return !now.Before(lastCheck.Add(every))
+}
+
+func (j *DriftDetectionJob) setLastCheck(uid types.UID, t time.Time) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	j.lastChecks[uid] = t
+}
+
+func (j *DriftDetectionJob) forgetRemovedDeployItems(existing map[types.UID]bool) {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+	for uid := range j.lastChecks {
+		if !existing[uid] {
+			delete(j.lastChecks, uid)
+		}
+	}
+}
+
+func (j *DriftDetectionJob) writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(j.lsUncachedClient)
+}
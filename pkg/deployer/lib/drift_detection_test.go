@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+)
+
+var _ = Describe("Drift Detection Job", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		job        *DriftDetectionJob
+		di         *lsv1alpha1.DeployItem
+		drifts     = []driftdetection.ResourceDrift{{
+			Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "test", Namespace: "default"},
+			Missing:  true,
+		}}
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		di = &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       lsv1alpha1.DeployItemSpec{Type: "test"},
+		}
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		di.Status.SetJobID("job1")
+		di.Status.JobIDFinished = "job1"
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).WithObjects(di).Build()
+		job = NewDriftDetectionJob(kubeClient, kubeClient, logging.Discard(), nil, "test", nil)
+	})
+
+	getDeployItem := func() *lsv1alpha1.DeployItem {
+		res := &lsv1alpha1.DeployItem{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(di), res)).To(Succeed())
+		return res
+	}
+
+	It("should only report a drift if auto-correction is disabled", func() {
+		Expect(job.reportDrift(ctx, di, &dd.DriftDetectionSpec{}, drifts)).To(Succeed())
+
+		res := getDeployItem()
+		Expect(lsv1alpha1helper.IsDrifted(res.ObjectMeta)).To(BeTrue())
+		Expect(lsv1alpha1helper.GetCondition(res.Status.Conditions, lsv1alpha1.DriftedCondition).Status).
+			To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(res.Status.GetJobID()).To(Equal("job1"))
+	})
+
+	It("should trigger a reconcile with a new job id to correct a drift", func() {
+		Expect(job.reportDrift(ctx, di, &dd.DriftDetectionSpec{AutoCorrect: true}, drifts)).To(Succeed())
+
+		res := getDeployItem()
+		Expect(lsv1alpha1helper.IsDrifted(res.ObjectMeta)).To(BeTrue())
+		Expect(lsv1alpha1helper.HasOperation(res.ObjectMeta, lsv1alpha1.TestReconcileOperation)).To(BeFalse())
+		Expect(res.Status.GetJobID()).ToNot(Equal("job1"))
+		Expect(res.Status.JobIDFinished).To(Equal("job1"))
+	})
+
+	It("should remove the drifted label if the drift has been corrected", func() {
+		Expect(job.reportDrift(ctx, di, &dd.DriftDetectionSpec{AutoCorrect: true}, drifts)).To(Succeed())
+		di = getDeployItem()
+		di.Status.JobIDFinished = di.Status.GetJobID()
+		Expect(kubeClient.Status().Update(ctx, di)).To(Succeed())
+
+		Expect(job.reportDrift(ctx, di, &dd.DriftDetectionSpec{AutoCorrect: true}, nil)).To(Succeed())
+
+		res := getDeployItem()
+		Expect(lsv1alpha1helper.IsDrifted(res.ObjectMeta)).To(BeFalse())
+		Expect(res.Status.GetJobID()).To(Equal(res.Status.JobIDFinished))
+	})
+})
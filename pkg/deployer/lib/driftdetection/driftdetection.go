@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// maxFieldsPerResource is the maximal number of drifted fields that are reported for a resource.
+const maxFieldsPerResource = 5
+
+// ResourceDrift describes the difference between a deployed resource and its desired state.
+type ResourceDrift struct {
+	// Resource is the drifted resource.
+	Resource corev1.ObjectReference
+	// Missing is true if the resource does not exist in the target cluster.
+	Missing bool
+	// Fields are the paths of the fields whose values differ from the desired state.
+	Fields []string
+}
+
+// String returns a short summary of the drift of the resource.
+func (d ResourceDrift) String() string {
+	name := d.Resource.Name
+	if len(d.Resource.Namespace) != 0 {
+		name = d.Resource.Namespace + "/" + name
+	}
+
+	if d.Missing {
+		return fmt.Sprintf("%s %s: missing", d.Resource.Kind, name)
+	}
+
+	fields := d.Fields
+	if len(fields) > maxFieldsPerResource {
+		fields = append(fields[:maxFieldsPerResource:maxFieldsPerResource], fmt.Sprintf("and %d more", len(d.Fields)-maxFieldsPerResource))
+	}
+	return fmt.Sprintf("%s %s: %s", d.Resource.Kind, name, strings.Join(fields, ", "))
+}
+
+// Message returns a summary of all drifted resources that can be used as condition message.
+func Message(drifts []ResourceDrift) string {
+	if len(drifts) == 0 {
+		return "the deployed resources match their desired state"
+	}
+
+	summaries := make([]string, len(drifts))
+	for i := range drifts {
+		summaries[i] = drifts[i].String()
+	}
+	return fmt.Sprintf("%d resource(s) drifted: %s", len(drifts), strings.Join(summaries, "; "))
+}
+
+// DecodeManifests decodes the given manifests into unstructured objects.
+func DecodeManifests(manifests []managedresource.Manifest) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0, len(manifests))
+	for i, manifest := range manifests {
+		if manifest.Manifest == nil || len(manifest.Manifest.Raw) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(manifest.Manifest.Raw, &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode manifest %d: %w", i, err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// Detect compares the managed resources in the target cluster with the desired objects and returns the drifted
+// resources. Managed resources without desired object, and resources with policy "ignore" or "immutable" are skipped.
+// A desired object without namespace matches a managed resource with the same kind and name in any namespace,
+// because the namespace of such objects is defaulted when they are applied.
+func Detect(ctx context.Context, kubeClient client.Client, managedResources managedresource.ManagedResourceStatusList,
+	desired []*unstructured.Unstructured) ([]ResourceDrift, error) {
+
+	drifts := []ResourceDrift{}
+	for _, mr := range managedResources {
+		if mr.Policy == managedresource.IgnorePolicy || mr.Policy == managedresource.ImmutablePolicy {
+			continue
+		}
+
+		desiredObj := findDesiredObject(mr.Resource, desired)
+		if desiredObj == nil {
+			continue
+		}
+
+		live := &unstructured.Unstructured{}
+		live.SetAPIVersion(mr.Resource.APIVersion)
+		live.SetKind(mr.Resource.Kind)
+		key := client.ObjectKey{Namespace: mr.Resource.Namespace, Name: mr.Resource.Name}
+		if err := read_write_layer.GetUnstructured(ctx, kubeClient, key, live, read_write_layer.R000114); err != nil {
+			if apierrors.IsNotFound(err) {
+				drifts = append(drifts, ResourceDrift{Resource: mr.Resource, Missing: true})
+				continue
+			}
+			return nil, fmt.Errorf("unable to get resource %s %s: %w", mr.Resource.Kind, key.String(), err)
+		}
+
+		if fields := Compare(desiredObj, live); len(fields) != 0 {
+			drifts = append(drifts, ResourceDrift{Resource: mr.Resource, Fields: fields})
+		}
+	}
+	return drifts, nil
+}
+
+func findDesiredObject(ref corev1.ObjectReference, desired []*unstructured.Unstructured) *unstructured.Unstructured {
+	for _, obj := range desired {
+		if obj.GetAPIVersion() != ref.APIVersion || obj.GetKind() != ref.Kind || obj.GetName() != ref.Name {
+			continue
+		}
+		if len(obj.GetNamespace()) == 0 || obj.GetNamespace() == ref.Namespace {
+			return obj
+		}
+	}
+	return nil
+}
+
+// Compare returns the sorted paths of all fields of the desired object that have a different value in the live object.
+// Only fields that are set in the desired object are compared, so that fields defaulted by the api server do not
+// cause a drift. The status, the write-only field "stringData" of secrets, and all metadata except for labels and
+// annotations are ignored.
+func Compare(desired, live *unstructured.Unstructured) []string {
+	fields := []string{}
+	for key, desiredValue := range desired.Object {
+		switch key {
+		case "apiVersion", "kind", "status", "stringData":
+			continue
+		case "metadata":
+			desiredMeta, _ := desiredValue.(map[string]interface{})
+			liveMeta, _ := live.Object["metadata"].(map[string]interface{})
+			for _, metaKey := range []string{"labels", "annotations"} {
+				if value, ok := desiredMeta[metaKey]; ok {
+					fields = compareValues("metadata."+metaKey, value, liveMeta[metaKey], fields)
+				}
+			}
+		default:
+			fields = compareValues(key, desiredValue, live.Object[key], fields)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func compareValues(path string, desired, live interface{}, fields []string) []string {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return fields
+			}
+			return append(fields, path)
+		}
+		for key, value := range desiredValue {
+			fields = compareValues(path+"."+key, value, liveValue[key], fields)
+		}
+		return fields
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return fields
+			}
+			return append(fields, path)
+		}
+		if len(desiredValue) != len(liveValue) {
+			return append(fields, path)
+		}
+		for i := range desiredValue {
+			fields = compareValues(fmt.Sprintf("%s[%d]", path, i), desiredValue[i], liveValue[i], fields)
+		}
+		return fields
+	case nil:
+		return fields
+	default:
+		if !equalScalars(desiredValue, live) {
+			return append(fields, path)
+		}
+		return fields
+	}
+}
+
+// equalScalars compares two scalar values. Numbers are compared by value, because decoded manifests contain
+// floats whereas objects read from the api server contain integers.
+func equalScalars(a, b interface{}) bool {
+	aNumber, aIsNumber := toFloat(a)
+	bNumber, bIsNumber := toFloat(b)
+	if aIsNumber && bIsNumber {
+		return aNumber == bNumber
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+)
+
+func parse(manifest string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	ExpectWithOffset(1, yaml.Unmarshal([]byte(manifest), &obj.Object)).To(Succeed())
+	return obj
+}
+
+const desiredDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  labels:
+    app: test
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0.0
+`
+
+var _ = Describe("Drift Detection", func() {
+
+	Context("Compare", func() {
+
+		It("should not report fields that are only set in the live object", func() {
+			live := parse(desiredDeployment)
+			live.SetNamespace("default")
+			live.SetResourceVersion("42")
+			live.SetLabels(map[string]string{"app": "test", "other": "label"})
+			Expect(unstructured.SetNestedField(live.Object, int64(2), "spec", "replicas")).To(Succeed())
+			Expect(unstructured.SetNestedField(live.Object, "RollingUpdate", "spec", "strategy", "type")).To(Succeed())
+			Expect(unstructured.SetNestedField(live.Object, int64(2), "status", "readyReplicas")).To(Succeed())
+
+			Expect(driftdetection.Compare(parse(desiredDeployment), live)).To(BeEmpty())
+		})
+
+		It("should report changed fields", func() {
+			live := parse(desiredDeployment)
+			Expect(unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas")).To(Succeed())
+			Expect(unstructured.SetNestedStringMap(live.Object, map[string]string{"app": "changed"}, "metadata", "labels")).To(Succeed())
+			containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+			containers[0].(map[string]interface{})["image"] = "app:2.0.0"
+			Expect(unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")).To(Succeed())
+
+			Expect(driftdetection.Compare(parse(desiredDeployment), live)).To(Equal([]string{
+				"metadata.labels.app",
+				"spec.replicas",
+				"spec.template.spec.containers[0].image",
+			}))
+		})
+
+		It("should report lists with a different length", func() {
+			live := parse(desiredDeployment)
+			containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+			containers = append(containers, map[string]interface{}{"name": "sidecar"})
+			Expect(unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers")).To(Succeed())
+
+			Expect(driftdetection.Compare(parse(desiredDeployment), live)).To(Equal([]string{"spec.template.spec.containers"}))
+		})
+
+		It("should ignore the string data of secrets", func() {
+			desired := parse(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+stringData:
+  key: value
+`)
+			live := parse(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+data:
+  key: dmFsdWU=
+`)
+			Expect(driftdetection.Compare(desired, live)).To(BeEmpty())
+		})
+	})
+
+	Context("Detect", func() {
+
+		var managedResources managedresource.ManagedResourceStatusList
+
+		BeforeEach(func() {
+			managedResources = managedresource.ManagedResourceStatusList{
+				{
+					Policy:   managedresource.ManagePolicy,
+					Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "changed"},
+				},
+				{
+					Policy:   managedresource.ManagePolicy,
+					Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "missing"},
+				},
+				{
+					Policy:   managedresource.IgnorePolicy,
+					Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "ignored"},
+				},
+				{
+					Policy:   managedresource.ManagePolicy,
+					Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "unchanged"},
+				},
+			}
+		})
+
+		It("should report changed and missing resources", func() {
+			kubeClient := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(
+				parse(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "changed", "namespace": "default"}, "data": {"key": "other"}}`),
+				parse(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "ignored", "namespace": "default"}, "data": {"key": "other"}}`),
+				parse(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "unchanged", "namespace": "default"}, "data": {"key": "value"}}`),
+			).Build()
+
+			desired, err := driftdetection.DecodeManifests([]managedresource.Manifest{
+				{Manifest: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "changed"}, "data": {"key": "value"}}`)}},
+				{Manifest: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "missing", "namespace": "default"}}`)}},
+				{Manifest: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "ignored", "namespace": "default"}, "data": {"key": "value"}}`)}},
+				{Manifest: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "unchanged", "namespace": "default"}, "data": {"key": "value"}}`)}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			drifts, err := driftdetection.Detect(context.Background(), kubeClient, managedResources, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drifts).To(Equal([]driftdetection.ResourceDrift{
+				{Resource: managedResources[0].Resource, Fields: []string{"data.key"}},
+				{Resource: managedResources[1].Resource, Missing: true},
+			}))
+			Expect(driftdetection.Message(drifts)).To(Equal(
+				"2 resource(s) drifted: ConfigMap default/changed: data.key; ConfigMap default/missing: missing"))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	lskustomize "github.com/gardener/landscaper/apis/deployer/utils/kustomize"
+)
+
+// NewKustomization returns a kustomization of the given resources, to which the given patches are applied.
+func NewKustomization(resources []string, patches []lskustomize.Patch) *types.Kustomization {
+	k := &types.Kustomization{
+		TypeMeta: types.TypeMeta{
+			APIVersion: types.KustomizationVersion,
+			Kind:       types.KustomizationKind,
+		},
+		Resources: resources,
+	}
+
+	for _, patch := range patches {
+		p := types.Patch{Patch: patch.Patch}
+		if patch.Target != nil {
+			p.Target = &types.Selector{
+				ResId: resid.ResId{
+					Gvk: resid.Gvk{
+						Group:   patch.Target.Group,
+						Version: patch.Target.Version,
+						Kind:    patch.Target.Kind,
+					},
+					Name:      patch.Target.Name,
+					Namespace: patch.Target.Namespace,
+				},
+				LabelSelector:      patch.Target.LabelSelector,
+				AnnotationSelector: patch.Target.AnnotationSelector,
+			}
+		}
+		k.Patches = append(k.Patches, p)
+	}
+	return k
+}
+
+// Build writes the kustomization into the root directory of the filesystem and builds it.
+// The resources of the kustomization have to be contained in the filesystem.
+func Build(fs filesys.FileSystem, kustomization *types.Kustomization) (resmap.ResMap, error) {
+	raw, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal kustomization: %w", err)
+	}
+	if err := fs.WriteFile(filepath.Join("/", "kustomization.yaml"), raw); err != nil {
+		return nil, fmt.Errorf("unable to write kustomization: %w", err)
+	}
+	return krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, "/")
+}
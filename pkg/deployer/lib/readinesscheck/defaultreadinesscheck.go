@@ -11,7 +11,6 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +29,10 @@ type DefaultReadinessCheck struct {
 	ManagedResources    []lsv1alpha1.TypedObjectReference
 	FailOnMissingObject bool
 	InterruptionChecker interruption.InterruptionChecker
+	// Generic enables the generic readiness check.
+	// Objects are checked with the checkers of the GenericRegistry instead of the DefaultRegistry,
+	// and objects of kinds without a registered checker are checked with CheckGenericObject.
+	Generic bool
 }
 
 // CheckResourcesReady implements the default readiness check for Kubernetes manifests
@@ -80,69 +83,44 @@ func (d *DefaultReadinessCheck) filterObjects(objects []*unstructured.Unstructur
 	return filteredObjects
 }
 
-// DefaultCheckObject checks if the object is ready and returns an error otherwise.
-// A non-managed object returns nil.
+// CheckObject checks if the object is ready and returns an error otherwise.
+// An object without a registered checker returns nil, unless the generic readiness check is enabled.
 func (d *DefaultReadinessCheck) CheckObject(u *unstructured.Unstructured) error {
-	var checkErr error
 	gk := u.GroupVersionKind().GroupKind()
-	switch gk.String() {
-	case "Pod":
-		pod := &corev1.Pod{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, pod); err != nil {
-			return err
-		}
-		checkErr = CheckPod(pod)
-	case "Deployment.apps":
-		dp := &appsv1.Deployment{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, dp); err != nil {
-			return err
-		}
-		checkErr = CheckDeployment(dp)
-	case "ReplicaSet.apps":
-		rs := &appsv1.ReplicaSet{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, rs); err != nil {
-			return err
-		}
-		checkErr = CheckReplicaSet(rs)
-	case "StatefulSet.apps":
-		sts := &appsv1.StatefulSet{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, sts); err != nil {
-			return err
-		}
-		checkErr = CheckStatefulSet(sts)
-	case "DaemonSet.apps":
-		ds := &appsv1.DaemonSet{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, ds); err != nil {
-			return err
-		}
-		checkErr = CheckDaemonSet(ds)
-	case "ReplicationController":
-		rc := &corev1.ReplicationController{}
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, rc); err != nil {
-			return err
+	checker, ok := d.registry().Get(gk)
+	if !ok {
+		if !d.Generic {
+			return nil
 		}
-		checkErr = CheckReplicationController(rc)
-	default:
-		return nil
+		checker = CheckGenericObject
 	}
 
-	if checkErr != nil {
-		return NewObjectNotReadyError(u, checkErr)
+	if err := checker(u); err != nil {
+		return NewObjectNotReadyError(u, err)
 	}
 	return nil
 }
 
+func (d *DefaultReadinessCheck) registry() *Registry {
+	if d.Generic {
+		return GenericRegistry
+	}
+	return DefaultRegistry
+}
+
 func (d *DefaultReadinessCheck) isCheckRelevant(u *unstructured.Unstructured) bool {
-	return IsRelevantForDefaultReadinessCheck(u.GroupVersionKind().GroupKind())
+	return IsRelevantForReadinessCheck(u.GroupVersionKind().GroupKind(), d.Generic)
 }
 
+// IsRelevantForDefaultReadinessCheck checks whether objects of the given kind are checked by the default readiness check.
 func IsRelevantForDefaultReadinessCheck(groupKind schema.GroupKind) bool {
-	switch groupKind.String() {
-	case "Pod", "Deployment.apps", "ReplicaSet.apps", "StatefulSet.apps", "DaemonSet.apps", "ReplicationController":
-		return true
-	default:
-		return false
-	}
+	return DefaultRegistry.Has(groupKind)
+}
+
+// IsRelevantForReadinessCheck checks whether objects of the given kind are checked by the default readiness check.
+// If the generic readiness check is enabled, objects of all kinds are checked.
+func IsRelevantForReadinessCheck(groupKind schema.GroupKind, generic bool) bool {
+	return generic || IsRelevantForDefaultReadinessCheck(groupKind)
 }
 
 func outdatedGeneration(current, expected int64) error {
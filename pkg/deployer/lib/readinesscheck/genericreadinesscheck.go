@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck
+
+import (
+	"errors"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	conditionTypeReady       = "Ready"
+	conditionTypeAvailable   = "Available"
+	conditionTypeReconciling = "Reconciling"
+	conditionTypeStalled     = "Stalled"
+)
+
+// objectCondition is the common structure of a condition in the status of an object.
+type objectCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// getObjectConditions returns the conditions of the status of an unstructured object.
+// Entries that do not have the structure of a condition are ignored.
+func getObjectConditions(u *unstructured.Unstructured) []objectCondition {
+	rawConditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	conditions := make([]objectCondition, 0, len(rawConditions))
+	for _, raw := range rawConditions {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condition := objectCondition{}
+		condition.Type, _ = m["type"].(string)
+		condition.Status, _ = m["status"].(string)
+		condition.Reason, _ = m["reason"].(string)
+		condition.Message, _ = m["message"].(string)
+		if len(condition.Type) != 0 {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+func getObjectCondition(conditions []objectCondition, conditionType string) *objectCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// CheckGenericObject checks whether an object of an arbitrary kind is ready.
+// The check follows the conventions of Kubernetes resources for status reporting:
+// An object is not ready if its controller has not yet observed its current generation, if it has a "Stalled"
+// or "Reconciling" condition with status "True", or if its "Ready" condition or, if there is none, its "Available"
+// condition is not "True". Objects without such status fields are considered ready.
+func CheckGenericObject(u *unstructured.Unstructured) error {
+	observedGeneration, found, err := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	if err == nil && found && observedGeneration < u.GetGeneration() {
+		return outdatedGeneration(observedGeneration, u.GetGeneration())
+	}
+
+	conditions := getObjectConditions(u)
+
+	for _, conditionType := range []string{conditionTypeStalled, conditionTypeReconciling} {
+		if condition := getObjectCondition(conditions, conditionType); condition != nil {
+			if err := checkConditionState(conditionType, string(corev1.ConditionFalse), condition.Status, condition.Reason, condition.Message); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, conditionType := range []string{conditionTypeReady, conditionTypeAvailable} {
+		if condition := getObjectCondition(conditions, conditionType); condition != nil {
+			return checkConditionState(conditionType, string(corev1.ConditionTrue), condition.Status, condition.Reason, condition.Message)
+		}
+	}
+
+	return nil
+}
+
+// CheckJob checks whether the given Job is ready.
+// A Job is considered ready if it has completed successfully.
+func CheckJob(job *batchv1.Job) error {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return fmt.Errorf("job failed due to %s: %s", condition.Reason, condition.Message)
+		case batchv1.JobComplete:
+			return nil
+		}
+	}
+	return errors.New("job has not completed")
+}
+
+// CheckPersistentVolumeClaim checks whether the given PersistentVolumeClaim is ready.
+// A PersistentVolumeClaim is considered ready if it is bound to a volume.
+func CheckPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) error {
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return fmt.Errorf("persistent volume claim is not bound (phase %q)", pvc.Status.Phase)
+	}
+	return nil
+}
+
+// CheckService checks whether the given Service is ready.
+// A Service of type LoadBalancer is considered ready if its load balancer has an ingress point.
+// Services of other types are always ready.
+func CheckService(svc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	if len(svc.Status.LoadBalancer.Ingress) == 0 {
+		return errors.New("load balancer has no ingress point")
+	}
+	return nil
+}
+
+// CheckCustomResourceDefinition checks whether the given CustomResourceDefinition is ready.
+// A CustomResourceDefinition is considered ready if it is established, i.e. if it is served by the api server.
+func CheckCustomResourceDefinition(crd *extv1.CustomResourceDefinition) error {
+	var established *extv1.CustomResourceDefinitionCondition
+	for i := range crd.Status.Conditions {
+		condition := &crd.Status.Conditions[i]
+		switch condition.Type {
+		case extv1.NamesAccepted:
+			if condition.Status == extv1.ConditionFalse {
+				return conditionInvalidStatus(string(condition.Type), string(extv1.ConditionTrue), string(condition.Status), condition.Reason, condition.Message)
+			}
+		case extv1.Established:
+			established = condition
+		}
+	}
+
+	if established == nil {
+		return requiredConditionMissing(string(extv1.Established))
+	}
+	return checkConditionState(string(established.Type), string(extv1.ConditionTrue), string(established.Status), established.Reason, established.Message)
+}
+
+// CheckAPIService checks whether the given APIService is ready.
+// An APIService is considered ready if it has the Available condition set to true.
+func CheckAPIService(u *unstructured.Unstructured) error {
+	condition := getObjectCondition(getObjectConditions(u), conditionTypeAvailable)
+	if condition == nil {
+		return requiredConditionMissing(conditionTypeAvailable)
+	}
+	return checkConditionState(conditionTypeAvailable, string(corev1.ConditionTrue), condition.Status, condition.Reason, condition.Message)
+}
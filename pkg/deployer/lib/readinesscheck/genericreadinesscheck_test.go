@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
+)
+
+func customResource(generation int64, status map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetAPIVersion("example.landscaper.gardener.cloud/v1")
+	u.SetKind("MyResource")
+	u.SetName("my-resource")
+	u.SetGeneration(generation)
+	if status != nil {
+		u.Object["status"] = status
+	}
+	return u
+}
+
+func condition(conditionType, status string) interface{} {
+	return map[string]interface{}{
+		"type":   conditionType,
+		"status": status,
+	}
+}
+
+var _ = Describe("Generic readiness checks", func() {
+	Describe("CheckGenericObject", func() {
+		DescribeTable("custom resource",
+			func(u *unstructured.Unstructured, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckGenericObject(u)
+				Expect(err).To(matcher)
+			},
+			Entry("without status", customResource(1, nil), BeNil()),
+			Entry("with current observed generation", customResource(2, map[string]interface{}{
+				"observedGeneration": int64(2),
+			}), BeNil()),
+			Entry("with outdated observed generation", customResource(2, map[string]interface{}{
+				"observedGeneration": int64(1),
+			}), HaveOccurred()),
+			Entry("with ready condition", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "True"), condition("Available", "False")},
+			}), BeNil()),
+			Entry("with unready condition", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "False")},
+			}), HaveOccurred()),
+			Entry("with available condition", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Available", "True")},
+			}), BeNil()),
+			Entry("with unavailable condition", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Available", "Unknown")},
+			}), HaveOccurred()),
+			Entry("while reconciling", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Ready", "True"), condition("Reconciling", "True")},
+			}), HaveOccurred()),
+			Entry("when stalled", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Stalled", "True")},
+			}), HaveOccurred()),
+			Entry("with unrelated conditions", customResource(1, map[string]interface{}{
+				"conditions": []interface{}{condition("Synced", "False")},
+			}), BeNil()),
+		)
+	})
+
+	Describe("CheckJob", func() {
+		DescribeTable("job",
+			func(job *batchv1.Job, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckJob(job)
+				Expect(err).To(matcher)
+			},
+			Entry("completed", &batchv1.Job{
+				Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+				}},
+			}, BeNil()),
+			Entry("failed", &batchv1.Job{
+				Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+				}},
+			}, HaveOccurred()),
+			Entry("running", &batchv1.Job{}, HaveOccurred()),
+		)
+	})
+
+	Describe("CheckPersistentVolumeClaim", func() {
+		DescribeTable("persistent volume claim",
+			func(pvc *corev1.PersistentVolumeClaim, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckPersistentVolumeClaim(pvc)
+				Expect(err).To(matcher)
+			},
+			Entry("bound", &corev1.PersistentVolumeClaim{
+				Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+			}, BeNil()),
+			Entry("pending", &corev1.PersistentVolumeClaim{
+				Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+			}, HaveOccurred()),
+		)
+	})
+
+	Describe("CheckService", func() {
+		DescribeTable("service",
+			func(svc *corev1.Service, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckService(svc)
+				Expect(err).To(matcher)
+			},
+			Entry("of type ClusterIP", &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+			}, BeNil()),
+			Entry("of type LoadBalancer with ingress", &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+				}},
+			}, BeNil()),
+			Entry("of type LoadBalancer without ingress", &corev1.Service{
+				Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			}, HaveOccurred()),
+		)
+	})
+
+	Describe("CheckCustomResourceDefinition", func() {
+		DescribeTable("custom resource definition",
+			func(crd *extv1.CustomResourceDefinition, matcher types.GomegaMatcher) {
+				err := readinesscheck.CheckCustomResourceDefinition(crd)
+				Expect(err).To(matcher)
+			},
+			Entry("established", &extv1.CustomResourceDefinition{
+				Status: extv1.CustomResourceDefinitionStatus{Conditions: []extv1.CustomResourceDefinitionCondition{
+					{Type: extv1.NamesAccepted, Status: extv1.ConditionTrue},
+					{Type: extv1.Established, Status: extv1.ConditionTrue},
+				}},
+			}, BeNil()),
+			Entry("not established", &extv1.CustomResourceDefinition{
+				Status: extv1.CustomResourceDefinitionStatus{Conditions: []extv1.CustomResourceDefinitionCondition{
+					{Type: extv1.Established, Status: extv1.ConditionFalse},
+				}},
+			}, HaveOccurred()),
+			Entry("names not accepted", &extv1.CustomResourceDefinition{
+				Status: extv1.CustomResourceDefinitionStatus{Conditions: []extv1.CustomResourceDefinitionCondition{
+					{Type: extv1.Established, Status: extv1.ConditionTrue},
+					{Type: extv1.NamesAccepted, Status: extv1.ConditionFalse},
+				}},
+			}, HaveOccurred()),
+			Entry("missing conditions", &extv1.CustomResourceDefinition{}, HaveOccurred()),
+		)
+	})
+
+	Describe("DefaultReadinessCheck", func() {
+		It("should only check the kinds of the default registry if the generic readiness check is disabled", func() {
+			check := &readinesscheck.DefaultReadinessCheck{}
+			Expect(check.CheckObject(customResource(2, map[string]interface{}{"observedGeneration": int64(1)}))).To(Succeed())
+		})
+
+		It("should check objects of all kinds if the generic readiness check is enabled", func() {
+			check := &readinesscheck.DefaultReadinessCheck{Generic: true}
+			err := check.CheckObject(customResource(2, map[string]interface{}{"observedGeneration": int64(1)}))
+			Expect(readinesscheck.IsRecoverableError(err)).To(BeTrue())
+		})
+
+		It("should use the checkers of the registry", func() {
+			// use a separate registry, so that the checker is not registered for the other tests
+			genericRegistry := readinesscheck.GenericRegistry
+			readinesscheck.GenericRegistry = readinesscheck.NewRegistry()
+			DeferCleanup(func() {
+				readinesscheck.GenericRegistry = genericRegistry
+			})
+
+			u := customResource(1, nil)
+			u.SetKind("MyCheckedResource")
+			readinesscheck.GenericRegistry.Register(u.GroupVersionKind().GroupKind(), func(_ *unstructured.Unstructured) error {
+				return errors.New("not ready")
+			})
+
+			check := &readinesscheck.DefaultReadinessCheck{Generic: true}
+			Expect(check.CheckObject(u)).To(HaveOccurred())
+		})
+	})
+})
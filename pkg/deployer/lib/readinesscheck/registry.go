@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck
+
+import (
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ObjectCheckerFunc checks whether an object is ready.
+// It returns an error describing why the object is not ready, or nil if the object is ready.
+type ObjectCheckerFunc func(u *unstructured.Unstructured) error
+
+// Registry contains the readiness checkers for kinds of objects.
+type Registry struct {
+	mux      sync.RWMutex
+	checkers map[schema.GroupKind]ObjectCheckerFunc
+}
+
+// NewRegistry creates a new empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		checkers: map[schema.GroupKind]ObjectCheckerFunc{},
+	}
+}
+
+// Register adds a checker for the given kind. An already registered checker of the kind is replaced.
+func (r *Registry) Register(gk schema.GroupKind, checker ObjectCheckerFunc) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.checkers[gk] = checker
+}
+
+// Get returns the checker for the given kind.
+func (r *Registry) Get(gk schema.GroupKind) (ObjectCheckerFunc, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	checker, ok := r.checkers[gk]
+	return checker, ok
+}
+
+// Has checks whether a checker is registered for the given kind.
+func (r *Registry) Has(gk schema.GroupKind) bool {
+	_, ok := r.Get(gk)
+	return ok
+}
+
+var (
+	// DefaultRegistry contains the checkers of the default readiness check.
+	// Objects of other kinds are not checked.
+	DefaultRegistry = newDefaultRegistry()
+
+	// GenericRegistry contains the checkers of the generic readiness check.
+	// Objects of other kinds are checked with CheckGenericObject.
+	GenericRegistry = newGenericRegistry()
+)
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	registerDefaultCheckers(r)
+	return r
+}
+
+func newGenericRegistry() *Registry {
+	r := NewRegistry()
+	registerDefaultCheckers(r)
+	r.Register(schema.GroupKind{Group: batchv1.GroupName, Kind: "Job"}, TypedObjectChecker(CheckJob))
+	r.Register(schema.GroupKind{Group: corev1.GroupName, Kind: "PersistentVolumeClaim"}, TypedObjectChecker(CheckPersistentVolumeClaim))
+	r.Register(schema.GroupKind{Group: corev1.GroupName, Kind: "Service"}, TypedObjectChecker(CheckService))
+	r.Register(schema.GroupKind{Group: extv1.GroupName, Kind: "CustomResourceDefinition"}, TypedObjectChecker(CheckCustomResourceDefinition))
+	r.Register(schema.GroupKind{Group: "apiregistration.k8s.io", Kind: "APIService"}, CheckAPIService)
+	return r
+}
+
+func registerDefaultCheckers(r *Registry) {
+	r.Register(schema.GroupKind{Group: corev1.GroupName, Kind: "Pod"}, TypedObjectChecker(CheckPod))
+	r.Register(schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}, TypedObjectChecker(CheckDeployment))
+	r.Register(schema.GroupKind{Group: appsv1.GroupName, Kind: "ReplicaSet"}, TypedObjectChecker(CheckReplicaSet))
+	r.Register(schema.GroupKind{Group: appsv1.GroupName, Kind: "StatefulSet"}, TypedObjectChecker(CheckStatefulSet))
+	r.Register(schema.GroupKind{Group: appsv1.GroupName, Kind: "DaemonSet"}, TypedObjectChecker(CheckDaemonSet))
+	r.Register(schema.GroupKind{Group: corev1.GroupName, Kind: "ReplicationController"}, TypedObjectChecker(CheckReplicationController))
+}
+
+// TypedObjectChecker converts a checker of a typed object into a checker of unstructured objects.
+func TypedObjectChecker[T any](check func(*T) error) ObjectCheckerFunc {
+	return func(u *unstructured.Unstructured) error {
+		obj := new(T)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+			return err
+		}
+		return check(obj)
+	}
+}
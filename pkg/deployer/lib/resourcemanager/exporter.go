@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/cel-go/cel"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,12 +70,22 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 	var result map[string]interface{}
 
 	for _, export := range exports.Exports {
-		if export.FromResource == nil {
+		if export.FromResource == nil && len(export.Expression) == 0 {
 			// ignore exports without from resource
 			// this currently only used for helm values where no resource is needed.
 			continue
 		}
 
+		var program cel.Program
+		if len(export.Expression) != 0 {
+			var err error
+			program, err = CompileExportExpression(export)
+			if err != nil {
+				msg := fmt.Sprintf("invalid expression for export %q: %s", export.Key, err.Error())
+				return nil, errors.NewWrappedError(err, "Export", "CompileExpression", msg, lsv1alpha1.ErrorConfigurationProblem)
+			}
+		}
+
 		checkpoint := fmt.Sprintf("deployer: during export - key: %s", export.Key)
 		timeout, timeoutErr := timeout.TimeoutExceeded(ctx, e.deployItem, checkpoint)
 		if timeoutErr != nil {
@@ -88,7 +99,7 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 				return false, err
 			}
 
-			value, err := e.doExport(ctx, export)
+			value, err := e.doExport(ctx, export, program)
 			if err != nil {
 				log2.Info("error while creating export", lc.KeyError, err.Error())
 				return false, nil
@@ -110,12 +121,16 @@ func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports)
 	return result, nil
 }
 
-func (e *Exporter) doExport(ctx context.Context, export managedresource.Export) (map[string]interface{}, error) {
+func (e *Exporter) doExport(ctx context.Context, export managedresource.Export, program cel.Program) (map[string]interface{}, error) {
 	targetClient, err := lib.GetTargetClientConsideringSecondaryTarget(ctx, e.kubeClient, e.lsClient, e.deployItem, export.TargetName, e.lsRestConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	if program != nil {
+		return e.exportFromExpression(ctx, targetClient, export, program)
+	}
+
 	// get resource from client
 	obj := kutil.ObjectFromTypedObjectReference(export.FromResource)
 	if err := read_write_layer.GetUnstructured(ctx, targetClient, kutil.ObjectKeyFromObject(obj), obj,
@@ -143,6 +158,21 @@ func (e *Exporter) doExport(ctx context.Context, export managedresource.Export)
 	return newValue, nil
 }
 
+// exportFromExpression computes the value of an export by evaluating its expression with the resources of the export.
+func (e *Exporter) exportFromExpression(ctx context.Context, cl client.Client, export managedresource.Export, program cel.Program) (map[string]interface{}, error) {
+	variables, err := readExportResources(ctx, cl, export.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := EvaluateExportExpression(program, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpath.Construct(export.Key, val)
+}
+
 func (e *Exporter) exportFromReferencedResource(ctx context.Context, cl client.Client, export managedresource.Export, ref interface{}) (interface{}, error) {
 	// check if the ref is of the right type
 	refMap, ok := ref.(map[string]interface{})
@@ -300,4 +300,74 @@ var _ = Describe("Exporter", func() {
 		})
 	})
 
+	Context("expression", func() {
+		It("should export a value that is computed from several resources", func() {
+			ctx := context.Background()
+			for _, app := range []string{"a", "x"} {
+				cm := &corev1.ConfigMap{}
+				cm.Name = "my-data-" + app
+				cm.Namespace = state.Namespace
+				cm.Labels = map[string]string{"app": app}
+				cm.Data = map[string]string{
+					"host": app + ".example.com",
+				}
+				Expect(state.Create(ctx, cm)).To(Succeed())
+			}
+
+			secret := &corev1.Secret{}
+			secret.Name = "my-secret"
+			secret.Namespace = state.Namespace
+			secret.Data = map[string][]byte{
+				"password": []byte("abc"),
+			}
+			Expect(state.Create(ctx, secret)).To(Succeed())
+
+			exports := &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{
+						Key:        "exportkey",
+						Expression: `"%s:%s".format([configMaps.filter(c, c.metadata.labels.app == "x")[0].data.host, string(base64.decode(secret.data.password))])`,
+						Resources: []managedresource.ExportResource{
+							{
+								Variable:   "configMaps",
+								APIVersion: "v1",
+								Kind:       "ConfigMap",
+								Namespace:  state.Namespace,
+							},
+							{
+								Variable:   "secret",
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       secret.Name,
+								Namespace:  secret.Namespace,
+							},
+						},
+					},
+				},
+			}
+			res, err := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+				KubeClient: testenv.Client,
+			}).Export(ctx, exports)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(map[string]interface{}{
+				"exportkey": "x.example.com:abc",
+			}))
+		})
+
+		It("should fail immediately if the expression is invalid", func() {
+			exports := &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{
+						Key:        "exportkey",
+						Expression: "unknown.data",
+					},
+				},
+			}
+			_, err := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+				KubeClient: testenv.Client,
+			}).Export(context.Background(), exports)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 })
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// exportExpressionCostLimit limits the cost of the evaluation of an export expression,
+// so that a faulty expression can not block the deployer.
+const exportExpressionCostLimit = 1000000
+
+// CompileExportExpression compiles the CEL expression of an export.
+// The resources of the export are declared as variables of the expression.
+// Besides the standard CEL functions, the expression can use the string extensions (e.g. format),
+// the encoder extensions (e.g. base64.decode) and the list extensions.
+func CompileExportExpression(export managedresource.Export) (cel.Program, error) {
+	opts := []cel.EnvOption{
+		ext.Strings(),
+		ext.Encoders(),
+		ext.Lists(),
+	}
+	for _, res := range export.Resources {
+		opts = append(opts, cel.Variable(res.Variable, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create expression environment: %w", err)
+	}
+
+	ast, issues := env.Compile(export.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("unable to compile expression: %w", issues.Err())
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(exportExpressionCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create program for expression: %w", err)
+	}
+	return program, nil
+}
+
+// EvaluateExportExpression evaluates a compiled export expression with the given variables.
+// The result is converted into a json compatible value.
+func EvaluateExportExpression(program cel.Program, variables map[string]interface{}) (interface{}, error) {
+	out, _, err := program.Eval(variables)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression: %w", err)
+	}
+
+	val, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert result of type %s: %w", out.Type().TypeName(), err)
+	}
+	return val.(*structpb.Value).AsInterface(), nil
+}
+
+// readExportResources reads the resources of an export from the target cluster and returns them by their variable name.
+// A resource with a name is returned as object, otherwise the list of all matching objects is returned.
+func readExportResources(ctx context.Context, cl client.Client, resources []managedresource.ExportResource) (map[string]interface{}, error) {
+	variables := make(map[string]interface{}, len(resources))
+	for _, res := range resources {
+		if len(res.Name) != 0 {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion(res.APIVersion)
+			obj.SetKind(res.Kind)
+			key := client.ObjectKey{Name: res.Name, Namespace: res.Namespace}
+			if err := read_write_layer.GetUnstructured(ctx, cl, key, obj, read_write_layer.R000127); err != nil {
+				return nil, fmt.Errorf("unable to get %s %s for variable %q: %w", res.Kind, key.String(), res.Variable, err)
+			}
+			variables[res.Variable] = obj.Object
+			continue
+		}
+
+		objList := &unstructured.UnstructuredList{}
+		objList.SetAPIVersion(res.APIVersion)
+		objList.SetKind(res.Kind)
+		if err := read_write_layer.ListUnstructured(ctx, cl, objList, read_write_layer.R000128, &client.ListOptions{
+			Namespace:     res.Namespace,
+			LabelSelector: labels.SelectorFromSet(res.LabelSelector),
+		}); err != nil {
+			return nil, fmt.Errorf("unable to list %s for variable %q: %w", res.Kind, res.Variable, err)
+		}
+		items := make([]interface{}, 0, len(objList.Items))
+		for _, item := range objList.Items {
+			items = append(items, item.Object)
+		}
+		variables[res.Variable] = items
+	}
+	return variables, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"slices"
 
+	apischema "k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -87,3 +89,70 @@ func (m *CustomMatcher) Match(res *managedresource.ManagedResourceStatus) bool {
 func listIsEmptyOrContainsElement(list []string, element string) bool {
 	return len(list) == 0 || slices.Contains(list, element)
 }
+
+func newApplyGroupMatcher(definition *managedresource.ApplyGroupDefinition) (Matcher, error) {
+	if definition.IsPredefined() && definition.IsCustom() {
+		return nil, fmt.Errorf("invalid apply group: predefinedResourceGroup and customResourceGroup must not both be set")
+	}
+	if definition.IsCustom() {
+		return &CustomMatcher{resourceTypes: definition.CustomResourceGroup.Resources}, nil
+	}
+	if !definition.IsPredefined() {
+		return nil, fmt.Errorf("invalid apply group: either predefinedResourceGroup or customResourceGroup must be set")
+	}
+
+	switch definition.PredefinedResourceGroup.Type {
+	case managedresource.PredefinedApplyGroupNamespaces:
+		return newGroupKindMatcher(apischema.GroupKind{Kind: "Namespace"}), nil
+	case managedresource.PredefinedApplyGroupCRDs:
+		return &CRDMatcher{}, nil
+	case managedresource.PredefinedApplyGroupRBAC:
+		return &RBACMatcher{}, nil
+	case managedresource.PredefinedApplyGroupWebhooks:
+		return newGroupKindMatcher(
+			apischema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"},
+			apischema.GroupKind{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"},
+		), nil
+	case managedresource.PredefinedApplyGroupWorkloads:
+		return newGroupKindMatcher(
+			apischema.GroupKind{Group: "apps", Kind: "Deployment"},
+			apischema.GroupKind{Group: "apps", Kind: "StatefulSet"},
+			apischema.GroupKind{Group: "apps", Kind: "DaemonSet"},
+			apischema.GroupKind{Group: "apps", Kind: "ReplicaSet"},
+			apischema.GroupKind{Group: "batch", Kind: "Job"},
+			apischema.GroupKind{Group: "batch", Kind: "CronJob"},
+			apischema.GroupKind{Kind: "Pod"},
+		), nil
+	default:
+		return nil, fmt.Errorf("invalid apply group: unsupported type of predefinedResourceGroup: %s", definition.PredefinedResourceGroup.Type)
+	}
+}
+
+func groupKindOf(res *managedresource.ManagedResourceStatus) apischema.GroupKind {
+	return res.Resource.GroupVersionKind().GroupKind()
+}
+
+func newGroupKindMatcher(groupKinds ...apischema.GroupKind) Matcher {
+	return &GroupKindMatcher{groupKinds: groupKinds}
+}
+
+type GroupKindMatcher struct {
+	groupKinds []apischema.GroupKind
+}
+
+func (m *GroupKindMatcher) Match(res *managedresource.ManagedResourceStatus) bool {
+	return slices.Contains(m.groupKinds, groupKindOf(res))
+}
+
+type RBACMatcher struct{}
+
+func (m *RBACMatcher) Match(res *managedresource.ManagedResourceStatus) bool {
+	groupKind := groupKindOf(res)
+	return groupKind.Group == "rbac.authorization.k8s.io" || groupKind == apischema.GroupKind{Kind: "ServiceAccount"}
+}
+
+type AllMatcher struct{}
+
+func (m *AllMatcher) Match(*managedresource.ManagedResourceStatus) bool {
+	return true
+}
@@ -62,15 +62,24 @@ type ManifestApplierOptions struct {
 	Clientset        kubernetes.Interface
 	DefaultNamespace string
 
-	DeployItemName   string
-	DeployItem       *lsv1alpha1.DeployItem
-	UpdateStrategy   manifestv1alpha2.UpdateStrategy
+	DeployItemName string
+	DeployItem     *lsv1alpha1.DeployItem
+	UpdateStrategy manifestv1alpha2.UpdateStrategy
+	// ServerSideApply configures the server-side apply update strategy.
+	ServerSideApply  *managedresource.ServerSideApplyConfiguration
 	Manifests        []managedresource.Manifest
 	ManagedResources managedresource.ManagedResourceStatusList
 	// Labels defines additional labels that are automatically injected into all resources.
-	Labels                     map[string]string
+	Labels map[string]string
+	// ApplyGroups defines the order in which the manifests are applied.
+	ApplyGroups []managedresource.ApplyGroupDefinition
+	// ReadinessCheck checks the readiness of the resources of apply groups that wait for readiness.
+	ReadinessCheck             ReadinessCheckFunc
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
-	InterruptionChecker        interruption.InterruptionChecker
+	// PruneProtection restricts the deletion of orphaned resources.
+	// If it is nil, the default protected resources are not deleted.
+	PruneProtection     *managedresource.PruneProtection
+	InterruptionChecker interruption.InterruptionChecker
 
 	LsUncachedClient client.Client
 	LsRestConfig     *rest.Config
@@ -85,25 +94,57 @@ type ManifestApplier struct {
 	deployItemName             string
 	deployItem                 *lsv1alpha1.DeployItem
 	updateStrategy             manifestv1alpha2.UpdateStrategy
+	serverSideApply            *managedresource.ServerSideApplyConfiguration
 	manifests                  []managedresource.Manifest
 	managedResources           managedresource.ManagedResourceStatusList
 	labels                     map[string]string
+	applyGroupDefinitions      []managedresource.ApplyGroupDefinition
+	readinessCheck             ReadinessCheckFunc
 	deletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
+	pruneProtection            *managedresource.PruneProtection
 	interruptionChecker        interruption.InterruptionChecker
 	lsUncachedClient           client.Client
 	lsRestConfig               *rest.Config
 
 	// properties created during runtime
 
+	// applyGroups contains the manifests sorted by the apply groups in which they are applied.
+	// The last group contains all manifests that match no configured apply group.
+	applyGroups        []*applyGroup
+	apiResourceHandler *ApiResourceHandler
+	// protectedResources contains the orphaned resources that have not been deleted due to the prune protection.
+	protectedResources managedresource.ManagedResourceStatusList
+}
+
+// ReadinessCheckFunc checks whether the given resources are ready.
+type ReadinessCheckFunc func(ctx context.Context, resources managedresource.ManagedResourceStatusList) error
+
+// applyGroup contains the manifests of an apply group.
+type applyGroup struct {
+	// definition is nil for the group of manifests that match no configured apply group.
+	definition *managedresource.ApplyGroupDefinition
+	matcher    Matcher
+
 	// manifestExecutions contains a sorted list of lists of managed resources.
 	// The list of list describe execution groups of manifests that can run in parallel.
 	//
 	// Currently the fist list can be max 3 whereas the first group contains all CRD's.
 	// The second group contains all clusterwide resources and teh third one contains all namespaced resources.
 	manifestExecutions [3][]*Manifest
-	apiResourceHandler *ApiResourceHandler
 }
 
+// waitForReadiness returns whether the next group must only be applied after the resources of this group are ready.
+func (g *applyGroup) waitForReadiness() bool {
+	return g.definition != nil && g.definition.WaitForReadiness
+}
+
+const (
+	// FieldManagerPrefix is the prefix of the names of the field managers that are used for server-side apply.
+	FieldManagerPrefix = "landscaper-"
+	// maxFieldManagerLength is the maximal length of a field manager name accepted by the api server.
+	maxFieldManagerLength = 128
+)
+
 const (
 	ExecutionGroupCRD = iota
 	ExecutionGroupClusterwide
@@ -136,10 +177,14 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		deployItem:                 opts.DeployItem,
 		deployItemName:             opts.DeployItemName,
 		updateStrategy:             opts.UpdateStrategy,
+		serverSideApply:            opts.ServerSideApply,
 		manifests:                  opts.Manifests,
 		managedResources:           opts.ManagedResources,
 		labels:                     opts.Labels,
+		applyGroupDefinitions:      opts.ApplyGroups,
+		readinessCheck:             opts.ReadinessCheck,
 		deletionGroupsDuringUpdate: opts.DeletionGroupsDuringUpdate,
+		pruneProtection:            opts.PruneProtection,
 		interruptionChecker:        opts.InterruptionChecker,
 		apiResourceHandler:         CreateApiResourceHandler(opts.Clientset),
 		lsUncachedClient:           opts.LsUncachedClient,
@@ -152,6 +197,12 @@ func (a *ManifestApplier) GetManagedResourcesStatus() managedresource.ManagedRes
 	return a.managedResources
 }
 
+// GetProtectedResources returns the orphaned resources that have not been deleted due to the prune protection.
+// They are no longer part of the managed resources.
+func (a *ManifestApplier) GetProtectedResources() managedresource.ManagedResourceStatusList {
+	return a.protectedResources
+}
+
 // Apply creates or updates all configured manifests.
 func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
 	if err := a.prepareManifests(ctx); err != nil {
@@ -168,50 +219,77 @@ func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
 	oldManagedResources := a.managedResources
 	a.managedResources = make(managedresource.ManagedResourceStatusList, 0)
 
-	var timeoutErr lserrors.LsError
+	var (
+		timeoutErr lserrors.LsError
+		aborted    bool
+	)
 
-	for _, list := range a.manifestExecutions {
-		var (
-			wg               = sync.WaitGroup{}
-			managedResources = make([]managedresource.ManagedResourceStatus, 0)
-			mux              sync.Mutex
-		)
-		for _, m := range list {
+	for i, group := range a.applyGroups {
+		groupManagedResources := make(managedresource.ManagedResourceStatusList, 0)
 
-			if _, timeoutErr = timeout.TimeoutExceeded(ctx, a.deployItem, TimeoutCheckpointDeployerApplyManifests); timeoutErr != nil {
-				break
-			}
+		for _, list := range group.manifestExecutions {
+			var (
+				wg               = sync.WaitGroup{}
+				managedResources = make([]managedresource.ManagedResourceStatus, 0)
+				mux              sync.Mutex
+			)
+			for _, m := range list {
 
-			wg.Add(1)
-			go func(m *Manifest) {
-				defer wg.Done()
-				mr, patchInfo, err := a.applyObject(ctx, m)
-				if err != nil {
-					errMux.Lock()
-					defer errMux.Unlock()
-					allErrs = append(allErrs, err)
+				if _, timeoutErr = timeout.TimeoutExceeded(ctx, a.deployItem, TimeoutCheckpointDeployerApplyManifests); timeoutErr != nil {
+					break
 				}
-				if mr != nil {
-					mux.Lock()
-					managedResources = append(managedResources, *mr)
-					if patchInfo != nil {
-						patchInfos = append(patchInfos, patchInfo)
+
+				wg.Add(1)
+				go func(m *Manifest) {
+					defer wg.Done()
+					mr, patchInfo, err := a.applyObject(ctx, m)
+					if err != nil {
+						errMux.Lock()
+						defer errMux.Unlock()
+						allErrs = append(allErrs, err)
 					}
-					mux.Unlock()
-				}
-			}(m)
+					if mr != nil {
+						mux.Lock()
+						managedResources = append(managedResources, *mr)
+						if patchInfo != nil {
+							patchInfos = append(patchInfos, patchInfo)
+						}
+						mux.Unlock()
+					}
+				}(m)
+			}
+			wg.Wait()
+
+			if timeoutErr != nil {
+				a.keepUnappliedResources(oldManagedResources)
+				return nil, timeoutErr
+			}
+
+			sort.Sort(managesResourceList(managedResources))
+			a.managedResources = append(a.managedResources, managedResources...)
+			groupManagedResources = append(groupManagedResources, managedResources...)
 		}
-		wg.Wait()
 
-		if timeoutErr != nil {
-			return nil, timeoutErr
+		if !group.waitForReadiness() || a.readinessCheck == nil || i == len(a.applyGroups)-1 {
+			continue
 		}
 
-		sort.Sort(managesResourceList(managedResources))
-		a.managedResources = append(a.managedResources, managedResources...)
+		// the resources of later groups must not be applied before the resources of this group are ready
+		if len(allErrs) != 0 {
+			aborted = true
+			break
+		}
+		if err := a.readinessCheck(ctx, groupManagedResources); err != nil {
+			a.keepUnappliedResources(oldManagedResources)
+			err = fmt.Errorf("resources of apply group %d are not ready: %w", i, err)
+			return nil, lserrors.NewWrappedError(err, "ApplyObjects", "CheckApplyGroupReadiness", err.Error())
+		}
 	}
 
 	if len(allErrs) != 0 {
+		if aborted {
+			a.keepUnappliedResources(oldManagedResources)
+		}
 		aggErr := apimacherrors.NewAggregate(allErrs)
 		return nil, lserrors.NewWrappedError(apimacherrors.NewAggregate(allErrs), "ApplyObjects", "ApplyNewObject", aggErr.Error())
 	}
@@ -260,7 +338,13 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			obj.SetAnnotations(objAnnotations)
 		}
 
-		if err := a.kubeClient.Create(ctx, obj); err != nil {
+		var fieldManager string
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyServerSideApply {
+			fieldManager = a.fieldManager()
+			if err := a.serverSideApplyObject(ctx, obj); err != nil {
+				return nil, nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
+			}
+		} else if err := a.kubeClient.Create(ctx, obj); err != nil {
 			return nil, nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
 		}
 
@@ -277,6 +361,7 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			PatchBeforeDelete:    manifest.PatchBeforeDelete,
 			Policy:               manifest.Policy,
 			Resource:             *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+			FieldManager:         fieldManager,
 		}, patchInfo, nil
 	}
 
@@ -294,6 +379,18 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		return nil, nil, nil
 	}
 
+	// if adopt policy is set, a resource that is not managed by any deploy item is taken over,
+	// whereas a resource that is managed by another deploy item must not be taken over
+	if manifest.Policy == managedresource.AdoptPolicy {
+		owner, managed := currObj.GetLabels()[manifestv1alpha2.ManagedDeployItemLabel]
+		if managed && owner != a.deployItemName {
+			return nil, nil, fmt.Errorf("unable to adopt resource %s: it is already managed by deploy item %q", key.String(), owner)
+		}
+		if !managed {
+			logger.Info("Adopting resource that is not managed by a deploy item", lc.KeyResource, key.String())
+		}
+	}
+
 	if manifest.Policy == managedresource.ImmutablePolicy {
 		logger.Info("Resource is immutable, skip update", lc.KeyResource, key.String())
 		return mr, nil, nil
@@ -345,6 +442,15 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
 			return mr, nil, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+	case manifestv1alpha2.UpdateStrategyServerSideApply:
+		// inject manifest specific labels
+		a.injectLabels(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		mr.FieldManager = a.fieldManager()
+		if err := a.serverSideApplyObject(ctx, obj); err != nil {
+			return mr, nil, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+		}
 	default:
 		return mr, nil, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
@@ -360,6 +466,44 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 	return mr, patchInfo, nil
 }
 
+// fieldManager returns the name of the field manager that is used for server-side apply.
+// Every deploy item has its own field manager, so that the ownership of fields can be tracked per deploy item.
+func (a *ManifestApplier) fieldManager() string {
+	return FieldManagerName(a.deployItemName)
+}
+
+// serverSideApplyObject applies an object with server-side apply.
+// Conflicts with other field managers are only resolved if the force option is set.
+func (a *ManifestApplier) serverSideApplyObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	opts := []client.PatchOption{client.FieldOwner(a.fieldManager())}
+	force := a.serverSideApply != nil && a.serverSideApply.Force
+	if force {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	// server-side apply rejects objects that contain a resource version of an outdated object or managed fields
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	if err := a.kubeClient.Patch(ctx, obj, client.Apply, opts...); err != nil {
+		if apierrors.IsConflict(err) && !force {
+			return fmt.Errorf("fields are owned by other field managers, set serverSideApply.force to take over their ownership: %w", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// FieldManagerName returns the name of the field manager that is used for the server-side apply of the resources
+// of a deploy item.
+func FieldManagerName(deployItemName string) string {
+	name := FieldManagerPrefix + deployItemName
+	if len(name) > maxFieldManagerLength {
+		name = name[:maxFieldManagerLength]
+	}
+	return name
+}
+
 func (a *ManifestApplier) getUnstructuredManifestObject(ctx context.Context, manifest *Manifest) (*unstructured.Unstructured, error) {
 	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "getManifestObjectKey")
 
@@ -437,6 +581,12 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 		orphanedManagedResources = append(orphanedManagedResources, *mr)
 	}
 
+	orphanedManagedResources, protectedResources, protectionErr := FilterPrunableResources(ctx, a.pruneProtection, orphanedManagedResources)
+	if protectionErr != nil {
+		return protectionErr
+	}
+	a.protectedResources = append(a.protectedResources, protectedResources...)
+
 	for i := range orphanedManagedResources {
 		mr := &orphanedManagedResources[i]
 
@@ -467,8 +617,8 @@ func FilterByPolicy(ctx context.Context, mr *managedresource.ManagedResourceStat
 		return false, nil
 	}
 
-	if mr.Policy == managedresource.FallbackPolicy {
-		// if fallback policy is set and the resource is already managed by another deployer
+	if mr.Policy == managedresource.FallbackPolicy || mr.Policy == managedresource.AdoptPolicy {
+		// if fallback or adopt policy is set and the resource is already managed by another deployer
 		// we are not allowed to manage that resource
 		ref := mr.Resource
 		obj := kutil.ObjectFromCoreObjectReference(&ref)
@@ -552,6 +702,17 @@ func AnnotateAndPatchBeforeDelete(ctx context.Context, mr *managedresource.Manag
 	return false, nil
 }
 
+// keepUnappliedResources adds the previously managed resources that have not been applied again to the managed
+// resources. It is called if the apply is aborted, so that these resources are still managed,
+// and cleaned up by a later apply if they are orphaned.
+func (a *ManifestApplier) keepUnappliedResources(oldManagedResources managedresource.ManagedResourceStatusList) {
+	for _, mr := range oldManagedResources {
+		if !containsObjectRef(mr.Resource, a.managedResources) {
+			a.managedResources = append(a.managedResources, mr)
+		}
+	}
+}
+
 // filterOrphaned returns true if the resource is orphaned, i.e. not contained in a.managedResources.
 func (a *ManifestApplier) filterOrphaned(mr *managedresource.ManagedResourceStatus) bool {
 	return !containsObjectRef(mr.Resource, a.managedResources)
@@ -590,9 +751,11 @@ func crdIdentifier(gvk apischema.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)
 }
 
-// prepareManifests sorts all manifests.
+// prepareManifests sorts all manifests into apply groups and execution groups.
 func (a *ManifestApplier) prepareManifests(ctx context.Context) error {
-	a.manifestExecutions = [3][]*Manifest{}
+	if err := a.initApplyGroups(); err != nil {
+		return err
+	}
 	crdNamespacedInfo := map[string]bool{}
 	todo := []*Manifest{}
 
@@ -624,7 +787,9 @@ func (a *ManifestApplier) prepareManifests(ctx context.Context) error {
 		}
 		// add to specific execution group
 		if kind == "CustomResourceDefinition" {
-			a.manifestExecutions[ExecutionGroupCRD] = append(a.manifestExecutions[ExecutionGroupCRD], manifest)
+			if err := a.addToApplyGroup(manifest, ExecutionGroupCRD, false); err != nil {
+				return err
+			}
 			crd := &extv1.CustomResourceDefinition{}
 			if err := json.Unmarshal(obj.Manifest.Raw, crd); err != nil {
 				return fmt.Errorf("unable to parse CRD: %w", err)
@@ -660,12 +825,64 @@ func (a *ManifestApplier) prepareManifests(ctx context.Context) error {
 			namespaced = apiresource.Namespaced
 		}
 		if namespaced {
-			a.manifestExecutions[ExecutionGroupNamespaced] = append(a.manifestExecutions[ExecutionGroupNamespaced], manifest)
+			err = a.addToApplyGroup(manifest, ExecutionGroupNamespaced, true)
 		} else {
-			a.manifestExecutions[ExecutionGroupClusterwide] = append(a.manifestExecutions[ExecutionGroupClusterwide], manifest)
+			err = a.addToApplyGroup(manifest, ExecutionGroupClusterwide, false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initApplyGroups creates the apply groups of the configured apply group definitions,
+// and a last group for all manifests that match no configured apply group.
+func (a *ManifestApplier) initApplyGroups() error {
+	a.applyGroups = make([]*applyGroup, 0, len(a.applyGroupDefinitions)+1)
+	for i := range a.applyGroupDefinitions {
+		definition := &a.applyGroupDefinitions[i]
+		matcher, err := newApplyGroupMatcher(definition)
+		if err != nil {
+			return err
 		}
+		a.applyGroups = append(a.applyGroups, &applyGroup{
+			definition: definition,
+			matcher:    matcher,
+		})
+	}
+	a.applyGroups = append(a.applyGroups, &applyGroup{
+		matcher: &AllMatcher{},
+	})
+	return nil
+}
+
+// addToApplyGroup adds a manifest to the given execution group of the first apply group that matches the manifest.
+func (a *ManifestApplier) addToApplyGroup(manifest *Manifest, executionGroup int, namespaced bool) error {
+	meta := metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(manifest.Manifest.Raw, &meta); err != nil {
+		return fmt.Errorf("unable to parse object metadata: %w", err)
+	}
+	namespace := meta.Namespace
+	if namespaced && len(namespace) == 0 {
+		namespace = a.defaultNamespace
 	}
 
+	res := &managedresource.ManagedResourceStatus{
+		Resource: corev1.ObjectReference{
+			APIVersion: manifest.TypeMeta.APIVersion,
+			Kind:       manifest.TypeMeta.Kind,
+			Name:       meta.Name,
+			Namespace:  namespace,
+		},
+	}
+	for _, group := range a.applyGroups {
+		if group.matcher.Match(res) {
+			group.manifestExecutions[executionGroup] = append(group.manifestExecutions[executionGroup], manifest)
+			return nil
+		}
+	}
 	return nil
 }
 
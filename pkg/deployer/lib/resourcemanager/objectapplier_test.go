@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -697,4 +698,232 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	It("should update objects correctly when serverSideApply strategy is selected", func() {
+		// CREATE
+
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			DeployItemName:   "test-di",
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+					Policy:   managedresource.ManagePolicy,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+			Labels: map[string]string{
+				"managedLabel": "managedVal",
+			},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Resource.Name).To(Equal("my-cm"))
+		Expect(managedResources[0].FieldManager).To(Equal(resourcemanager.FieldManagerName(opts.DeployItemName)))
+
+		cmRead := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Labels).To(HaveKeyWithValue(manifestv1alpha2.ManagedDeployItemLabel, opts.DeployItemName))
+		Expect(cmRead.Labels).To(HaveKeyWithValue("managedLabel", "managedVal"))
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "val"))
+
+		// UPDATE of a field owned by another field manager
+
+		modifiedCm := &corev1.ConfigMap{}
+		Expect(state.Client.Get(ctx, client.ObjectKeyFromObject(cm), modifiedCm)).To(Succeed())
+		modifiedCm.Data["key"] = "valModified"
+		modifiedCm.Data["addedKey"] = "val1"
+		Expect(state.Client.Update(ctx, modifiedCm, client.FieldOwner("other-manager"))).To(Succeed())
+
+		cm.Data["key"] = "valUpdated"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+				Policy:   managedresource.ManagePolicy,
+			},
+		}
+		opts.ManagedResources = managedResources
+
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).To(HaveOccurred())
+
+		opts.ServerSideApply = &managedresource.ServerSideApplyConfiguration{Force: true}
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "valUpdated"))
+		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
+	})
+
+	Context("Apply groups", func() {
+
+		var (
+			cm        *corev1.ConfigMap
+			secret    *corev1.Secret
+			manifests []managedresource.Manifest
+		)
+
+		BeforeEach(func() {
+			cm = &corev1.ConfigMap{}
+			cm.Name = "my-cm"
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{
+				"key": "val",
+			}
+			cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+			secret = &corev1.Secret{}
+			secret.Name = "my-secret"
+			secret.Namespace = state.Namespace
+			secret.Data = map[string][]byte{
+				"key": []byte("val"),
+			}
+			secretRaw, err := kutil.ConvertToRawExtension(secret, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+
+			manifests = []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+				{
+					Manifest: secretRaw,
+				},
+			}
+		})
+
+		It("should apply the resources in the order of the apply groups", func() {
+			checkedResources := [][]string{}
+			opts := resourcemanager.ManifestApplierOptions{
+				Decoder:          api.NewDecoder(scheme.Scheme),
+				KubeClient:       testenv.Client,
+				Clientset:        clientset,
+				DefaultNamespace: state.Namespace,
+				UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+				Manifests:        manifests,
+				ManagedResources: managedresource.ManagedResourceStatusList{},
+				ApplyGroups: []managedresource.ApplyGroupDefinition{
+					{
+						CustomResourceGroup: &managedresource.CustomApplyGroup{
+							Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+						},
+						WaitForReadiness: true,
+					},
+				},
+				ReadinessCheck: func(_ context.Context, resources managedresource.ManagedResourceStatusList) error {
+					names := []string{}
+					for _, res := range resources {
+						names = append(names, res.Resource.Name)
+					}
+					checkedResources = append(checkedResources, names)
+					return nil
+				},
+			}
+			managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(managedResources).To(HaveLen(2))
+			Expect(managedResources[0].Resource.Name).To(Equal("my-secret"))
+			Expect(managedResources[1].Resource.Name).To(Equal("my-cm"))
+			Expect(checkedResources).To(Equal([][]string{{"my-secret"}}))
+		})
+
+		It("should not apply later apply groups if the resources of a group are not ready", func() {
+			opts := resourcemanager.ManifestApplierOptions{
+				Decoder:          api.NewDecoder(scheme.Scheme),
+				KubeClient:       testenv.Client,
+				Clientset:        clientset,
+				DefaultNamespace: state.Namespace,
+				UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+				Manifests:        manifests,
+				ManagedResources: managedresource.ManagedResourceStatusList{},
+				ApplyGroups: []managedresource.ApplyGroupDefinition{
+					{
+						CustomResourceGroup: &managedresource.CustomApplyGroup{
+							Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+						},
+						WaitForReadiness: true,
+					},
+				},
+				ReadinessCheck: func(_ context.Context, _ managedresource.ManagedResourceStatusList) error {
+					return fmt.Errorf("not ready")
+				},
+			}
+			_, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).To(HaveOccurred())
+
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+			err = testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), &corev1.ConfigMap{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+	})
+
+	It("should adopt unmanaged resources and reject resources of other deploy items with the adopt policy", func() {
+		unmanaged := &corev1.ConfigMap{}
+		unmanaged.Name = "unmanaged"
+		unmanaged.Namespace = state.Namespace
+		Expect(testenv.Client.Create(ctx, unmanaged)).To(Succeed())
+
+		managed := &corev1.ConfigMap{}
+		managed.Name = "managed"
+		managed.Namespace = state.Namespace
+		managed.Labels = map[string]string{manifestv1alpha2.ManagedDeployItemLabel: "other-item"}
+		Expect(testenv.Client.Create(ctx, managed)).To(Succeed())
+
+		newManifest := func(name string) managedresource.Manifest {
+			cm := &corev1.ConfigMap{}
+			cm.Name = name
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{"key": "val"}
+			raw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+			return managedresource.Manifest{Policy: managedresource.AdoptPolicy, Manifest: raw}
+		}
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeployItemName:   "my-item",
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests:        []managedresource.Manifest{newManifest("unmanaged")},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(unmanaged), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(res.Labels).To(HaveKeyWithValue(manifestv1alpha2.ManagedDeployItemLabel, "my-item"))
+
+		opts.Manifests = []managedresource.Manifest{newManifest("managed")}
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).To(HaveOccurred())
+
+		res = &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(managed), res)).To(Succeed())
+		Expect(res.Data).To(BeEmpty())
+	})
 })
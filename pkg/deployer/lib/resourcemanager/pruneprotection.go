@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apischema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// defaultProtectedResources contains the kinds of resources that are protected by default, because their deletion
+// usually results in the loss of data.
+var defaultProtectedResources = newGroupKindMatcher(
+	apischema.GroupKind{Kind: "PersistentVolumeClaim"},
+	apischema.GroupKind{Kind: "Namespace"},
+	apischema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"},
+)
+
+// FilterPrunableResources splits the orphaned resources into the resources that may be deleted according to the given
+// prune protection, and the protected resources. Protected resources are not deleted, and are no longer managed.
+// If no prune protection is given, only the default protected resources are protected.
+// If more resources would be deleted than allowed, an error is returned.
+func FilterPrunableResources(ctx context.Context, protection *managedresource.PruneProtection,
+	orphaned []managedresource.ManagedResourceStatus) (prunable, protected []managedresource.ManagedResourceStatus, err error) {
+
+	if len(orphaned) == 0 {
+		return orphaned, nil, nil
+	}
+	if protection == nil {
+		protection = &managedresource.PruneProtection{}
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "FilterPrunableResources")
+
+	protectedMatchers := []Matcher{}
+	if !protection.DisableDefaultProtectedResources {
+		protectedMatchers = append(protectedMatchers, defaultProtectedResources)
+	}
+	if len(protection.ProtectedResources) != 0 {
+		protectedMatchers = append(protectedMatchers, &CustomMatcher{resourceTypes: protection.ProtectedResources})
+	}
+
+	prunable = make([]managedresource.ManagedResourceStatus, 0, len(orphaned))
+	for i := range orphaned {
+		mr := &orphaned[i]
+		if matchesAny(protectedMatchers, mr) {
+			logger.Info("Orphaned resource is protected and will not be deleted",
+				lc.KeyResource, types.NamespacedName{Namespace: mr.Resource.Namespace, Name: mr.Resource.Name}.String(),
+				lc.KeyResourceKind, mr.Resource.Kind)
+			protected = append(protected, *mr)
+			continue
+		}
+		prunable = append(prunable, *mr)
+	}
+
+	if protection.MaxPrunedResources != nil && len(prunable) > int(*protection.MaxPrunedResources) {
+		return nil, nil, fmt.Errorf("%d orphaned resources would be deleted, but at most %d resources may be deleted in one reconciliation: "+
+			"check the manifests, or increase pruneProtection.maxPrunedResources", len(prunable), *protection.MaxPrunedResources)
+	}
+
+	return prunable, protected, nil
+}
+
+// ProtectedResourcesMessage returns a human-readable list of protected orphaned resources.
+func ProtectedResourcesMessage(protected []managedresource.ManagedResourceStatus) string {
+	resources := make([]string, 0, len(protected))
+	for _, mr := range protected {
+		name := mr.Resource.Name
+		if len(mr.Resource.Namespace) != 0 {
+			name = mr.Resource.Namespace + "/" + name
+		}
+		resources = append(resources, mr.Resource.Kind+" "+name)
+	}
+	return strings.Join(resources, ", ")
+}
+
+func matchesAny(matchers []Matcher, res *managedresource.ManagedResourceStatus) bool {
+	for _, m := range matchers {
+		if m.Match(res) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+)
+
+var _ = Describe("PruneProtection", func() {
+
+	newResource := func(apiVersion, kind, namespace, name string) managedresource.ManagedResourceStatus {
+		return managedresource.ManagedResourceStatus{
+			Resource: corev1.ObjectReference{
+				APIVersion: apiVersion,
+				Kind:       kind,
+				Namespace:  namespace,
+				Name:       name,
+			},
+		}
+	}
+
+	var orphaned []managedresource.ManagedResourceStatus
+
+	BeforeEach(func() {
+		orphaned = []managedresource.ManagedResourceStatus{
+			newResource("v1", "ConfigMap", "default", "my-cm"),
+			newResource("v1", "PersistentVolumeClaim", "default", "my-pvc"),
+			newResource("v1", "Namespace", "", "my-ns"),
+			newResource("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "tests.example.com"),
+			newResource("apps/v1", "Deployment", "default", "my-deployment"),
+		}
+	})
+
+	It("should not prune the default protected resources if no prune protection is configured", func() {
+		prunable, protected, err := resourcemanager.FilterPrunableResources(context.Background(), nil, orphaned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prunable).To(ConsistOf(orphaned[0], orphaned[4]))
+		Expect(protected).To(ConsistOf(orphaned[1], orphaned[2], orphaned[3]))
+	})
+
+	It("should not prune the default protected resources", func() {
+		prunable, protected, err := resourcemanager.FilterPrunableResources(context.Background(), &managedresource.PruneProtection{}, orphaned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prunable).To(ConsistOf(orphaned[0], orphaned[4]))
+		Expect(protected).To(ConsistOf(orphaned[1], orphaned[2], orphaned[3]))
+	})
+
+	It("should not prune additional protected resources", func() {
+		protection := &managedresource.PruneProtection{
+			ProtectedResources: []managedresource.ResourceType{
+				{APIVersion: "apps/v1", Kind: "Deployment"},
+			},
+		}
+		prunable, protected, err := resourcemanager.FilterPrunableResources(context.Background(), protection, orphaned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prunable).To(ConsistOf(orphaned[0]))
+		Expect(protected).To(HaveLen(4))
+	})
+
+	It("should prune the default protected resources if their protection is disabled", func() {
+		protection := &managedresource.PruneProtection{
+			DisableDefaultProtectedResources: true,
+		}
+		prunable, protected, err := resourcemanager.FilterPrunableResources(context.Background(), protection, orphaned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prunable).To(Equal(orphaned))
+		Expect(protected).To(BeEmpty())
+	})
+
+	It("should fail if more resources would be pruned than allowed", func() {
+		protection := &managedresource.PruneProtection{
+			MaxPrunedResources: ptr.To[int32](1),
+		}
+		_, _, err := resourcemanager.FilterPrunableResources(context.Background(), protection, orphaned)
+		Expect(err).To(HaveOccurred())
+
+		protection.MaxPrunedResources = ptr.To[int32](2)
+		prunable, _, err := resourcemanager.FilterPrunableResources(context.Background(), protection, orphaned)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(prunable).To(HaveLen(2))
+	})
+
+	It("should list the protected resources", func() {
+		Expect(resourcemanager.ProtectedResourcesMessage(orphaned[1:3])).
+			To(Equal("PersistentVolumeClaim default/my-pvc, Namespace my-ns"))
+	})
+
+})
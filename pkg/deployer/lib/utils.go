@@ -26,6 +26,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -130,14 +131,38 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 			if err == nil {
 				return err2
 			}
-		} else if finishedObjectCache != nil && IsDeployItemFinished(deployItem) {
-			finishedObjectCache.AddSynchonized(&deployItem.ObjectMeta)
+		} else {
+			recordDeployItemMetrics(lsEventRecorder, oldDeployItem, deployItem)
+			lsutil.RecordPhaseChangedEvent(lsEventRecorder, deployItem, oldDeployItem.Status.Phase, deployItem.Status.Phase)
+
+			if finishedObjectCache != nil && IsDeployItemFinished(deployItem) {
+				finishedObjectCache.AddSynchonized(&deployItem.ObjectMeta)
+			}
 		}
 	}
 
 	return err
 }
 
+// recordDeployItemMetrics records a phase transition of the deploy item,
+// and a progressing timeout if the deploy item job has failed because of it.
+// A progressing timeout is also emitted as event.
+func recordDeployItemMetrics(lsEventRecorder record.EventRecorder, oldDeployItem, deployItem *lsv1alpha1.DeployItem) {
+	jobFinished := deployItem.Status.JobIDFinished != oldDeployItem.Status.JobIDFinished
+	if deployItem.Status.Phase == oldDeployItem.Status.Phase && !jobFinished {
+		return
+	}
+
+	metrics.RecordDeployItemPhase(deployItem)
+
+	if jobFinished && deployItem.Status.Phase.IsFailed() && deployItem.Status.GetLastError() != nil &&
+		lserrors.HasErrorCode(deployItem.Status.GetLastError().Codes, lsv1alpha1.ErrorTimeout) {
+		metrics.RecordDeployItemTimeout(deployItem, metrics.TimeoutProgressing)
+		lsEventRecorder.Eventf(deployItem, corev1.EventTypeWarning, lsutil.ProgressingTimeoutEventReason,
+			"job %s failed due to a progressing timeout: %s", deployItem.Status.JobIDFinished, deployItem.Status.GetLastError().Message)
+	}
+}
+
 func CheckResponsibility(ctx context.Context, lsClient client.Client, obj *metav1.PartialObjectMetadata,
 	deployerType lsv1alpha1.DeployItemType, targetSelectors []lsv1alpha1.TargetSelector) (*lsv1alpha1.ResolvedTarget, bool, bool, lserrors.LsError) {
 
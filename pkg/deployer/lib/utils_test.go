@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("HandleReconcileResult", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		recorder   *record.FakeRecorder
+		di         *lsv1alpha1.DeployItem
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		recorder = record.NewFakeRecorder(1024)
+		di = &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       lsv1alpha1.DeployItemSpec{Type: "test"},
+		}
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		di.Status.SetJobID("job2")
+		di.Status.JobIDFinished = "job1"
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).WithObjects(di).Build()
+	})
+
+	// failJob lets the reconciliation of the deploy item job fail with the given error.
+	failJob := func(err lserrors.LsError) []string {
+		deployItem := di.DeepCopy()
+		deployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Failed
+		Expect(HandleReconcileResult(ctx, err, di, deployItem, kubeClient, recorder, nil)).To(Equal(err))
+		Expect(deployItem.Status.JobIDFinished).To(Equal("job2"))
+
+		close(recorder.Events)
+		events := []string{}
+		for event := range recorder.Events {
+			events = append(events, event)
+		}
+		return events
+	}
+
+	It("should emit an event if the job has failed due to a progressing timeout", func() {
+		err := lserrors.NewError("Reconcile", lsv1alpha1.ProgressingTimeoutReason, "deployer has not finished", lsv1alpha1.ErrorTimeout)
+
+		Expect(failJob(err)).To(ContainElement(
+			"Warning ProgressingTimeout job job2 failed due to a progressing timeout: deployer has not finished"))
+	})
+
+	It("should not emit a progressing timeout event if the job has failed due to another error", func() {
+		err := lserrors.NewError("Reconcile", "ApplyManifests", "manifests are invalid")
+
+		events := failJob(err)
+		Expect(events).To(ContainElement("Warning ApplyManifests manifests are invalid"))
+		Expect(events).ToNot(ContainElement(HavePrefix("Warning ProgressingTimeout")))
+	})
+})
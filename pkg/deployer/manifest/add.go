@@ -20,11 +20,12 @@ import (
 	"github.com/gardener/landscaper/pkg/version"
 )
 
-// AddDeployerToManager adds a new helm deployers to a controller manager.
+// AddDeployerToManager adds a new manifest deployer to a controller manager.
+// It returns the drift detection job of the deployer, which has to be started together with the managers.
 func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	finishedObjectCache *utils.FinishedObjectCache,
 	logger logging.Logger, lsMgr, hostMgr manager.Manager, config manifestv1alpha2.Configuration,
-	callerName, controllerName string) error {
+	callerName, controllerName string) (*deployerlib.DriftDetectionJob, error) {
 	log := logger.WithName("k8sManifest")
 
 	lockingEnabled := config.HPAConfiguration != nil && config.HPAConfiguration.MaxReplicas > 1
@@ -35,16 +36,17 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 
 	problemHandler := utils.GetCriticalProblemsHandler()
 	if err := problemHandler.AccessAllowed(context.Background(), hostUncachedClient); err != nil {
-		return err
+		return nil, err
 	}
 	log.Info("access to critical problems allowed")
 
 	d, err := NewDeployer(lsMgr.GetConfig(), lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		log,
+		lsMgr.GetEventRecorderFor(Name),
 		config,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	options := controller.Options{
@@ -54,7 +56,7 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 		options.CacheSyncTimeout = config.Controller.CacheSyncTimeout.Duration
 	}
 
-	return deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+	if err := deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
 			Name:            Name,
@@ -64,5 +66,16 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 			Deployer:        d,
 			TargetSelectors: config.TargetSelector,
 			Options:         options,
-		}, config.Controller.Workers, lockingEnabled, callerName, controllerName)
+		}, config.Controller.Workers, lockingEnabled, callerName, controllerName); err != nil {
+		return nil, err
+	}
+
+	detector, ok := d.(deployerlib.DriftDetector)
+	if !ok {
+		return nil, fmt.Errorf("the manifest deployer does not support drift detection")
+	}
+	if err := deployerlib.IndexDeployItemsByDeployerType(context.Background(), lsMgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("unable to index deploy items for the drift detection: %w", err)
+	}
+	return deployerlib.NewDriftDetectionJob(lsUncachedClient, lsCachedClient, log, detector, Type, config.TargetSelector), nil
 }
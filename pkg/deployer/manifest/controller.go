@@ -9,14 +9,17 @@ import (
 	"time"
 
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 )
 
@@ -26,6 +29,7 @@ const (
 	TimeoutCheckpointManifestBeforeReadingExportValues = "manifest deployer: before reading export values"
 	TimeoutCheckpointManifestDefaultReadinessChecks    = "manifest deployer: default readiness checks"
 	TimeoutCheckpointManifestCustomReadinessChecks     = "manifest deployer: custom readiness checks"
+	TimeoutCheckpointManifestApplyGroupReadinessCheck  = "manifest deployer: apply group readiness check"
 	TimeoutCheckpointManifestStartDelete               = "manifest deployer: start delete"
 )
 
@@ -33,6 +37,7 @@ const (
 func NewDeployer(lsRestConfig *rest.Config,
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	log logging.Logger,
+	lsEventRecorder record.EventRecorder,
 	config manifestv1alpha2.Configuration) (deployerlib.Deployer, error) {
 
 	dep := &deployer{
@@ -42,6 +47,7 @@ func NewDeployer(lsRestConfig *rest.Config,
 		hostUncachedClient: hostUncachedClient,
 		hostCachedClient:   hostCachedClient,
 		log:                log,
+		lsEventRecorder:    lsEventRecorder,
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
 	}
@@ -56,16 +62,19 @@ type deployer struct {
 	hostUncachedClient client.Client
 	hostCachedClient   client.Client
 	log                logging.Logger
+	lsEventRecorder    record.EventRecorder
 	config             manifestv1alpha2.Configuration
 	hooks              extension.ReconcileExtensionHooks
 }
 
-func (d *deployer) Reconcile(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
 	manifest, err := New(d.lsUncachedClient, d.hostUncachedClient, &d.config, di, rt)
 	if err != nil {
 		return err
 	}
 	manifest.SetLsRestConfig(d.lsRestConfig)
+	manifest.SetContext(lsCtx)
+	manifest.SetEventRecorder(d.lsEventRecorder)
 	return manifest.Reconcile(ctx)
 }
 
@@ -104,3 +113,21 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetectionSpec(di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error) {
+	manifest, err := New(d.lsUncachedClient, d.hostUncachedClient, &d.config, di, nil)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.ProviderConfiguration.DriftDetection, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) ([]driftdetection.ResourceDrift, error) {
+	manifest, err := New(d.lsUncachedClient, d.hostUncachedClient, &d.config, di, rt)
+	if err != nil {
+		return nil, err
+	}
+	manifest.SetLsRestConfig(d.lsRestConfig)
+	manifest.SetContext(lsCtx)
+	return manifest.DetectDrift(ctx)
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+)
+
+// DetectDrift compares the managed resources in the target cluster with the manifests of the deploy item.
+func (m *Manifest) DetectDrift(ctx context.Context) ([]driftdetection.ResourceDrift, error) {
+	currOp := "DetectDrift"
+
+	if m.ProviderStatus == nil || len(m.ProviderStatus.ManagedResources) == 0 {
+		return nil, nil
+	}
+
+	if err := m.ensureTargetAccess(ctx); err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "ensureTargetAccess", err.Error())
+	}
+
+	manifests, err := m.getManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := driftdetection.DecodeManifests(manifests)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DecodeManifests", err.Error())
+	}
+
+	return driftdetection.Detect(ctx, m.targetAccess.TargetClient(), m.ProviderStatus.ManagedResources, desired)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,6 +27,7 @@ import (
 	health "github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -53,6 +55,11 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		}
 	}
 
+	manifests, err := m.getManifests(ctx)
+	if err != nil {
+		return err
+	}
+
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
 		KubeClient:       m.targetAccess.TargetClient(),
@@ -60,12 +67,16 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		DeployItemName:   m.DeployItem.Name,
 		DeployItem:       m.DeployItem,
 		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
-		Manifests:        m.ProviderConfiguration.Manifests,
+		ServerSideApply:  m.ProviderConfiguration.ServerSideApply,
+		Manifests:        manifests,
 		ManagedResources: m.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
 			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
 		},
+		ApplyGroups:                m.ProviderConfiguration.ApplyGroups,
+		ReadinessCheck:             m.checkApplyGroupReady,
 		DeletionGroupsDuringUpdate: m.ProviderConfiguration.DeletionGroupsDuringUpdate,
+		PruneProtection:            m.ProviderConfiguration.PruneProtection,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
 		LsUncachedClient:           m.lsUncachedClient,
 		LsRestConfig:               m.lsRestConfig,
@@ -73,6 +84,11 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 
 	patchInfos, err := applier.Apply(ctx)
 	m.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
+	if protected := applier.GetProtectedResources(); len(protected) != 0 && m.lsEventRecorder != nil {
+		m.lsEventRecorder.Eventf(m.DeployItem, corev1.EventTypeWarning, lsutil.PruneProtectedEventReason,
+			"orphaned resources are protected and have not been deleted, they are no longer managed: %s",
+			resourcemanager.ProtectedResourcesMessage(protected))
+	}
 	if err != nil {
 		var err2 error
 		m.DeployItem.Status.ProviderStatus, err2 = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
@@ -152,6 +168,7 @@ func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client
 			ManagedResources:    managedresources,
 			FailOnMissingObject: true,
 			InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
+			Generic:             m.ProviderConfiguration.ReadinessChecks.Generic,
 		}
 		err := defaultReadinessCheck.CheckResourcesReady()
 		if err != nil {
@@ -187,6 +204,27 @@ func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client
 	return nil
 }
 
+// checkApplyGroupReady checks the readiness of the resources of an apply group with the default readiness check,
+// before the resources of the next apply group are applied.
+func (m *Manifest) checkApplyGroupReady(ctx context.Context, resources managedresource.ManagedResourceStatusList) error {
+	timeout, lserr := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestApplyGroupReadinessCheck)
+	if lserr != nil {
+		return lserr
+	}
+
+	defaultReadinessCheck := health.DefaultReadinessCheck{
+		Context:             ctx,
+		Client:              m.targetAccess.TargetClient(),
+		CurrentOp:           "CheckApplyGroupReadinessManifest",
+		Timeout:             &lsv1alpha1.Duration{Duration: timeout},
+		ManagedResources:    resources.TypedObjectReferenceList(),
+		FailOnMissingObject: true,
+		InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
+		Generic:             m.ProviderConfiguration.ReadinessChecks.Generic,
+	}
+	return defaultReadinessCheck.CheckResourcesReady()
+}
+
 func (m *Manifest) Delete(ctx context.Context) error {
 	return m.deleteManifestsInGroups(ctx)
 }
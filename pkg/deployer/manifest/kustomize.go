@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/kustomize"
+)
+
+const (
+	// kustomizationBaseDir is the directory in which the files of the kustomization base are stored.
+	kustomizationBaseDir = "base"
+)
+
+// getManifests returns the manifests of the provider configuration together with the resources
+// that result from the kustomization.
+func (m *Manifest) getManifests(ctx context.Context) ([]managedresource.Manifest, error) {
+	currOp := "GetManifests"
+
+	kustomization := m.ProviderConfiguration.Kustomize
+	if kustomization == nil {
+		return m.ProviderConfiguration.Manifests, nil
+	}
+
+	files := map[string][]byte{}
+	if kustomization.ResourceRef != nil {
+		var err error
+		files, err = m.readKustomizationResource(ctx, kustomization.ResourceRef)
+		if err != nil {
+			msg := fmt.Sprintf("unable to read kustomization resource %q: %s", kustomization.ResourceRef.ResourceName, err.Error())
+			return nil, lserrors.NewWrappedError(err, currOp, "ReadKustomizationResource", msg)
+		}
+	}
+	for name, content := range kustomization.Files {
+		files[name] = []byte(content)
+	}
+
+	built, err := BuildKustomization(files, kustomization)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "BuildKustomization", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	manifests := make([]managedresource.Manifest, 0, len(m.ProviderConfiguration.Manifests)+len(built))
+	manifests = append(manifests, m.ProviderConfiguration.Manifests...)
+	return append(manifests, built...), nil
+}
+
+// BuildKustomization builds the given kustomization with the files of its base, and returns the resulting resources
+// as manifests with the policy of the kustomization.
+// The base must contain a kustomization file in the directory that is defined by the path of the kustomization.
+func BuildKustomization(files map[string][]byte, kustomization *manifestv1alpha2.Kustomization) ([]managedresource.Manifest, error) {
+	fs := filesys.MakeFsInMemory()
+	for name, content := range files {
+		filePath := filepath.Join("/", kustomizationBaseDir, filepath.Join("/", name))
+		if err := fs.MkdirAll(filepath.Dir(filePath)); err != nil {
+			return nil, fmt.Errorf("unable to create directory for file %q: %w", name, err)
+		}
+		if err := fs.WriteFile(filePath, content); err != nil {
+			return nil, fmt.Errorf("unable to write file %q: %w", name, err)
+		}
+	}
+
+	overlay := kustomize.NewKustomization(
+		[]string{path.Join(kustomizationBaseDir, filepath.ToSlash(kustomization.Path))}, kustomization.Patches)
+	resMap, err := kustomize.Build(fs, overlay)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build kustomization: %w", err)
+	}
+
+	policy := kustomization.Policy
+	if len(policy) == 0 {
+		policy = managedresource.ManagePolicy
+	}
+
+	manifests := make([]managedresource.Manifest, 0, resMap.Size())
+	for _, res := range resMap.Resources() {
+		raw, err := res.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode resource %s: %w", res.CurId().String(), err)
+		}
+		manifests = append(manifests, managedresource.Manifest{
+			Policy:   policy,
+			Manifest: &runtime.RawExtension{Raw: raw},
+		})
+	}
+	return manifests, nil
+}
+
+// readKustomizationResource reads the files of a kustomization resource of a component version.
+func (m *Manifest) readKustomizationResource(ctx context.Context, ref *manifestv1alpha2.KustomizationResourceReference) (map[string][]byte, error) {
+	content, err := lib.GetComponentResourceContent(ctx, m.lsUncachedClient, m.Context, m.Configuration.OCI,
+		&ref.ComponentDescriptorDefinition, ref.ResourceName, mediatype.KustomizationType)
+	if err != nil {
+		return nil, err
+	}
+	fs, ok := content.(vfs.FileSystem)
+	if !ok {
+		return nil, fmt.Errorf("received content of type %T but expected a filesystem", content)
+	}
+	return readFiles(fs)
+}
+
+// readFiles reads all regular files of a filesystem by their path.
+func readFiles(fs vfs.FileSystem) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := vfs.Walk(fs, "/", func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		content, err := vfs.ReadFile(fs, filePath)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q: %w", filePath, err)
+		}
+		files[filePath] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/kustomize"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/deployer/manifest"
+)
+
+var _ = Describe("Kustomization", func() {
+
+	const (
+		baseKustomization = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: example
+resources:
+- configmap.yaml
+- secret.yaml
+`
+		configMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: value
+`
+		secret = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+  labels:
+    app: example
+stringData:
+  password: secret
+`
+	)
+
+	decode := func(manifests []managedresource.Manifest) []*unstructured.Unstructured {
+		objects := make([]*unstructured.Unstructured, 0, len(manifests))
+		for _, m := range manifests {
+			obj := &unstructured.Unstructured{}
+			Expect(json.Unmarshal(m.Manifest.Raw, &obj.Object)).To(Succeed())
+			objects = append(objects, obj)
+		}
+		return objects
+	}
+
+	baseFiles := func() map[string][]byte {
+		return map[string][]byte{
+			"/kustomization.yaml": []byte(baseKustomization),
+			"/configmap.yaml":     []byte(configMap),
+			"/secret.yaml":        []byte(secret),
+		}
+	}
+
+	It("should build the resources of the base with the default policy", func() {
+		manifests, err := manifest.BuildKustomization(baseFiles(), &manifestv1alpha2.Kustomization{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(2))
+		Expect(manifests[0].Policy).To(Equal(managedresource.ManagePolicy))
+
+		objects := decode(manifests)
+		Expect(objects[0].GetKind()).To(Equal("ConfigMap"))
+		Expect(objects[0].GetNamespace()).To(Equal("example"))
+		Expect(objects[1].GetKind()).To(Equal("Secret"))
+		Expect(objects[1].GetNamespace()).To(Equal("example"))
+	})
+
+	It("should build a base in a sub directory", func() {
+		files := map[string][]byte{}
+		for name, content := range baseFiles() {
+			files["app/"+name] = content
+		}
+
+		manifests, err := manifest.BuildKustomization(files, &manifestv1alpha2.Kustomization{
+			Path:   "app",
+			Policy: managedresource.KeepPolicy,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(2))
+		Expect(manifests[1].Policy).To(Equal(managedresource.KeepPolicy))
+	})
+
+	It("should apply strategic merge patches and json patches", func() {
+		manifests, err := manifest.BuildKustomization(baseFiles(), &manifestv1alpha2.Kustomization{
+			Patches: []kustomize.Patch{
+				{
+					Patch: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: patched
+`,
+				},
+				{
+					Patch: `[{"op": "add", "path": "/metadata/annotations", "value": {"patched": "true"}}]`,
+					Target: &kustomize.PatchTarget{
+						Kind:          "Secret",
+						LabelSelector: "app=example",
+					},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		objects := decode(manifests)
+		Expect(objects[0].Object["data"]).To(HaveKeyWithValue("key", "patched"))
+		Expect(objects[1].GetAnnotations()).To(HaveKeyWithValue("patched", "true"))
+	})
+
+	It("should fail if the base contains no kustomization file", func() {
+		_, err := manifest.BuildKustomization(map[string][]byte{
+			"/configmap.yaml": []byte(configMap),
+		}, &manifestv1alpha2.Kustomization{})
+		Expect(err).To(HaveOccurred())
+	})
+
+})
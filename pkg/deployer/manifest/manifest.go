@@ -9,6 +9,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -37,9 +38,11 @@ type Manifest struct {
 	lsRestConfig       *rest.Config
 	lsUncachedClient   client.Client
 	hostUncachedClient client.Client
+	lsEventRecorder    record.EventRecorder
 
 	Configuration *manifestv1alpha2.Configuration
 
+	Context               *lsv1alpha1.Context
 	DeployItem            *lsv1alpha1.DeployItem
 	Target                *lsv1alpha1.ResolvedTarget
 	ProviderConfiguration *manifestv1alpha2.ProviderConfiguration
@@ -98,6 +101,16 @@ func (m *Manifest) SetLsRestConfig(lsRestConfig *rest.Config) {
 	m.lsRestConfig = lsRestConfig
 }
 
+// SetContext sets the landscaper context of the deploy item, which is required to read component resources.
+func (m *Manifest) SetContext(lsCtx *lsv1alpha1.Context) {
+	m.Context = lsCtx
+}
+
+// SetEventRecorder sets the recorder for the events of the deploy item.
+func (m *Manifest) SetEventRecorder(lsEventRecorder record.EventRecorder) {
+	m.lsEventRecorder = lsEventRecorder
+}
+
 func (m *Manifest) ensureTargetAccess(ctx context.Context) (err error) {
 	if m.targetAccess == nil {
 		m.targetAccess, err = lib.NewTargetAccess(ctx, m.Target, m.lsUncachedClient, m.lsRestConfig)
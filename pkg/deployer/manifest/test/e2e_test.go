@@ -54,6 +54,7 @@ var _ = Describe("Manifest Deployer", func() {
 
 		deployer, err := manifestctlr.NewDeployer(nil, testenv.Client, testenv.Client, testenv.Client, testenv.Client,
 			logging.Discard(),
+			record.NewFakeRecorder(1024),
 			manifestv1alpha2.Configuration{},
 		)
 		Expect(err).ToNot(HaveOccurred())
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -24,7 +25,7 @@ import (
 // the controller marks the deploy item as failed.
 // pickupTimeout is a string containing the pickup timeout duration, either as 'none' or as a duration that can be parsed by time.ParseDuration.
 func NewController(lsUncachedClient, lsCachedClient client.Client,
-	logger logging.Logger, scheme *runtime.Scheme, pickupTimeout *lscore.Duration,
+	logger logging.Logger, scheme *runtime.Scheme, eventRecorder record.EventRecorder, pickupTimeout *lscore.Duration,
 	maxNumberOfWorkers int) (reconcile.Reconciler, error) {
 
 	wc := utils.NewWorkerCounter(maxNumberOfWorkers)
@@ -34,6 +35,7 @@ func NewController(lsUncachedClient, lsCachedClient client.Client,
 		lsCachedClient:   lsCachedClient,
 		log:              logger,
 		scheme:           scheme,
+		eventRecorder:    eventRecorder,
 		workerCounter:    wc,
 	}
 
@@ -54,6 +56,7 @@ type controller struct {
 	lsCachedClient   client.Client
 	log              logging.Logger
 	scheme           *runtime.Scheme
+	eventRecorder    record.EventRecorder
 	pickupTimeout    time.Duration
 	workerCounter    *utils.WorkerCounter
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -18,6 +19,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -54,6 +56,11 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.IsSuspended(di.ObjectMeta) {
+		logger.Debug("deploy item is suspended, pickup timeout is not checked")
+		return reconcile.Result{}, nil
+	}
+
 	if HasBeenPickedUp(di) || con.pickupTimeout == 0 {
 		// deploy item has been picked up, or the pickup check is deactivated
 		return reconcile.Result{}, nil
@@ -94,6 +101,11 @@ func (con *controller) isPickupTimeoutExceeded(di *lsv1alpha1.DeployItem) (bool,
 	if di.Status.JobIDGenerationTime != nil {
 		waitingForPickupDuration = time.Since(di.Status.JobIDGenerationTime.Time)
 	}
+	if cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.SuspendedCondition); cond != nil &&
+		cond.Status == lsv1alpha1.ConditionFalse && time.Since(cond.LastTransitionTime.Time) < waitingForPickupDuration {
+		// a resumed deploy item waits for its pickup since it was resumed
+		waitingForPickupDuration = time.Since(cond.LastTransitionTime.Time)
+	}
 	if waitingForPickupDuration >= con.pickupTimeout {
 		return true, nil
 	}
@@ -131,5 +143,9 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		return err
 	}
 
+	metrics.RecordDeployItemTimeout(di, metrics.TimeoutPickup)
+	metrics.RecordDeployItemPhase(di)
+	con.eventRecorder.Event(di, corev1.EventTypeWarning, lsutil.PickupTimeoutEventReason, di.Status.GetLastError().Message)
+
 	return nil
 }
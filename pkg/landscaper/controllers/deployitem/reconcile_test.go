@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -33,7 +34,7 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		var err error
 
 		deployItemController, err = dictrl.NewController(testenv.Client, testenv.Client, logging.Discard(), api.LandscaperScheme,
-			&testPickupTimeoutDuration, 1000)
+			record.NewFakeRecorder(1024), &testPickupTimeoutDuration, 1000)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// AddControllersToManager adds the controllers that aggregate the Drifted condition of deploy items
+// to their executions and installations.
+// The controllers only watch the metadata of the objects and react on changes of the drifted label.
+func AddControllersToManager(lsUncachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	execLog := logger.Reconciles("driftExecution", "Execution")
+	if err := builder.ControllerManagedBy(lsMgr).
+		Named("drift-execution").
+		Watches(&lsv1alpha1.DeployItem{}, handler.EnqueueRequestsFromMapFunc(mapDeployItemToExecution),
+			builder.OnlyMetadata, builder.WithPredicates(driftedLabelChangedPredicate())).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return execLog.Logr() }).
+		Complete(NewExecutionController(lsUncachedClient, execLog)); err != nil {
+		return err
+	}
+
+	instLog := logger.Reconciles("driftInstallation", "Installation")
+	return builder.ControllerManagedBy(lsMgr).
+		Named("drift-installation").
+		Watches(&lsv1alpha1.Execution{}, handler.EnqueueRequestsFromMapFunc(mapExecutionToInstallation),
+			builder.OnlyMetadata, builder.WithPredicates(driftedLabelChangedPredicate())).
+		Watches(&lsv1alpha1.Installation{}, handler.EnqueueRequestsFromMapFunc(mapInstallationToParent),
+			builder.OnlyMetadata, builder.WithPredicates(driftedLabelChangedPredicate())).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return instLog.Logr() }).
+		Complete(NewInstallationController(lsUncachedClient, instLog))
+}
+
+// driftedLabelChangedPredicate only accepts events that change whether an object is drifted.
+func driftedLabelChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isDrifted(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isDrifted(e.ObjectOld) != isDrifted(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isDrifted(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func isDrifted(obj client.Object) bool {
+	return obj.GetLabels()[lsv1alpha1.DriftedLabel] == "true"
+}
+
+func mapDeployItemToExecution(_ context.Context, obj client.Object) []reconcile.Request {
+	execName, ok := obj.GetLabels()[lsv1alpha1.ExecutionManagedByLabel]
+	if !ok || len(execName) == 0 {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: execName}}}
+}
+
+func mapExecutionToInstallation(_ context.Context, obj client.Object) []reconcile.Request {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != utils.InstallationKind {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: owner.Name}}}
+}
+
+func mapInstallationToParent(_ context.Context, obj client.Object) []reconcile.Request {
+	parentName, ok := obj.GetLabels()[lsv1alpha1.EncompassedByLabel]
+	if !ok || len(parentName) == 0 {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: parentName}}}
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// ChildrenDriftedReason is the reason of an aggregated Drifted condition with status true.
+	ChildrenDriftedReason = "ChildrenDrifted"
+	// NoDriftReason is the reason of an aggregated Drifted condition with status false.
+	NoDriftReason = "NoDrift"
+)
+
+// NewExecutionController creates a controller that aggregates the drift of deploy items to their execution.
+func NewExecutionController(lsUncachedClient client.Client, log logging.Logger) reconcile.Reconciler {
+	return &executionController{
+		lsUncachedClient: lsUncachedClient,
+		log:              log,
+	}
+}
+
+type executionController struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+}
+
+func (c *executionController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	exec := &lsv1alpha1.Execution{}
+	if err := read_write_layer.GetExecution(ctx, c.lsUncachedClient, req.NamespacedName, exec, read_write_layer.R000117); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	diList := &metav1.PartialObjectMetadataList{}
+	diList.SetGroupVersionKind(lsutil.DeployItemGVK)
+	if err := read_write_layer.ListMetaData(ctx, c.lsUncachedClient, diList, read_write_layer.R000118,
+		client.InNamespace(exec.Namespace), client.MatchingLabels{lsv1alpha1.ExecutionManagedByLabel: exec.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	drifted := []string{}
+	for i := range diList.Items {
+		if lsv1alpha1helper.IsDrifted(diList.Items[i].ObjectMeta) {
+			drifted = append(drifted, "deploy item "+diList.Items[i].Name)
+		}
+	}
+
+	writer := read_write_layer.NewWriter(c.lsUncachedClient)
+	if updateDriftedLabel(&exec.ObjectMeta, len(drifted) != 0) {
+		if err := writer.UpdateExecution(ctx, read_write_layer.W000167, exec); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	conditions, changed := updateDriftedCondition(exec.Status.Conditions, drifted)
+	if changed {
+		exec.Status.Conditions = conditions
+		if err := writer.UpdateExecutionStatus(ctx, read_write_layer.W000168, exec); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// NewInstallationController creates a controller that aggregates the drift of executions and subinstallations
+// to their installation.
+func NewInstallationController(lsUncachedClient client.Client, log logging.Logger) reconcile.Reconciler {
+	return &installationController{
+		lsUncachedClient: lsUncachedClient,
+		log:              log,
+	}
+}
+
+type installationController struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+}
+
+func (c *installationController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := c.log.StartReconcile(req)
+	ctx = logging.NewContext(ctx, logger)
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, c.lsUncachedClient, req.NamespacedName, inst, read_write_layer.R000119); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	drifted := []string{}
+
+	if inst.Status.ExecutionReference != nil {
+		execMetadata := lsutil.EmptyExecutionMetadata()
+		if err := read_write_layer.GetMetaData(ctx, c.lsUncachedClient, inst.Status.ExecutionReference.NamespacedName(),
+			execMetadata, read_write_layer.R000120); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, err
+		} else if err == nil && lsv1alpha1helper.IsDrifted(execMetadata.ObjectMeta) {
+			drifted = append(drifted, "execution "+execMetadata.Name)
+		}
+	}
+
+	subInstList := &metav1.PartialObjectMetadataList{}
+	subInstList.SetGroupVersionKind(lsutil.InstallationGVK)
+	if err := read_write_layer.ListMetaData(ctx, c.lsUncachedClient, subInstList, read_write_layer.R000121,
+		client.InNamespace(inst.Namespace), client.MatchingLabels{lsv1alpha1.EncompassedByLabel: inst.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+	for i := range subInstList.Items {
+		if lsv1alpha1helper.IsDrifted(subInstList.Items[i].ObjectMeta) {
+			drifted = append(drifted, "installation "+subInstList.Items[i].Name)
+		}
+	}
+
+	writer := read_write_layer.NewWriter(c.lsUncachedClient)
+	if updateDriftedLabel(&inst.ObjectMeta, len(drifted) != 0) {
+		if err := writer.UpdateInstallation(ctx, read_write_layer.W000169, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	conditions, changed := updateDriftedCondition(inst.Status.Conditions, drifted)
+	if changed {
+		inst.Status.Conditions = conditions
+		if err := writer.UpdateInstallationStatus(ctx, read_write_layer.W000170, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// updateDriftedLabel sets or removes the drifted label. It returns whether the label has changed.
+func updateDriftedLabel(obj *metav1.ObjectMeta, drifted bool) bool {
+	if lsv1alpha1helper.IsDrifted(*obj) == drifted {
+		return false
+	}
+	if drifted {
+		metav1.SetMetaDataLabel(obj, lsv1alpha1.DriftedLabel, "true")
+	} else {
+		delete(obj.Labels, lsv1alpha1.DriftedLabel)
+	}
+	return true
+}
+
+// updateDriftedCondition computes the Drifted condition from the list of drifted children.
+// A condition with status false is only set if the object already has a Drifted condition.
+// It returns the updated conditions and whether the condition has changed.
+func updateDriftedCondition(conditions []lsv1alpha1.Condition, drifted []string) ([]lsv1alpha1.Condition, bool) {
+	oldCondition := lsv1alpha1helper.GetCondition(conditions, lsv1alpha1.DriftedCondition)
+	if oldCondition == nil && len(drifted) == 0 {
+		return conditions, false
+	}
+
+	newCondition := lsv1alpha1helper.GetOrInitCondition(conditions, lsv1alpha1.DriftedCondition)
+	if len(drifted) == 0 {
+		newCondition = lsv1alpha1helper.UpdatedCondition(newCondition, lsv1alpha1.ConditionFalse, NoDriftReason,
+			"the deployed resources match their desired state")
+	} else {
+		sort.Strings(drifted)
+		newCondition = lsv1alpha1helper.UpdatedCondition(newCondition, lsv1alpha1.ConditionTrue, ChildrenDriftedReason,
+			fmt.Sprintf("drifted: %s", strings.Join(drifted, ", ")))
+	}
+
+	if oldCondition != nil && reflect.DeepEqual(*oldCondition, newCondition) {
+		return conditions, false
+	}
+	return lsv1alpha1helper.MergeConditions(conditions, newCondition), true
+}
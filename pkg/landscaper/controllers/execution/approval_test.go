@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Approval", func() {
+
+	var (
+		ctx   context.Context
+		ctrl  reconcile.Reconciler
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		ctrl, err = execution.NewController(testenv.Client, testenv.Client, testenv.Client, testenv.Client, logging.Discard(), api.Scheme,
+			record.NewFakeRecorder(1024), 1000, false, "exec-approval-test-"+testutils.GetNextCounter())
+		Expect(err).ToNot(HaveOccurred())
+		state, err = testenv.InitState(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+	})
+
+	It("should wait for the approval of the current job before it starts a deploy item that requires an approval", func() {
+		exec := &lsv1alpha1.Execution{}
+		exec.GenerateName = "test-"
+		exec.Namespace = state.Namespace
+		exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+			{
+				Name: "def",
+				Type: "test-type",
+				Configuration: &runtime.RawExtension{
+					Raw: []byte(`
+{
+  "apiVersion": "sometest",
+  "kind": "somekind"
+}
+`),
+				},
+				RequiresApproval: true,
+			},
+		}
+		Expect(state.Create(ctx, exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(testutils.UpdateJobIdForExecution(ctx, testenv, exec)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.WaitingForApproval))
+		Expect(exec.Status.Approval).To(BeNil())
+
+		// the deploy item is created, but not started
+		items := &lsv1alpha1.DeployItemList{}
+		testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+		Expect(items.Items).To(HaveLen(1))
+		di := &items.Items[0]
+		Expect(di.Status.GetJobID()).ToNot(Equal(exec.Status.JobID))
+
+		// the execution remains waiting without an approval
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.WaitingForApproval))
+
+		// approve the current job
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.ApprovedByAnnotation, "jane.doe")
+		Expect(state.Client.Update(ctx, exec)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Annotations).ToNot(HaveKey(lsv1alpha1.ApprovedByAnnotation))
+		Expect(exec.Status.Approval).ToNot(BeNil())
+		Expect(exec.Status.Approval.JobID).To(Equal(exec.Status.JobID))
+		Expect(exec.Status.Approval.ApprovedBy).To(Equal("jane.doe"))
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.Progressing))
+
+		// the deploy item is started
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(di), di)).To(Succeed())
+		Expect(di.Status.GetJobID()).To(Equal(exec.Status.JobID))
+	})
+})
@@ -8,6 +8,7 @@ import (
 
 func isExecFinished(exec *lsv1alpha1.Execution) bool {
 	if needsFinalizer(exec) ||
+		isSuspensionChanged(exec) ||
 		hasInterruptOperation(exec) ||
 		isDifferentJobIDs(exec) {
 		return false
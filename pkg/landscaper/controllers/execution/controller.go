@@ -7,6 +7,7 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,6 +25,8 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
@@ -185,6 +188,11 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveExecutionReconcile(exec, time.Since(startTime))
+	}()
+
 	if needsFinalizer(exec) {
 		controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
 		if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000086, exec); err != nil {
@@ -192,6 +200,16 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
+	if isSuspended(exec) || isSuspensionChanged(exec) {
+		if err := c.handleSuspension(ctx, exec); err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+		if isSuspended(exec) {
+			logger.Info("execution is suspended")
+			return reconcile.Result{}, nil
+		}
+	}
+
 	if hasInterruptOperation(exec) {
 		if err := c.handleInterruptOperation(ctx, exec); err != nil {
 			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -203,6 +221,13 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		// Execution is unfinished
 
 		err := c.handleReconcilePhase(ctx, exec)
+		if requeueAfter := rollout.RequeueAfter(exec.Status.Rollouts, time.Now()); requeueAfter > 0 &&
+			exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Progressing &&
+			(err == nil || lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorUnfinished)) {
+			// a rollout waits for the pause between two waves
+			logger.Info("waiting for the next wave of a rollout", "requeueAfter", requeueAfter.String())
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
 		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
 		// Execution is finished; nothing to do
@@ -226,7 +251,9 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 
 		exec.Status.DeployItemCache = nil
+		exec.Status.Rollouts = nil
 
+		oldPhase := exec.Status.ExecutionPhase
 		if exec.DeletionTimestamp.IsZero() {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Init
 		} else {
@@ -242,6 +269,14 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000105, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
+		metrics.RecordExecutionPhase(exec)
+		lsutil.RecordPhaseChangedEvent(c.eventRecorder, exec, oldPhase, exec.Status.ExecutionPhase)
+	}
+
+	if exec.DeletionTimestamp.IsZero() {
+		if err := c.handleApproval(ctx, exec); err != nil {
+			return err
+		}
 	}
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Init {
@@ -259,16 +294,31 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 	}
 
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval {
+		if !lsv1alpha1helper.IsApproved(exec.Status.Approval, exec.Status.JobID) {
+			// remain waiting until the current job is approved with the approved-by annotation
+			return nil
+		}
+
+		if err := c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Progressing, nil, read_write_layer.W000157); err != nil {
+			return err
+		}
+	}
+
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Progressing {
 		deployItemClassification, err := c.handlePhaseProgressing(ctx, exec)
 		if err != nil {
 			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000133)
 		}
 
-		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
+		if deployItemClassification.IsFailed() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000134)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if deployItemClassification.IsWaitingForApproval() {
+			// the remaining items require an approval of the current job
+			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.WaitingForApproval, nil, read_write_layer.W000158)
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasWaitingItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000135)
 		} else if !deployItemClassification.AllSucceeded() {
@@ -336,6 +386,32 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 	return nil
 }
 
+// handleApproval records the approval of the current job, if the approved-by annotation is set, and removes the annotation.
+func (c *controller) handleApproval(ctx context.Context, exec *lsv1alpha1.Execution) lserrors.LsError {
+	op := "handleApproval"
+
+	approver := lsv1alpha1helper.GetApprover(exec.ObjectMeta)
+	if len(approver) == 0 {
+		return nil
+	}
+
+	delete(exec.Annotations, lsv1alpha1.ApprovedByAnnotation)
+	if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000159, exec); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateExecution", err.Error())
+	}
+
+	exec.Status.Approval = &lsv1alpha1.ApprovalStatus{
+		JobID:        exec.Status.JobID,
+		ApprovedBy:   approver,
+		ApprovalTime: metav1.Now(),
+	}
+	if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000160, exec); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+	}
+
+	return nil
+}
+
 func (c *controller) handlePhaseInit(ctx context.Context, exec *lsv1alpha1.Execution, deployItemCache *lsv1alpha1.DeployItemCache) lserrors.LsError {
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
@@ -455,7 +531,9 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 
 	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, lsErr)
 
-	if phase != exec.Status.ExecutionPhase {
+	oldPhase := exec.Status.ExecutionPhase
+	phaseChanged := phase != oldPhase
+	if phaseChanged {
 		now := metav1.Now()
 		exec.Status.PhaseTransitionTime = &now
 	}
@@ -483,8 +561,15 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		if lsErr == nil {
 			return lserrors.NewWrappedError(err, "setExecutionPhaseAndUpdate", "UpdateExecutionStatus", err.Error())
 		}
-	} else if isExecFinished(exec) {
-		c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+	} else {
+		if phaseChanged {
+			metrics.RecordExecutionPhase(exec)
+			lsutil.RecordPhaseChangedEvent(c.eventRecorder, exec, oldPhase, phase)
+		}
+
+		if isExecFinished(exec) {
+			c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+		}
 	}
 
 	return lsErr
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// isSuspended returns whether the processing of the execution is suspended, because its installation is suspended.
+func isSuspended(exec *lsv1alpha1.Execution) bool {
+	return lsv1alpha1helper.IsSuspended(exec.ObjectMeta)
+}
+
+// isSuspensionChanged returns whether the suspension of the execution differs from its Suspended condition,
+// i.e. whether it has not yet been propagated to the deploy items of the execution.
+func isSuspensionChanged(exec *lsv1alpha1.Execution) bool {
+	return isSuspended(exec) != lsutil.IsSuspendedConditionTrue(exec.Status.Conditions)
+}
+
+// handleSuspension propagates the suspension of the execution to its deploy items by setting or removing the
+// suspended label and the Suspended condition, and updates the Suspended condition of the execution.
+func (c *controller) handleSuspension(ctx context.Context, exec *lsv1alpha1.Execution) error {
+	suspended := isSuspended(exec)
+
+	managedItems, err := read_write_layer.ListManagedDeployItems(ctx, c.lsUncachedClient, client.ObjectKeyFromObject(exec),
+		read_write_layer.R000123)
+	if err != nil {
+		return err
+	}
+
+	for i := range managedItems.Items {
+		item := &managedItems.Items[i]
+		if suspended {
+			// The label is set before the condition, so that deployers and the pickup timeout check ignore the
+			// deploy item from now on.
+			if err := c.updateDeployItemSuspendedLabel(ctx, item, suspended); err != nil {
+				return err
+			}
+			if err := c.updateDeployItemSuspendedCondition(ctx, item, suspended); err != nil {
+				return err
+			}
+		} else {
+			// The condition is updated before the label is removed, so that the pickup timeout of the deploy item
+			// is measured from the time when it was resumed.
+			if err := c.updateDeployItemSuspendedCondition(ctx, item, suspended); err != nil {
+				return err
+			}
+			if err := c.updateDeployItemSuspendedLabel(ctx, item, suspended); err != nil {
+				return err
+			}
+		}
+	}
+
+	conditions, changed := lsutil.UpdateSuspendedCondition(exec.Status.Conditions, suspended)
+	if changed {
+		exec.Status.Conditions = conditions
+		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000176, exec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) updateDeployItemSuspendedLabel(ctx context.Context, item *lsv1alpha1.DeployItem, suspended bool) error {
+	if !lsutil.SetSuspendedLabel(&item.ObjectMeta, suspended) {
+		return nil
+	}
+	return c.Writer().UpdateDeployItem(ctx, read_write_layer.W000174, item)
+}
+
+func (c *controller) updateDeployItemSuspendedCondition(ctx context.Context, item *lsv1alpha1.DeployItem, suspended bool) error {
+	conditions, changed := lsutil.UpdateSuspendedCondition(item.Status.Conditions, suspended)
+	if !changed {
+		return nil
+	}
+	item.Status.Conditions = conditions
+	return c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000175, item)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleApproval records the approval of the current job, if the approved-by annotation is set, and removes the annotation.
+func (c *Controller) handleApproval(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	op := "handleApproval"
+
+	approver := lsv1alpha1helper.GetApprover(inst.ObjectMeta)
+	if len(approver) == 0 {
+		return nil
+	}
+
+	delete(inst.Annotations, lsv1alpha1.ApprovedByAnnotation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000161, inst); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateInstallation", err.Error())
+	}
+
+	inst.Status.Approval = &lsv1alpha1.ApprovalStatus{
+		JobID:        inst.Status.JobID,
+		ApprovedBy:   approver,
+		ApprovalTime: metav1.Now(),
+	}
+	if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000162, inst); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateInstallationStatus", err.Error())
+	}
+
+	return nil
+}
+
+// isWaitingForApproval checks whether the current job of an installation requires an approval that was not yet given.
+func isWaitingForApproval(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.RequiresApproval(inst.ObjectMeta) &&
+		!lsv1alpha1helper.IsApproved(inst.Status.Approval, inst.Status.JobID)
+}
+
+// isWaitingForSubobjectApproval checks whether an installation waits for the approval of its execution or of a
+// subinstallation. The subobjects of a job are only triggered after the installation itself has been approved, which
+// is indicated by the wait transition time of the job.
+func isWaitingForSubobjectApproval(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.WaitingForApproval &&
+		inst.Status.TransitionTimes != nil && inst.Status.TransitionTimes.WaitTime != nil
+}
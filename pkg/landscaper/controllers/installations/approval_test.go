@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Approval", func() {
+
+	var (
+		ctx     context.Context
+		ctrl    reconcile.Reconciler
+		state   *envtest.State
+		inst    *v1alpha1.Installation
+		exec    *v1alpha1.Execution
+		subInst *v1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op := lsoperation.NewOperation(api.LandscaperScheme, record.NewFakeRecorder(1024), testenv.Client)
+		ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client, *op,
+			logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{}, "test-approval-"+testutils.GetNextCounter())
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test15")
+		Expect(err).ToNot(HaveOccurred())
+		inst = state.Installations[state.Namespace+"/root"]
+		exec = state.Executions[state.Namespace+"/root"]
+		subInst = state.Installations[state.Namespace+"/subinst"]
+	})
+
+	AfterEach(func() {
+		Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+	})
+
+	getObjects := func() {
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(subInst), subInst)).To(Succeed())
+	}
+
+	It("should wait for the approval of the current job of a subinstallation", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subInst))
+
+		getObjects()
+		Expect(subInst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+		Expect(subInst.Status.Approval).To(BeNil())
+
+		// the subinstallation remains waiting without an approval
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subInst))
+
+		getObjects()
+		Expect(subInst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+
+		// approve the current job
+		metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, v1alpha1.ApprovedByAnnotation, "jane.doe")
+		Expect(state.Client.Update(ctx, subInst)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subInst))
+
+		getObjects()
+		Expect(subInst.Annotations).ToNot(HaveKey(v1alpha1.ApprovedByAnnotation))
+		Expect(subInst.Status.Approval).ToNot(BeNil())
+		Expect(subInst.Status.Approval.JobID).To(Equal("job2"))
+		Expect(subInst.Status.Approval.ApprovedBy).To(Equal("jane.doe"))
+		Expect(subInst.Status.InstallationPhase).ToNot(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+	})
+
+	It("should propagate the waiting for an approval of a subinstallation to the owning installation", func() {
+		exec.Status.ExecutionPhase = v1alpha1.ExecutionPhases.Progressing
+		Expect(state.Client.Status().Update(ctx, exec)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subInst))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(subInst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+		Expect(inst.Status.LastError).ToNot(BeNil())
+		Expect(inst.Status.LastError.Message).To(ContainSubstring("installation " + subInst.Namespace + " / subinst waits for an approval"))
+	})
+
+	It("should propagate the waiting for an approval of the execution to the owning installation", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+		Expect(inst.Status.LastError).ToNot(BeNil())
+		Expect(inst.Status.LastError.Message).To(ContainSubstring("execution " + exec.Namespace + " / root waits for an approval"))
+
+		// the installation does not restart its job, but remains waiting
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.WaitingForApproval))
+		Expect(inst.Status.JobID).To(Equal("job2"))
+
+		// the installation continues when the execution has been approved
+		exec.Status.ExecutionPhase = v1alpha1.ExecutionPhases.Progressing
+		Expect(state.Client.Status().Update(ctx, exec)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.Progressing))
+	})
+})
@@ -8,11 +8,15 @@ import (
 )
 
 func isInstFinished(inst *lsv1alpha1.Installation) bool {
-	if isAutomaticReconcileOnSpecChange(inst) ||
+	if isSuspensionChanged(inst) ||
+		isAutomaticReconcileOnSpecChange(inst) ||
 		isAutomaticReconcileConfigured(inst) ||
 		needsFinalizer(inst) ||
 		hasDependentsToTrigger(inst) ||
 		hasInterruptOperation(inst) ||
+		hasDryRunOperation(inst) ||
+		hasRollbackOperation(inst) ||
+		isRollbackOnFailureDue(inst) ||
 		isNotRootWithReconcileOperation(inst) ||
 		isCreateNewJobID(inst) ||
 		isDifferentJobIDs(inst) {
@@ -26,12 +30,14 @@ func isAutomaticReconcileOnSpecChange(inst *lsv1alpha1.Installation) bool {
 	return installations.IsRootInstallation(inst) &&
 		lsv1alpha1helper.HasReconcileIfChangedAnnotation(inst.ObjectMeta) &&
 		!lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
+		!hasDryRunOperation(inst) &&
+		!hasRollbackOperation(inst) &&
 		inst.Status.JobID == inst.Status.JobIDFinished &&
 		inst.GetGeneration() != inst.Status.ObservedGeneration
 }
 
 func isAutomaticReconcileConfigured(inst *lsv1alpha1.Installation) bool {
-	retryHelper := newRetryHelper(nil, nil)
+	retryHelper := newRetryHelper(nil, nil, nil)
 	return retryHelper.isRetryActivatedForSucceeded(inst) || retryHelper.isRetryActivatedForFailed(inst)
 }
 
@@ -48,6 +54,30 @@ func hasInterruptOperation(inst *lsv1alpha1.Installation) bool {
 	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.InterruptOperation)
 }
 
+func hasDryRunOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.DryRunOperation)
+}
+
+func hasRollbackOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.RollbackOperation)
+}
+
+// isRollbackOnFailureDue checks whether a failed root installation must be rolled back automatically.
+// An installation whose spec was restored by a rollback, or which was already handled by a rollback, is not
+// rolled back again.
+func isRollbackOnFailureDue(inst *lsv1alpha1.Installation) bool {
+	return installations.IsRootInstallation(inst) &&
+		inst.Spec.AutomaticReconcile != nil &&
+		inst.Spec.AutomaticReconcile.OnFailure == lsv1alpha1.OnFailureRollback &&
+		inst.DeletionTimestamp.IsZero() &&
+		inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Failed &&
+		inst.Status.JobID == inst.Status.JobIDFinished &&
+		inst.Status.ObservedGeneration == inst.GetGeneration() &&
+		len(lsv1alpha1helper.GetOperation(inst.ObjectMeta)) == 0 &&
+		(inst.Status.Rollback == nil ||
+			(inst.Status.Rollback.Generation != inst.GetGeneration() && inst.Status.Rollback.FromGeneration != inst.GetGeneration()))
+}
+
 func isNotRootWithReconcileOperation(inst *lsv1alpha1.Installation) bool {
 	return !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +33,8 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	utilscache "github.com/gardener/landscaper/pkg/utils/cache"
 	"github.com/gardener/landscaper/pkg/utils/lock"
@@ -228,6 +231,11 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveInstallationReconcile(inst, installations.GetRootInstallationName(inst), time.Since(startTime))
+	}()
+
 	return c.handleAutomaticReconcile(ctx, inst)
 }
 
@@ -252,13 +260,29 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 
 	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "handleAutomaticReconcile")
 
+	if isSuspended(inst) || isSuspensionChanged(inst) {
+		if err := c.handleSuspension(ctx, inst); err != nil {
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+		if isSuspended(inst) {
+			// a suspended installation is neither reconciled, nor retried, nor does it trigger its dependents
+			logger.Info("installation is suspended")
+			if !inst.DeletionTimestamp.IsZero() {
+				// the deletion is continued when the suspension is removed
+				c.EventRecorder().Event(inst, corev1.EventTypeWarning, utils.DeletionBlockedEventReason,
+					"deletion is blocked until the suspension of the installation is removed")
+			}
+			return reconcile.Result{}, nil
+		}
+	}
+
 	if isAutomaticReconcileOnSpecChange(inst) {
 		if err := c.addReconcileAnnotation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
-	retryHelper := newRetryHelper(c.LsUncachedClient(), c.clock)
+	retryHelper := newRetryHelper(c.LsUncachedClient(), c.clock, c.EventRecorder())
 
 	if err := retryHelper.preProcessRetry(ctx, inst); err != nil {
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -266,6 +290,13 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 
 	result, err := c.reconcileInstallation(ctx, inst)
 
+	if err == nil && isRollbackOnFailureDue(inst) {
+		rolledBack, err := c.handleRollbackOnFailure(ctx, inst)
+		if err != nil || rolledBack {
+			return reconcile.Result{}, err
+		}
+	}
+
 	result, err = retryHelper.recomputeRetry(ctx, inst, result, err)
 	if err != nil {
 		logger.Error(err, "recomputeRetry failed")
@@ -299,6 +330,21 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if hasDryRunOperation(inst) {
+		if err := c.handleDryRunOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if hasRollbackOperation(inst) && !isDifferentJobIDs(inst) {
+		// a rollback is postponed until the current job has finished
+		if err := c.handleRollbackOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if isNotRootWithReconcileOperation(inst) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -331,6 +377,13 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		ctx = octx.BindTo(ctx)
 
 		err := c.handleReconcilePhase(ctx, inst)
+		if requeueAfter := rollout.RequeueAfter(inst.Status.Rollouts, time.Now()); requeueAfter > 0 &&
+			inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Progressing &&
+			(err == nil || lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorUnfinished)) {
+			// a rollout of subinstallations waits for the pause between two waves
+			logger.Info("waiting for the next wave of a rollout", "requeueAfter", requeueAfter.String())
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
 		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
 		// job finished; nothing to do
@@ -380,6 +433,7 @@ func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Ins
 	blueprintCacheID := utilscache.NewBlueprintCacheID(inst)
 	intBlueprint, err := blueprints.Resolve(ctx, op.ComponentsRegistry(), lsCtx.External.ComponentDescriptorRef(), inst.Spec.Blueprint, blueprintCacheID)
 	if err != nil {
+		c.EventRecorder().Event(inst, corev1.EventTypeWarning, utils.BlueprintResolutionFailedEventReason, err.Error())
 		return nil, lserrors.NewWrappedError(err, currOp, "ResolveBlueprint", err.Error())
 	}
 
@@ -465,12 +519,15 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
 
-	if inst.Status.LastError != nil {
+	// import errors are already recorded with a dedicated event reason
+	if inst.Status.LastError != nil && len(importErrorEventReason(lsError)) == 0 {
 		lastErr := inst.Status.LastError
 		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
 	}
 
-	if phase != inst.Status.InstallationPhase {
+	oldPhase := inst.Status.InstallationPhase
+	phaseChanged := phase != oldPhase
+	if phaseChanged {
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
 	}
@@ -527,7 +584,14 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		}
 
 		return lsError
-	} else if isInstFinished(inst) {
+	}
+
+	if phaseChanged {
+		metrics.RecordInstallationPhase(inst, installations.GetRootInstallationName(inst))
+		utils.RecordPhaseChangedEvent(c.EventRecorder(), inst, oldPhase, phase)
+	}
+
+	if isInstFinished(inst) {
 		c.finishedObjectCache.AddSynchonized(&inst.ObjectMeta)
 	}
 
@@ -28,13 +28,15 @@ var _ = Describe("Installation Controller", func() {
 
 	Context("reconcile", func() {
 		var (
-			op    *lsoperation.Operation
-			ctrl  reconcile.Reconciler
-			state *envtest.State
+			op       *lsoperation.Operation
+			ctrl     reconcile.Reconciler
+			state    *envtest.State
+			recorder *record.FakeRecorder
 		)
 
 		BeforeEach(func() {
-			op = lsoperation.NewOperation(api.LandscaperScheme, record.NewFakeRecorder(1024), testenv.Client)
+			recorder = record.NewFakeRecorder(1024)
+			op = lsoperation.NewOperation(api.LandscaperScheme, recorder, testenv.Client)
 
 			ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client,
 				*op, logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{
@@ -146,6 +148,34 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		It("should emit a single event for an import that is not satisfied", func() {
+			// We consider an Installation whose blueprint defines an import that is not provided by the Installation.
+			// The failing reconciliation should emit an ImportsNotSatisfied event, but no additional event for
+			// the last error of the Installation.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+
+			// installation gets a new job id
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			// installation fails because of the missing import
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			events := []string{}
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement(HavePrefix("Warning ImportsNotSatisfied ")))
+			Expect(events).ToNot(ContainElement(HavePrefix("Warning ImportsSatisfied ")))
+		})
 	})
 
 })
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	goerrors "errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"ocm.software/ocm/api/datacontext"
+	"ocm.software/ocm/api/ocm"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleDryRunOperation resolves the imports of an installation and renders its deploy items without creating or
+// updating any objects. The result is written to the dry-run status of the installation.
+func (c *Controller) handleDryRunOperation(ctx context.Context, inst *lsv1alpha1.Installation) (err error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
+		lc.KeyMethod, "handleDryRunOperation")
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000150, inst); err != nil {
+		return err
+	}
+
+	octx := ocm.New(datacontext.MODE_EXTENDED)
+	defer func() {
+		err = goerrors.Join(err, octx.Finalize())
+	}()
+
+	dryRun := &lsv1alpha1.DryRunStatus{
+		ObservedGeneration: inst.GetGeneration(),
+		LastRunTime:        metav1.Now(),
+	}
+
+	// the dry-run works on a copy, because templating and import resolution modify the conditions of the installation
+	deployItems, lsErr := c.dryRun(octx.BindTo(ctx), inst.DeepCopy())
+	if lsErr != nil {
+		logger.Info("dry-run of installation failed", lc.KeyError, lsErr.Error())
+		dryRun.LastError = lserrors.TryUpdateLsError(nil, lsErr)
+	} else {
+		dryRun.DeployItems = deployItems
+	}
+
+	inst.Status.DryRun = dryRun
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000151, inst)
+}
+
+func (c *Controller) dryRun(ctx context.Context, inst *lsv1alpha1.Installation) ([]lsv1alpha1.DeployItemDryRunResult, lserrors.LsError) {
+	currOp := "DryRun"
+
+	instOp, imps, _, _, fatalError, normalError := c.init(ctx, inst, true)
+	if fatalError != nil {
+		return nil, fatalError
+	} else if normalError != nil {
+		return nil, normalError
+	}
+
+	constructor := imports.NewConstructor(instOp)
+	if err := constructor.Construct(ctx, imps); err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
+	}
+	if err := constructor.RenderImportExecutions(); err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "RenderImportExecutions", err.Error())
+	}
+
+	deployItems, err := executions.New(instOp).DryRun(ctx, instOp.Inst)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DryRunExecution", err.Error())
+	}
+
+	return deployItems, nil
+}
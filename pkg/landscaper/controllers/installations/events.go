@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+// recordImportErrorEvent emits an event if the error indicates that an import of the installation is not satisfied,
+// or that an import does not match its schema. Other errors are ignored.
+func (c *Controller) recordImportErrorEvent(inst *lsv1alpha1.Installation, err error) {
+	if reason := importErrorEventReason(err); len(reason) != 0 {
+		c.EventRecorder().Event(inst, corev1.EventTypeWarning, reason, err.Error())
+	}
+}
+
+// importErrorEventReason returns the reason of the event for an import error, which is either the error itself
+// or wrapped by the error. An empty reason is returned for other errors.
+func importErrorEventReason(err error) string {
+	for ; err != nil; err = errors.Unwrap(err) {
+		switch {
+		case installations.IsImportNotFoundError(err), installations.IsErrorForReason(err, installations.ImportNotSatisfied):
+			return lsutil.ImportsNotSatisfiedEventReason
+		case installations.IsSchemaValidationFailedError(err):
+			return lsutil.SchemaValidationFailedEventReason
+		}
+	}
+	return ""
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +25,8 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -43,12 +46,14 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		}
 
 		inst.Status.SubInstCache = nil
+		inst.Status.Rollouts = nil
 
 		nextPhase := lsv1alpha1.InstallationPhases.Init
 		if !inst.DeletionTimestamp.IsZero() {
 			nextPhase = lsv1alpha1.InstallationPhases.InitDelete
 		}
 
+		oldPhase := inst.Status.InstallationPhase
 		inst.Status.InstallationPhase = nextPhase
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
@@ -59,6 +64,31 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000115, inst); err != nil {
 			return lserrors.NewWrappedError(err, op, "InitialPhaseSetting", err.Error())
 		}
+		metrics.RecordInstallationPhase(inst, installations.GetRootInstallationName(inst))
+		lsutil.RecordPhaseChangedEvent(c.EventRecorder(), inst, oldPhase, nextPhase)
+	}
+
+	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Init ||
+		inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.WaitingForApproval {
+		if err := c.handleApproval(ctx, inst); err != nil {
+			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
+				read_write_layer.W000163, false)
+		}
+
+		if isWaitingForApproval(inst) {
+			if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.WaitingForApproval {
+				// remain waiting until the current job is approved with the approved-by annotation
+				return nil
+			}
+			return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhases.WaitingForApproval, nil,
+				read_write_layer.W000164, false)
+		}
+
+		if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.WaitingForApproval && !isWaitingForSubobjectApproval(inst) {
+			now := metav1.Now()
+			inst.Status.PhaseTransitionTime = &now
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Init
+		}
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Init {
@@ -120,11 +150,15 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		}
 	}
 
-	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Progressing {
-		allSucceeded, failedSubInsts, isExecFailed, err := c.handlePhaseProgressing(ctx, inst)
-		if err != nil {
+	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Progressing || isWaitingForSubobjectApproval(inst) {
+		allSucceeded, failedSubInsts, isExecFailed, waitingForApproval, err := c.handlePhaseProgressing(ctx, inst)
+		if waitingForApproval {
+			// an unfinished subobject waits for the approval of its current job
+			return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhases.WaitingForApproval, err,
+				read_write_layer.W000178, false)
+		} else if err != nil {
 			// error or unfinished subobjects => phase remains progressing
-			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
+			return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhases.Progressing, err,
 				read_write_layer.W000118, false)
 		}
 
@@ -145,7 +179,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Completing {
-		fatalError, normalError := c.handlePhaseCompleting(ctx, inst)
+		componentVersion, fatalError, normalError := c.handlePhaseCompleting(ctx, inst)
 
 		if fatalError != nil && !lsutil.IsRecoverableError(fatalError) {
 			return c.setInstallationPhaseAndUpdate(ctx, inst, lsv1alpha1.InstallationPhases.Failed, fatalError,
@@ -163,6 +197,13 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 			return err
 		}
 
+		if err := c.recordRevision(ctx, inst, componentVersion); err != nil {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Error(err, "unable to record revision of installation")
+			c.EventRecorder().Eventf(inst, corev1.EventTypeWarning, lsutil.RevisionFailedEventReason,
+				"unable to record revision of installation: %s", err.Error())
+		}
+
 		return nil
 	}
 
@@ -345,6 +386,7 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 
 	imps, err := rh.ImportsSatisfied(ctx)
 	if err != nil {
+		c.recordImportErrorEvent(inst, err)
 		fatalError = lserrors.NewWrappedError(err, currentOperation, "ImportsSatisfied", err.Error())
 		return nil, nil, "", nil, fatalError, nil
 	}
@@ -423,9 +465,13 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 		return lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
 	}
 
+	// subinstallations of a rollout are only triggered if they belong to the current wave
+	plan := getSubinstallationRolloutPlan(ctx, inst, subInsts)
+	inst.Status.Rollouts = plan.Status()
+
 	// trigger subinstallations
 	for _, next := range subInsts {
-		if next.Status.JobID != inst.Status.JobID {
+		if next.Status.JobID != inst.Status.JobID && plan.Decision(getSubinstallationRolloutName(next)) == rollout.Allowed {
 			next.Status.JobID = inst.Status.JobID
 			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
 			if err = c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
@@ -453,24 +499,57 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	return nil
 }
 
-func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha1.Installation) (allSucceeded bool, failedSubInstNames []string, executionFailed bool, lsErr lserrors.LsError) {
+func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha1.Installation) (allSucceeded bool, failedSubInstNames []string, executionFailed bool, waitingForApproval bool, lsErr lserrors.LsError) {
 	currentOperation := "handlePhaseProgressing"
 
 	allSucceeded = true
 
 	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000087)
 	if err != nil {
-		return false, nil, false, lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
+		return false, nil, false, false, lserrors.NewWrappedError(err, currentOperation, "ListSubinstallations", err.Error())
 	}
 
 	failedSubInstNames = []string{}
 
+	plan := getSubinstallationRolloutPlan(ctx, inst, subInsts)
+	inst.Status.Rollouts = plan.Status()
+
+	var unfinishedErr, approvalErr lserrors.LsError
 	for _, next := range subInsts {
+		if next.Status.JobID != inst.Status.JobID {
+			// the subinstallation belongs to a rollout and was not yet started
+			switch plan.Decision(getSubinstallationRolloutName(next)) {
+			case rollout.Allowed:
+				next.Status.JobID = inst.Status.JobID
+				next.Status.TransitionTimes = lsutil.NewTransitionTimes()
+				if err = c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000156, next); err != nil {
+					return false, nil, false, false, lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
+				}
+			case rollout.Stopped:
+				// the failure budget of the rollout is exceeded, so that the subinstallation is not started
+				allSucceeded = false
+				continue
+			}
+
+			message := fmt.Sprintf("installation %s / %s waits for its rollout wave or is not finished yet", next.Namespace, next.Name)
+			unfinishedErr = lserrors.NewError(currentOperation, "RolloutWave", message,
+				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			continue
+		}
+
 		if next.Status.JobIDFinished != next.Status.JobID {
 			// Hack: being unfinished should not be treated as an error
+			if next.Status.InstallationPhase == lsv1alpha1.InstallationPhases.WaitingForApproval {
+				message := fmt.Sprintf("installation %s / %s waits for an approval", next.Namespace, next.Name)
+				approvalErr = lserrors.NewError(currentOperation, "WaitingForApproval", message,
+					lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+				continue
+			}
+
 			message := fmt.Sprintf("installation %s / %s is not finished yet", next.Namespace, next.Name)
-			return false, nil, false, lserrors.NewError(currentOperation, "JobIDFinished", message,
+			unfinishedErr = lserrors.NewError(currentOperation, "JobIDFinished", message,
 				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			continue
 		}
 
 		allSucceeded = allSucceeded && (next.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded)
@@ -480,21 +559,37 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 		}
 	}
 
-	executionFailed = false
-
+	// the execution is checked even if some subinstallations are unfinished, because it might wait for an approval
+	var exec *lsv1alpha1.Execution
 	if inst.Status.ExecutionReference != nil {
 		key := client.ObjectKey{Namespace: inst.Status.ExecutionReference.Namespace, Name: inst.Status.ExecutionReference.Name}
-		exec := &lsv1alpha1.Execution{}
+		exec = &lsv1alpha1.Execution{}
 		if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), key, exec, read_write_layer.R000024); err != nil {
-			return false, nil, false, lserrors.NewWrappedError(err, currentOperation, "GetExecution", err.Error())
+			return false, nil, false, false, lserrors.NewWrappedError(err, currentOperation, "GetExecution", err.Error())
 		}
 
 		if exec.Status.JobIDFinished != exec.Status.JobID {
-			message := fmt.Sprintf("execution %s / %s is not finished yet", exec.Namespace, exec.Name)
-			return false, nil, false, lserrors.NewError(currentOperation, "JobIDFinished", message,
-				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.WaitingForApproval {
+				message := fmt.Sprintf("execution %s / %s waits for an approval", exec.Namespace, exec.Name)
+				approvalErr = lserrors.NewError(currentOperation, "WaitingForApproval", message,
+					lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			} else {
+				message := fmt.Sprintf("execution %s / %s is not finished yet", exec.Namespace, exec.Name)
+				unfinishedErr = lserrors.NewError(currentOperation, "JobIDFinished", message,
+					lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			}
 		}
+	}
 
+	if approvalErr != nil {
+		return false, nil, false, true, approvalErr
+	} else if unfinishedErr != nil {
+		return false, nil, false, false, unfinishedErr
+	}
+
+	executionFailed = false
+
+	if exec != nil {
 		allSucceeded = allSucceeded && (exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Succeeded)
 
 		if exec.Status.ExecutionPhase.IsFailed() {
@@ -502,58 +597,65 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 		}
 	}
 
-	return allSucceeded, failedSubInstNames, executionFailed, nil
+	return allSucceeded, failedSubInstNames, executionFailed, false, nil
 }
 
-func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1.Installation) (lserrors.LsError, lserrors.LsError) {
+// handlePhaseCompleting constructs the exports of the installation. It returns the version of the resolved component,
+// which is empty if the installation has no component.
+func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1.Installation) (string, lserrors.LsError, lserrors.LsError) {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
 	currentOperation := "handlePhaseCompleting"
 
 	newCleaner := NewDataObjectAndTargetCleaner(inst, c.LsUncachedClient())
 	if err := newCleaner.CleanupContext(ctx); err != nil {
-		return nil, lserrors.NewWrappedError(err, currentOperation, "CleanupContext", err.Error())
+		return "", nil, lserrors.NewWrappedError(err, currentOperation, "CleanupContext", err.Error())
 	}
 
 	instOp, imps, importsHash, _, fatalError, fatalError2 := c.init(ctx, inst, false)
 
 	if fatalError != nil {
-		return fatalError, nil
+		return "", fatalError, nil
 	} else if fatalError2 != nil {
-		return fatalError2, nil
+		return "", fatalError2, nil
 	}
 
 	if importsHash != inst.Status.ImportsHash {
 		logger.Info("changed hash", "oldHash", inst.Status.ImportsHash, "newHash", importsHash)
-		return lserrors.NewError(currentOperation, "CheckImportsHash", "imports have changed"), nil
+		return "", lserrors.NewError(currentOperation, "CheckImportsHash", "imports have changed"), nil
 	}
 
 	if inst.Generation != inst.Status.ObservedGeneration {
-		return lserrors.NewError(currentOperation, "CheckObservedGeneration", "installation spec has been changed", lsv1alpha1.ErrorForInfoOnly), nil
+		return "", lserrors.NewError(currentOperation, "CheckObservedGeneration", "installation spec has been changed", lsv1alpha1.ErrorForInfoOnly), nil
 	}
 
 	con := imports.NewConstructor(instOp)
 	err := con.Construct(ctx, imps)
 	if err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
+		c.recordImportErrorEvent(inst, err)
+		return "", lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
 	}
 	err = con.RenderImportExecutions()
 	if err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error()), nil
+		return "", lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error()), nil
 	}
 
 	dataExports, targetExports, err := exports.NewConstructor(instOp).Construct(ctx)
 	if err != nil {
-		return lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error()), nil
+		return "", lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error()), nil
 	}
 
 	if err := instOp.CreateOrUpdateExports(ctx, dataExports, targetExports); err != nil {
 		if apierrors.IsConflict(err) {
-			return nil, lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error())
+			return "", nil, lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error())
 		}
-		return lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error()), nil
+		return "", lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error()), nil
 	}
 
-	return nil, nil
+	componentVersion := ""
+	if instOp.ComponentVersion != nil {
+		componentVersion = instOp.ComponentVersion.GetVersion()
+	}
+	return componentVersion, nil, nil
 }
 
 func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *installations.Operation, imps *imports.Imports,
@@ -564,6 +666,7 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 	// collect and merge all imports and start the Executions
 	constructor := imports.NewConstructor(op)
 	if err := constructor.Construct(ctx, imps); err != nil {
+		c.recordImportErrorEvent(inst.GetInstallation(), err)
 		return lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
 	}
 	if err := constructor.RenderImportExecutions(); err != nil {
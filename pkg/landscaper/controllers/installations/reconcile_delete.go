@@ -10,6 +10,7 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -209,14 +210,14 @@ func (c *Controller) deleteAllowed(ctx context.Context, inst *lsv1alpha1.Install
 	}
 
 	// check if suitable for deletion
-	return checkIfSiblingImports(inst, installations.CreateInternalInstallationBases(siblings...))
+	return c.checkIfSiblingImports(inst, installations.CreateInternalInstallationBases(siblings...))
 }
 
 // checkIfSiblingImports checks if a sibling imports any of the installations exports.
-func checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
+func (c *Controller) checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 	for _, sibling := range siblings {
 		if inst.IsSuccessor(sibling.GetInstallation()) {
-			return checkSuccessorSibling(inst, sibling)
+			return c.checkSuccessorSibling(inst, sibling)
 		}
 	}
 
@@ -229,7 +230,7 @@ func checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installati
 //     This is achieved by a fatal error.
 //   - Otherwise, the existence of "sibling" means that "inst" cannot yet be deleted, but must be checked again later.
 //     This is achieved by a normal error.
-func checkSuccessorSibling(inst *lsv1alpha1.Installation,
+func (c *Controller) checkSuccessorSibling(inst *lsv1alpha1.Installation,
 	sibling *installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 
 	op := "CheckSuccessorSibling"
@@ -240,11 +241,15 @@ func checkSuccessorSibling(inst *lsv1alpha1.Installation,
 		err := lserrors.NewWrappedError(SiblingDeleteError, op, "SiblingDeleteError",
 			SiblingDeleteError.Error(), lsv1alpha1.ErrorForInfoOnly)
 
+		c.EventRecorder().Eventf(inst, corev1.EventTypeWarning, lsutil.DeletionBlockedEventReason,
+			"deletion failed, because the deletion of the successor sibling %s failed", sibling.GetInstallation().Name)
 		return err, nil
 	}
 
 	err := lserrors.NewWrappedError(SiblingImportError, op, "SiblingImport", SiblingImportError.Error(),
 		lsv1alpha1.ErrorForInfoOnly)
 
+	c.EventRecorder().Eventf(inst, corev1.EventTypeNormal, lsutil.DeletionBlockedEventReason,
+		"deletion waits for the successor sibling %s, which imports exports of this installation", sibling.GetInstallation().Name)
 	return nil, err
 }
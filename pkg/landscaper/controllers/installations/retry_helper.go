@@ -12,13 +12,18 @@ import (
 
 	"k8s.io/utils/clock"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/metrics"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -32,16 +37,18 @@ var (
 )
 
 type retryHelper struct {
-	cl     client.Client
-	writer *read_write_layer.Writer
-	clock  clock.PassiveClock
+	cl            client.Client
+	writer        *read_write_layer.Writer
+	clock         clock.PassiveClock
+	eventRecorder record.EventRecorder
 }
 
-func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock) *retryHelper {
+func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock, eventRecorder record.EventRecorder) *retryHelper {
 	return &retryHelper{
-		cl:     cl,
-		writer: read_write_layer.NewWriter(cl),
-		clock:  passiveClock,
+		cl:            cl,
+		writer:        read_write_layer.NewWriter(cl),
+		clock:         passiveClock,
+		eventRecorder: eventRecorder,
 	}
 }
 
@@ -164,9 +171,29 @@ func (r *retryHelper) updateRetryStatus(ctx context.Context, inst *lsv1alpha1.In
 		return err
 	}
 
+	if numRetries > 0 {
+		// a retry has been triggered by the reconcile annotation set before
+		metrics.RecordInstallationRetry(inst, installations.GetRootInstallationName(inst), onFailed)
+		r.recordRetryEvent(inst, numRetries, onFailed)
+	}
+
 	return nil
 }
 
+func (r *retryHelper) recordRetryEvent(inst *lsv1alpha1.Installation, numRetries int, onFailed bool) {
+	if r.eventRecorder == nil {
+		return
+	}
+
+	if onFailed {
+		r.eventRecorder.Eventf(inst, corev1.EventTypeNormal, lsutil.RetryEventReason,
+			"automatic retry %d of the failed installation triggered", numRetries)
+	} else {
+		r.eventRecorder.Eventf(inst, corev1.EventTypeNormal, lsutil.RetryEventReason,
+			"automatic reconcile %d of the succeeded installation triggered", numRetries)
+	}
+}
+
 func (r *retryHelper) resetRetryStatus(ctx context.Context, inst *lsv1alpha1.Installation, writeID read_write_layer.WriteID) error {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
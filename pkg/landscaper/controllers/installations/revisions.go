@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultRevisionHistoryLimit is the number of revisions that are kept if the installation does not define a limit.
+const DefaultRevisionHistoryLimit = 5
+
+// revisionData is the content of the secret of a revision.
+type revisionData struct {
+	Spec             lsv1alpha1.InstallationSpec       `json:"spec"`
+	ComponentVersion string                            `json:"componentVersion,omitempty"`
+	ImportsHash      string                            `json:"importsHash,omitempty"`
+	DeployItems      lsv1alpha1.DeployItemTemplateList `json:"deployItems,omitempty"`
+}
+
+// recordRevision stores the spec, the resolved component version and the rendered deploy items of a succeeded root
+// installation as a new revision. Revisions exceeding the revision history limit are removed.
+func (c *Controller) recordRevision(ctx context.Context, inst *lsv1alpha1.Installation, componentVersion string) error {
+	if !installations.IsRootInstallation(inst) || !inst.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if !needsNewRevision(inst.Status.Revisions, inst.GetGeneration(), inst.Status.ImportsHash) {
+		return nil
+	}
+
+	data := revisionData{
+		Spec:             *inst.Spec.DeepCopy(),
+		ComponentVersion: componentVersion,
+		ImportsHash:      inst.Status.ImportsHash,
+	}
+
+	if inst.Status.ExecutionReference != nil {
+		exec := &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), inst.Status.ExecutionReference.NamespacedName(),
+			exec, read_write_layer.R000112); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to get execution of installation: %w", err)
+		} else if err == nil {
+			data.DeployItems = exec.Spec.DeployItems
+		}
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal revision data: %w", err)
+	}
+
+	revision := lsv1alpha1.InstallationRevision{
+		Revision:         nextRevisionNumber(inst.Status.Revisions),
+		Generation:       inst.GetGeneration(),
+		ComponentVersion: data.ComponentVersion,
+		ImportsHash:      data.ImportsHash,
+		SucceededTime:    metav1.Now(),
+	}
+	revision.DataRef = revisionSecretName(inst.Name, revision.Revision)
+
+	secret := &corev1.Secret{}
+	secret.Name = revision.DataRef
+	secret.Namespace = inst.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.LsUncachedClient(), secret, func() error {
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: raw,
+		}
+		return controllerutil.SetControllerReference(inst, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store revision %d: %w", revision.Revision, err)
+	}
+
+	revisions, removed := trimRevisions(append(inst.Status.Revisions, revision), getRevisionHistoryLimit(inst))
+	inst.Status.Revisions = revisions
+	if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000154, inst); err != nil {
+		return err
+	}
+
+	c.deleteRevisionSecrets(ctx, inst, removed)
+	return nil
+}
+
+// deleteRevisionSecrets removes the secrets of revisions that are no longer part of the revision history.
+func (c *Controller) deleteRevisionSecrets(ctx context.Context, inst *lsv1alpha1.Installation, revisions []lsv1alpha1.InstallationRevision) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	for _, revision := range revisions {
+		secret := &corev1.Secret{}
+		secret.Name = revision.DataRef
+		secret.Namespace = inst.Namespace
+		if err := c.LsUncachedClient().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "unable to delete secret of revision", lc.KeyResource, kutil.ObjectKeyFromObject(secret).String())
+		}
+	}
+}
+
+// loadRevisionData reads the spec and the rendered deploy items of a revision.
+func (c *Controller) loadRevisionData(ctx context.Context, inst *lsv1alpha1.Installation, revision *lsv1alpha1.InstallationRevision) (*revisionData, error) {
+	secret := &corev1.Secret{}
+	if err := read_write_layer.GetSecret(ctx, c.LsUncachedClient(), kutil.ObjectKey(revision.DataRef, inst.Namespace),
+		secret, read_write_layer.R000113); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("data of revision %d not found", revision.Revision)
+		}
+		return nil, err
+	}
+
+	raw, ok := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s of revision %d contains no data", revision.DataRef, revision.Revision)
+	}
+
+	data := &revisionData{}
+	if err := json.Unmarshal(raw, data); err != nil {
+		return nil, fmt.Errorf("unable to parse data of revision %d: %w", revision.Revision, err)
+	}
+	return data, nil
+}
+
+func getRevisionHistoryLimit(inst *lsv1alpha1.Installation) int {
+	if inst.Spec.RevisionHistoryLimit == nil {
+		return DefaultRevisionHistoryLimit
+	}
+	return int(*inst.Spec.RevisionHistoryLimit)
+}
+
+func revisionSecretName(instName string, revision int64) string {
+	return fmt.Sprintf("%s-revision-%d", instName, revision)
+}
+
+// needsNewRevision checks whether a succeeded generation and import data differ from the latest revision.
+func needsNewRevision(revisions []lsv1alpha1.InstallationRevision, generation int64, importsHash string) bool {
+	if len(revisions) == 0 {
+		return true
+	}
+	latest := revisions[len(revisions)-1]
+	return latest.Generation != generation || latest.ImportsHash != importsHash
+}
+
+func nextRevisionNumber(revisions []lsv1alpha1.InstallationRevision) int64 {
+	if len(revisions) == 0 {
+		return 1
+	}
+	return revisions[len(revisions)-1].Revision + 1
+}
+
+// trimRevisions returns the latest revisions up to the given limit, and the removed older revisions.
+func trimRevisions(revisions []lsv1alpha1.InstallationRevision, limit int) (kept, removed []lsv1alpha1.InstallationRevision) {
+	if limit < 1 {
+		limit = 1
+	}
+	if len(revisions) <= limit {
+		return revisions, nil
+	}
+	return revisions[len(revisions)-limit:], revisions[:len(revisions)-limit]
+}
+
+// findRevision returns the revision with the given number.
+func findRevision(revisions []lsv1alpha1.InstallationRevision, number int64) *lsv1alpha1.InstallationRevision {
+	for i := range revisions {
+		if revisions[i].Revision == number {
+			return &revisions[i]
+		}
+	}
+	return nil
+}
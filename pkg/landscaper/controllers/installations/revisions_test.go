@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Revisions", func() {
+
+	var (
+		ctx      context.Context
+		ctrl     reconcile.Reconciler
+		recorder *record.FakeRecorder
+		state    *envtest.State
+		inst     *v1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(1024)
+		op := lsoperation.NewOperation(api.LandscaperScheme, recorder, testenv.Client)
+		ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client, *op,
+			logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{
+				Registry: config.RegistryConfiguration{
+					Local: &config.LocalRegistryConfiguration{
+						RootPath: "./testdata",
+					},
+				},
+			}, "test-revisions-"+testutils.GetNextCounter())
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test14")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+		inst = state.Installations[state.Namespace+"/root"]
+	})
+
+	AfterEach(func() {
+		Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+	})
+
+	getEvents := func() []string {
+		events := []string{}
+		for len(recorder.Events) > 0 {
+			events = append(events, <-recorder.Events)
+		}
+		return events
+	}
+
+	It("should record a revision with the resolved component version", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.Succeeded))
+		Expect(inst.Status.Revisions).To(HaveLen(1))
+		Expect(inst.Status.Revisions[0].Revision).To(Equal(int64(1)))
+		Expect(inst.Status.Revisions[0].Generation).To(Equal(inst.Generation))
+		Expect(inst.Status.Revisions[0].ComponentVersion).To(Equal("1.0.0"))
+
+		secret := &corev1.Secret{}
+		Expect(state.Client.Get(ctx, kutil.ObjectKey(inst.Status.Revisions[0].DataRef, inst.Namespace), secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKey(v1alpha1.DataObjectSecretDataKey))
+	})
+
+	It("should emit an event if the revision could not be recorded", func() {
+		// the secret of the revision is already controlled by another object
+		secret := &corev1.Secret{}
+		secret.Name = inst.Name + "-revision-1"
+		secret.Namespace = inst.Namespace
+		secret.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       "other",
+			UID:        "other",
+			Controller: ptr.To(true),
+		}}
+		Expect(state.Create(ctx, secret)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.InstallationPhase).To(Equal(v1alpha1.InstallationPhases.Succeeded))
+		Expect(inst.Status.Revisions).To(BeEmpty())
+		Expect(getEvents()).To(ContainElement(HavePrefix("Warning " + lsutil.RevisionFailedEventReason + " ")))
+	})
+})
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// handleRollbackOperation restores the deployment of a root installation from a succeeded revision and triggers a
+// reconcile of the restored spec. The revision is taken from the rollback revision annotation. If the annotation
+// is not set, the latest revision whose spec differs from the current spec is used.
+func (c *Controller) handleRollbackOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
+		lc.KeyMethod, "handleRollbackOperation")
+
+	if !installations.IsRootInstallation(inst) {
+		logger.Info("Removing rollback annotation from non-root installation. A rollback annotation at a non-root installation has no effect")
+		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+		delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+		return c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000152, inst)
+	}
+
+	revisionNumber, hasRevision := inst.Annotations[lsv1alpha1.RollbackRevisionAnnotation]
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+
+	var (
+		revision *lsv1alpha1.InstallationRevision
+		data     *revisionData
+		err      error
+	)
+	if hasRevision {
+		revision, data, err = c.getRollbackRevision(ctx, inst, revisionNumber)
+	} else {
+		revision, data, err = c.getLatestDifferentRevision(ctx, inst)
+	}
+	if err != nil {
+		return c.setRollbackError(ctx, inst, "GetRevision", err, false)
+	}
+	if err := checkRevisionImports(inst, revision); err != nil {
+		return c.setRollbackError(ctx, inst, "CheckImports", err, false)
+	}
+
+	return c.rollback(ctx, inst, revision, data, false)
+}
+
+// handleRollbackOnFailure rolls back a failed root installation if its onFailure policy is "rollback".
+// It returns true if the installation was rolled back.
+func (c *Controller) handleRollbackOnFailure(ctx context.Context, inst *lsv1alpha1.Installation) (bool, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
+		lc.KeyMethod, "handleRollbackOnFailure")
+
+	revision, data, err := c.getLatestDifferentRevision(ctx, inst)
+	if err != nil {
+		logger.Info("automatic rollback of failed installation not possible", lc.KeyError, err.Error())
+		return false, c.setRollbackError(ctx, inst, "GetRevision", err, true)
+	}
+
+	if err := checkRevisionImports(inst, revision); err != nil {
+		logger.Info("automatic rollback of failed installation not possible", lc.KeyError, err.Error())
+		return false, c.setRollbackError(ctx, inst, "CheckImports", err, true)
+	}
+
+	logger.Info("rolling back failed installation", "revision", revision.Revision)
+	return true, c.rollback(ctx, inst, revision, data, true)
+}
+
+// rollback restores the deployment relevant fields of the spec from the revision. The operational settings of the
+// installation are kept.
+func (c *Controller) rollback(ctx context.Context, inst *lsv1alpha1.Installation, revision *lsv1alpha1.InstallationRevision,
+	data *revisionData, automatic bool) error {
+
+	fromGeneration := inst.GetGeneration()
+
+	restoreRevisionSpec(&inst.Spec, &data.Spec)
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000152, inst); err != nil {
+		return err
+	}
+
+	inst.Status.Rollback = &lsv1alpha1.RollbackStatus{
+		Revision:       revision.Revision,
+		FromGeneration: fromGeneration,
+		Generation:     inst.GetGeneration(),
+		Time:           metav1.Now(),
+		Automatic:      automatic,
+	}
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000153, inst)
+}
+
+// checkRevisionImports returns an error if the imports of the installation have changed since the revision succeeded.
+// A rollback is not possible then, because the restored spec would not result in the deploy items of the revision.
+func checkRevisionImports(inst *lsv1alpha1.Installation, revision *lsv1alpha1.InstallationRevision) error {
+	if len(revision.ImportsHash) != 0 && revision.ImportsHash != inst.Status.ImportsHash {
+		return fmt.Errorf("the imports have changed since revision %d succeeded", revision.Revision)
+	}
+	return nil
+}
+
+// restoreRevisionSpec restores the fields of the spec that define the deployment from the spec of a revision.
+// The operational settings, i.e. the automatic reconciliation, the optimization, the revision history limit and
+// the suspension, are not changed.
+func restoreRevisionSpec(spec, revisionSpec *lsv1alpha1.InstallationSpec) {
+	revisionSpec = revisionSpec.DeepCopy()
+	spec.Context = revisionSpec.Context
+	spec.Verification = revisionSpec.Verification
+	spec.ComponentDescriptor = revisionSpec.ComponentDescriptor
+	spec.Blueprint = revisionSpec.Blueprint
+	spec.Imports = revisionSpec.Imports
+	spec.ImportDataMappings = revisionSpec.ImportDataMappings
+	spec.Exports = revisionSpec.Exports
+	spec.ExportDataMappings = revisionSpec.ExportDataMappings
+}
+
+// hasSameDeployment checks whether the spec of a revision defines the same deployment as the given spec.
+func hasSameDeployment(spec, revisionSpec *lsv1alpha1.InstallationSpec) bool {
+	restored := spec.DeepCopy()
+	restoreRevisionSpec(restored, revisionSpec)
+	return apiequality.Semantic.DeepEqual(restored, spec)
+}
+
+// setRollbackError removes the rollback annotations and reports the error in the rollback status.
+func (c *Controller) setRollbackError(ctx context.Context, inst *lsv1alpha1.Installation, reason string, err error, automatic bool) error {
+	if !automatic {
+		if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000152, inst); err != nil {
+			return err
+		}
+	}
+
+	lsErr := lserrors.NewWrappedError(err, "Rollback", reason, err.Error())
+	inst.Status.Rollback = &lsv1alpha1.RollbackStatus{
+		FromGeneration: inst.GetGeneration(),
+		Time:           metav1.Now(),
+		Automatic:      automatic,
+		LastError:      lserrors.TryUpdateLsError(nil, lsErr),
+	}
+	return c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000155, inst)
+}
+
+func (c *Controller) getRollbackRevision(ctx context.Context, inst *lsv1alpha1.Installation,
+	revisionNumber string) (*lsv1alpha1.InstallationRevision, *revisionData, error) {
+
+	number, err := strconv.ParseInt(revisionNumber, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid revision %q in annotation %s", revisionNumber, lsv1alpha1.RollbackRevisionAnnotation)
+	}
+
+	revision := findRevision(inst.Status.Revisions, number)
+	if revision == nil {
+		return nil, nil, fmt.Errorf("revision %d not found", number)
+	}
+
+	data, err := c.loadRevisionData(ctx, inst, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	return revision, data, nil
+}
+
+// getLatestDifferentRevision returns the latest revision whose deployment differs from the current spec.
+func (c *Controller) getLatestDifferentRevision(ctx context.Context, inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationRevision, *revisionData, error) {
+	for i := len(inst.Status.Revisions) - 1; i >= 0; i-- {
+		revision := &inst.Status.Revisions[i]
+		if revision.Generation == inst.GetGeneration() {
+			continue
+		}
+
+		data, err := c.loadRevisionData(ctx, inst, revision)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !hasSameDeployment(&inst.Spec, &data.Spec) {
+			return revision, data, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no succeeded revision with a different spec found")
+}
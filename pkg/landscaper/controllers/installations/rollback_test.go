@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Rollback", func() {
+
+	var (
+		ctx   context.Context
+		ctrl  reconcile.Reconciler
+		state *envtest.State
+		inst  *v1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op := lsoperation.NewOperation(api.LandscaperScheme, record.NewFakeRecorder(1024), testenv.Client)
+		ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client, *op,
+			logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{}, "test-rollback-"+testutils.GetNextCounter())
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test9")
+		Expect(err).ToNot(HaveOccurred())
+		inst = state.Installations[state.Namespace+"/root"]
+
+		// revision 1 contains the spec with another import target and without automatic reconciliation
+		revisionSpec := inst.Spec.DeepCopy()
+		revisionSpec.Imports.Targets[0].Target = "old-target"
+		revisionSpec.AutomaticReconcile = nil
+		raw, err := json.Marshal(map[string]interface{}{"spec": revisionSpec})
+		Expect(err).ToNot(HaveOccurred())
+
+		secret := &corev1.Secret{}
+		secret.Name = inst.Name + "-revision-1"
+		secret.Namespace = inst.Namespace
+		secret.Data = map[string][]byte{v1alpha1.DataObjectSecretDataKey: raw}
+		Expect(state.Create(ctx, secret)).To(Succeed())
+
+		inst.Status.Revisions = []v1alpha1.InstallationRevision{{
+			Revision:      1,
+			Generation:    inst.Generation - 1,
+			SucceededTime: metav1.Now(),
+			DataRef:       secret.Name,
+		}}
+		Expect(state.Client.Status().Update(ctx, inst)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+	})
+
+	setRevisionImportsHash := func(importsHash string) {
+		inst.Status.Revisions[0].ImportsHash = importsHash
+		Expect(state.Client.Status().Update(ctx, inst)).To(Succeed())
+	}
+
+	setRollbackAnnotations := func(revision string) {
+		inst.Annotations = map[string]string{v1alpha1.OperationAnnotation: string(v1alpha1.RollbackOperation)}
+		if len(revision) != 0 {
+			inst.Annotations[v1alpha1.RollbackRevisionAnnotation] = revision
+		}
+		Expect(state.Client.Update(ctx, inst)).To(Succeed())
+	}
+
+	It("should restore the deployment of a revision and trigger a reconcile", func() {
+		generation := inst.Generation
+		setRollbackAnnotations("1")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Spec.Imports.Targets[0].Target).To(Equal("old-target"))
+		Expect(inst.Spec.AutomaticReconcile).ToNot(BeNil())
+		Expect(inst.Annotations).To(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Annotations).ToNot(HaveKey(v1alpha1.RollbackRevisionAnnotation))
+		Expect(inst.Status.Rollback).ToNot(BeNil())
+		Expect(inst.Status.Rollback.Revision).To(Equal(int64(1)))
+		Expect(inst.Status.Rollback.FromGeneration).To(Equal(generation))
+		Expect(inst.Status.Rollback.Generation).To(Equal(inst.Generation))
+		Expect(inst.Status.Rollback.Automatic).To(BeFalse())
+		Expect(inst.Status.Rollback.LastError).To(BeNil())
+	})
+
+	It("should roll back to the latest revision with a different spec if no revision is specified", func() {
+		setRollbackAnnotations("")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Spec.Imports.Targets[0].Target).To(Equal("old-target"))
+		Expect(inst.Spec.AutomaticReconcile).ToNot(BeNil())
+		Expect(inst.Status.Rollback).ToNot(BeNil())
+		Expect(inst.Status.Rollback.Revision).To(Equal(int64(1)))
+	})
+
+	It("should report an error if the revision does not exist", func() {
+		setRollbackAnnotations("7")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Spec.AutomaticReconcile).ToNot(BeNil())
+		Expect(inst.Annotations).ToNot(HaveKey(v1alpha1.OperationAnnotation))
+		Expect(inst.Annotations).ToNot(HaveKey(v1alpha1.RollbackRevisionAnnotation))
+		Expect(inst.Status.Rollback).ToNot(BeNil())
+		Expect(inst.Status.Rollback.LastError).ToNot(BeNil())
+	})
+	It("should block the rollback if the imports have changed since the revision succeeded", func() {
+		setRevisionImportsHash("outdated-hash")
+		setRollbackAnnotations("1")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Spec.Imports.Targets[0].Target).To(Equal("not-existing-target"))
+		Expect(inst.Annotations).ToNot(HaveKey(v1alpha1.OperationAnnotation))
+		Expect(inst.Status.Rollback).ToNot(BeNil())
+		Expect(inst.Status.Rollback.LastError).ToNot(BeNil())
+		Expect(inst.Status.Rollback.LastError.Reason).To(Equal("CheckImports"))
+	})
+})
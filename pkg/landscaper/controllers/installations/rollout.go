@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
+)
+
+// getSubinstallationRolloutPlan divides the subinstallations of an installation into the waves of their rollouts
+// and decides which subinstallations may be started in the current job of the installation.
+// Subinstallations with an invalid rollout policy annotation are treated as not being part of a rollout.
+func getSubinstallationRolloutPlan(ctx context.Context, inst *lsv1alpha1.Installation, subInsts []*lsv1alpha1.Installation) *rollout.Plan {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+
+	items := make([]rollout.Item, 0, len(subInsts))
+	for _, subInst := range subInsts {
+		policy, err := lsv1alpha1helper.GetSubinstallationRolloutPolicy(subInst.ObjectMeta)
+		if err != nil {
+			logger.Error(err, "ignoring rollout policy of subinstallation", lc.KeyResource, client.ObjectKeyFromObject(subInst).String())
+		}
+
+		item := rollout.Item{
+			Name:   getSubinstallationRolloutName(subInst),
+			Index:  lsv1alpha1helper.GetSubinstallationRolloutIndex(subInst.ObjectMeta),
+			Policy: policy,
+		}
+
+		switch {
+		case subInst.Status.JobID != inst.Status.JobID:
+			item.State = rollout.NotStarted
+		case subInst.Status.JobIDFinished != subInst.Status.JobID:
+			item.State = rollout.Running
+		case subInst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded:
+			item.State = rollout.Succeeded
+		default:
+			item.State = rollout.Failed
+		}
+
+		if item.State == rollout.Succeeded || item.State == rollout.Failed {
+			if subInst.Status.TransitionTimes != nil {
+				item.FinishedTime = subInst.Status.TransitionTimes.FinishedTime
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return rollout.NewPlan(items, time.Now())
+}
+
+// getSubinstallationRolloutName returns the name of the installation template of a subinstallation,
+// which identifies the subinstallation in a rollout.
+func getSubinstallationRolloutName(subInst *lsv1alpha1.Installation) string {
+	if name, ok := subInst.Annotations[lsv1alpha1.SubinstallationNameAnnotation]; ok && len(name) != 0 {
+		return name
+	}
+	return subInst.Name
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// isSuspended returns whether the processing of the installation is suspended, either by its own spec,
+// or because an installation above it is suspended.
+func isSuspended(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.Suspended || lsv1alpha1helper.IsSuspended(inst.ObjectMeta)
+}
+
+// isSuspensionChanged returns whether the suspension of the installation differs from its Suspended condition,
+// i.e. whether it has not yet been propagated to the children of the installation.
+func isSuspensionChanged(inst *lsv1alpha1.Installation) bool {
+	return isSuspended(inst) != lsutil.IsSuspendedConditionTrue(inst.Status.Conditions)
+}
+
+// handleSuspension propagates the suspension of the installation to its execution and subinstallations by setting
+// or removing the suspended label, and updates the Suspended condition of the installation.
+func (c *Controller) handleSuspension(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	suspended := isSuspended(inst)
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	if err != nil {
+		return err
+	}
+	if exec != nil && lsutil.SetSuspendedLabel(&exec.ObjectMeta, suspended) {
+		if err := c.WriterToLsUncachedClient().UpdateExecution(ctx, read_write_layer.W000171, exec); err != nil {
+			return err
+		}
+	}
+
+	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000122)
+	if err != nil {
+		return err
+	}
+	for _, subInst := range subInsts {
+		if lsutil.SetSuspendedLabel(&subInst.ObjectMeta, suspended) {
+			if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000172, subInst); err != nil {
+				return err
+			}
+		}
+	}
+
+	conditions, changed := lsutil.UpdateSuspendedCondition(inst.Status.Conditions, suspended)
+	if changed {
+		inst.Status.Conditions = conditions
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000173, inst); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Suspension", func() {
+
+	var (
+		ctx      context.Context
+		ctrl     reconcile.Reconciler
+		recorder *record.FakeRecorder
+		state    *envtest.State
+		inst     *v1alpha1.Installation
+		exec     *v1alpha1.Execution
+		subInst  *v1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = record.NewFakeRecorder(1024)
+		op := lsoperation.NewOperation(api.LandscaperScheme, recorder, testenv.Client)
+		ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client, *op,
+			logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{}, "test-suspension-"+testutils.GetNextCounter())
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test13")
+		Expect(err).ToNot(HaveOccurred())
+		inst = state.Installations[state.Namespace+"/root"]
+		exec = state.Executions[state.Namespace+"/root"]
+		subInst = state.Installations[state.Namespace+"/subinst"]
+	})
+
+	AfterEach(func() {
+		Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+	})
+
+	getObjects := func() {
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(subInst), subInst)).To(Succeed())
+	}
+
+	getEvents := func() []string {
+		events := []string{}
+		for len(recorder.Events) > 0 {
+			events = append(events, <-recorder.Events)
+		}
+		return events
+	}
+
+	It("should propagate the suspension and skip the reconciliation of a suspended installation", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(lsv1alpha1helper.IsSuspended(exec.ObjectMeta)).To(BeTrue())
+		Expect(lsv1alpha1helper.IsSuspended(subInst.ObjectMeta)).To(BeTrue())
+		Expect(lsutil.IsSuspendedConditionTrue(inst.Status.Conditions)).To(BeTrue())
+
+		// the reconcile annotation remains at the installation and no new job is started
+		Expect(inst.Annotations).To(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Status.JobID).To(Equal("job1"))
+		Expect(inst.Status.JobIDFinished).To(Equal("job1"))
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(inst.Annotations).To(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Status.JobID).To(Equal("job1"))
+	})
+
+	It("should remove the suspension and continue the processing of a resumed installation", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		inst.Spec.Suspended = false
+		Expect(state.Client.Update(ctx, inst)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(lsv1alpha1helper.IsSuspended(exec.ObjectMeta)).To(BeFalse())
+		Expect(lsv1alpha1helper.IsSuspended(subInst.ObjectMeta)).To(BeFalse())
+		Expect(lsutil.IsSuspendedConditionTrue(inst.Status.Conditions)).To(BeFalse())
+		cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, v1alpha1.SuspendedCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Reason).To(Equal(lsutil.ResumedReason))
+
+		// the pending reconcile annotation is processed
+		Expect(inst.Annotations).ToNot(HaveKeyWithValue(v1alpha1.OperationAnnotation, string(v1alpha1.ReconcileOperation)))
+		Expect(inst.Status.JobID).ToNot(Equal(inst.Status.JobIDFinished))
+	})
+
+	It("should block the deletion of a suspended installation with an event", func() {
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		getEvents()
+
+		Expect(state.Client.Delete(ctx, inst)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		getObjects()
+		Expect(inst.DeletionTimestamp.IsZero()).To(BeFalse())
+		Expect(inst.Finalizers).To(ContainElement(v1alpha1.LandscaperFinalizer))
+		Expect(exec.DeletionTimestamp.IsZero()).To(BeTrue())
+		Expect(subInst.DeletionTimestamp.IsZero()).To(BeTrue())
+		Expect(getEvents()).To(ContainElement(HavePrefix("Warning " + lsutil.DeletionBlockedEventReason + " ")))
+	})
+})
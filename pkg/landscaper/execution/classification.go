@@ -2,9 +2,11 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
 )
 
 // DeployItemClassification divides all the deploy items of an execution into the following classes.
@@ -13,13 +15,24 @@ import (
 // - succeeded items: they have the same jobID as the execution, are finished and succeeded
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
-// - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - pending items:   they have an old jobID, which can not be updated because of pending dependencies, or because
+// their rollout has not yet reached their wave or was stopped
+// - waiting items:   they have an old jobID and belong to the next wave of a rollout, which waits for the pause
+// between waves
+// - approval items:  they have an old jobID and could be updated, but require an approval of the current job,
+// which was not yet given
 type DeployItemClassification struct {
 	runningItems   []*executionItem
 	succeededItems []*executionItem
 	failedItems    []*executionItem
 	runnableItems  []*executionItem
 	pendingItems   []*executionItem
+	waitingItems   []*executionItem
+	approvalItems  []*executionItem
+
+	// failuresTolerated is true if all failed items are within the failure budget of their rollouts.
+	failuresTolerated bool
+	rollouts          []lsv1alpha1.RolloutStatus
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -42,23 +55,65 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasWaitingItems() bool {
+	return len(c.waitingItems) > 0
+}
+
+func (c *DeployItemClassification) HasApprovalItems() bool {
+	return len(c.approvalItems) > 0
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems() &&
+		!c.HasWaitingItems() && !c.HasApprovalItems()
+}
+
+// IsWaitingForApproval returns true if the execution cannot proceed until the current job is approved,
+// i.e. if there are items that require approval, and no other items are running or can be started.
+func (c *DeployItemClassification) IsWaitingForApproval() bool {
+	return c.HasApprovalItems() && c.CanStartItems() && !c.HasRunningItems() && !c.HasRunnableItems() && !c.HasWaitingItems()
+}
+
+// CanStartItems returns true if runnable items may be started, i.e. if there are no failed items, or if all failed
+// items are within the failure budget of their rollouts.
+func (c *DeployItemClassification) CanStartItems() bool {
+	return !c.HasFailedItems() || c.failuresTolerated
+}
+
+// IsFailed returns true if there are failed items, and no items are running or can be started anymore.
+func (c *DeployItemClassification) IsFailed() bool {
+	if c.HasRunningItems() || !c.HasFailedItems() {
+		return false
+	}
+	return !c.CanStartItems() || (!c.HasRunnableItems() && !c.HasWaitingItems() && !c.HasApprovalItems())
+}
+
+// GetRolloutStatus returns the progress of the rollouts of the deploy items.
+func (c *DeployItemClassification) GetRolloutStatus() []lsv1alpha1.RolloutStatus {
+	return c.rollouts
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
-func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
+// newDeployItemClassification classifies the deploy items of an execution. The parameter approved specifies whether
+// the current job of the execution has been approved, so that items which require approval may be started.
+func newDeployItemClassification(executionJobID string, items []*executionItem, approved bool) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
-		runningItems:   []*executionItem{},
-		succeededItems: []*executionItem{},
-		failedItems:    []*executionItem{},
-		runnableItems:  []*executionItem{},
-		pendingItems:   []*executionItem{},
+		runningItems:      []*executionItem{},
+		succeededItems:    []*executionItem{},
+		failedItems:       []*executionItem{},
+		runnableItems:     []*executionItem{},
+		pendingItems:      []*executionItem{},
+		waitingItems:      []*executionItem{},
+		approvalItems:     []*executionItem{},
+		failuresTolerated: true,
 	}
 
+	plan := rollout.NewPlan(getRolloutItems(executionJobID, items), time.Now())
+	c.rollouts = plan.Status()
+
 	for i := range items {
 		item := items[i]
 
@@ -81,17 +136,66 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 				return nil, lsErr
 			}
 
-			if runnable {
-				c.runnableItems = append(c.runnableItems, item)
-			} else {
+			if !runnable {
+				c.pendingItems = append(c.pendingItems, item)
+				continue
+			}
+
+			switch plan.Decision(item.Info.Name) {
+			case rollout.Allowed:
+				if item.Info.RequiresApproval && !approved {
+					c.approvalItems = append(c.approvalItems, item)
+				} else {
+					c.runnableItems = append(c.runnableItems, item)
+				}
+			case rollout.Waiting:
+				c.waitingItems = append(c.waitingItems, item)
+			default:
 				c.pendingItems = append(c.pendingItems, item)
 			}
 		}
 	}
 
+	for _, item := range c.failedItems {
+		if !plan.IsFailureTolerated(item.Info.Name) {
+			c.failuresTolerated = false
+		}
+	}
+
 	return c, nil
 }
 
+// getRolloutItems returns the state of the items in the current job of the execution.
+// The items are in the order of the deploy item templates of the execution.
+func getRolloutItems(executionJobID string, items []*executionItem) []rollout.Item {
+	result := make([]rollout.Item, 0, len(items))
+	for i, item := range items {
+		rolloutItem := rollout.Item{
+			Name:   item.Info.Name,
+			Index:  i,
+			Policy: item.Info.Rollout,
+		}
+
+		if item.DeployItem == nil {
+			rolloutItem.State = rollout.Failed
+		} else if item.DeployItem.Status.GetJobID() == executionJobID {
+			if item.DeployItem.Status.GetJobID() != item.DeployItem.Status.JobIDFinished {
+				rolloutItem.State = rollout.Running
+			} else if item.DeployItem.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded {
+				rolloutItem.State = rollout.Succeeded
+			} else {
+				rolloutItem.State = rollout.Failed
+			}
+			if item.DeployItem.Status.TransitionTimes != nil {
+				rolloutItem.FinishedTime = item.DeployItem.Status.TransitionTimes.FinishedTime
+			}
+		}
+
+		result = append(result, rolloutItem)
+	}
+	return result
+}
+
 func isItemRunnable(executionJobID string, item *executionItem, items []*executionItem) (bool, lserrors.LsError) {
 	if len(item.Info.DependsOn) == 0 {
 		return true, nil
@@ -47,7 +47,7 @@ var _ = Describe("DeployItem Classification", func() {
 			buildExecutionItemWithoutDeployItem("a", nil),
 		}
 
-		classification, err := newDeployItemClassification(currJobID, items)
+		classification, err := newDeployItemClassification(currJobID, items, false)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(classification.succeededItems).To(BeEmpty())
@@ -74,7 +74,7 @@ var _ = Describe("DeployItem Classification", func() {
 			buildExecutionItem("i", []string{}, currJobID, prevJobID, lsv1alpha1.DeployItemPhases.Progressing),
 		}
 
-		classification, err := newDeployItemClassification(currJobID, items)
+		classification, err := newDeployItemClassification(currJobID, items, false)
 		Expect(err).NotTo(HaveOccurred())
 
 		Expect(classification.succeededItems).To(ConsistOf(items[0], items[2]))
@@ -84,6 +84,62 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
 
+	It("should classify execution items of a rollout", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		maxParallel := int32(2)
+		failureBudget := int32(1)
+		policy := &lsv1alpha1.RolloutPolicy{Group: "clusters", MaxParallel: &maxParallel, FailureBudget: &failureBudget}
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("e", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		for _, item := range items {
+			item.Info.Rollout = policy
+		}
+
+		classification, err := newDeployItemClassification(currJobID, items, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.failedItems).To(ConsistOf(items[0]))
+		Expect(classification.runnableItems).To(ConsistOf(items[2], items[3]))
+		Expect(classification.pendingItems).To(ConsistOf(items[4]))
+		Expect(classification.CanStartItems()).To(BeTrue())
+		Expect(classification.IsFailed()).To(BeFalse())
+		Expect(classification.GetRolloutStatus()).To(HaveLen(1))
+		Expect(classification.GetRolloutStatus()[0].CurrentWave).To(Equal(int32(2)))
+	})
+
+	It("should classify execution items that require approval", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{"a"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{"b"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[1].Info.RequiresApproval = true
+
+		classification, err := newDeployItemClassification(currJobID, items, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.approvalItems).To(ConsistOf(items[1]))
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.pendingItems).To(ConsistOf(items[2]))
+		Expect(classification.IsWaitingForApproval()).To(BeTrue())
+		Expect(classification.AllSucceeded()).To(BeFalse())
+
+		classification, err = newDeployItemClassification(currJobID, items, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.approvalItems).To(BeEmpty())
+		Expect(classification.runnableItems).To(ConsistOf(items[1]))
+		Expect(classification.IsWaitingForApproval()).To(BeFalse())
+	})
+
 	It("should classify execution items for delete", func() {
 		currJobID := "02"
 		prevJobID := "01"
@@ -119,13 +119,17 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 	}
 
 	// Trigger new and updated deploy items
-	classification, lsErr := newDeployItemClassification(o.exec.Status.JobID, items)
+	classification, lsErr := newDeployItemClassification(o.exec.Status.JobID, items,
+		lsv1alpha1helper.IsApproved(o.exec.Status.Approval, o.exec.Status.JobID))
 	if lsErr != nil {
 		return nil, lsErr
 	}
 
-	// Start the runnable items, provided there are no failed items
-	if !classification.HasFailedItems() {
+	o.exec.Status.Rollouts = classification.GetRolloutStatus()
+
+	// Start the runnable items, provided there are no failed items or the failures are within the failure budget
+	// of their rollouts
+	if classification.CanStartItems() {
 		runnableItems := classification.GetRunnableItems()
 		for _, item := range runnableItems {
 			if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000056); err != nil {
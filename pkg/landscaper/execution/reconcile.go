@@ -64,6 +64,7 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&o.exec.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		lsv1alpha1helper.SetRootInstallationAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1helper.GetRootInstallationName(o.exec.ObjectMeta))
 
 		o.Scheme().Default(item.DeployItem)
 		return controllerutil.SetControllerReference(o.exec, item.DeployItem, o.Scheme())
@@ -147,6 +148,7 @@ func (o *Operation) CollectAndUpdateExportsNew(ctx context.Context) lserrors.LsE
 }
 
 // addExports loads the exports of a deployitem and adds it to the given values.
+// Sensitive exports of container deployitems are not read, because the exports of the execution are stored in a data object.
 func (o *Operation) addExports(ctx context.Context, item *lsv1alpha1.DeployItem) (map[string]interface{}, error) {
 	if item.Status.ExportReference == nil {
 		return nil, nil
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DryRun renders the deploy items of an installation and compares them with the deploy items that currently exist
+// for its execution. Neither the execution, nor the deploy items, nor the templating state are created or updated.
+func (o *ExecutionOperation) DryRun(ctx context.Context,
+	inst *installations.InstallationImportsAndBlueprint) ([]lsv1alpha1.DeployItemDryRunResult, error) {
+
+	templateStateHandler := template.NewReadOnlyStateHandler(template.KubernetesStateHandler{
+		KubeClient: o.LsUncachedClient(),
+		Inst:       inst.GetInstallation(),
+	})
+	execTemplates, err := o.renderDeployItemTemplates(ctx, inst, templateStateHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedDeployItemTemplateList := lsv1alpha1.DeployItemTemplateList{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &versionedDeployItemTemplateList, nil); err != nil {
+		return nil, fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
+	}
+
+	// the execution has the same name and namespace as the installation
+	deployItems, err := read_write_layer.ListManagedDeployItems(ctx, o.LsUncachedClient(),
+		kutil.ObjectKeyFromObject(inst.GetInstallation()), read_write_layer.R000111)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list deploy items: %w", err)
+	}
+
+	return ComputeDryRunResults(versionedDeployItemTemplateList, deployItems.Items)
+}
+
+// ComputeDryRunResults compares the rendered deploy item templates with the existing deploy items of an execution.
+// The deploy items are matched to the templates by their execution managed name label.
+func ComputeDryRunResults(templates lsv1alpha1.DeployItemTemplateList,
+	deployItems []lsv1alpha1.DeployItem) ([]lsv1alpha1.DeployItemDryRunResult, error) {
+
+	results := []lsv1alpha1.DeployItemDryRunResult{}
+	matched := map[string]bool{}
+
+	for i := range templates {
+		tmpl := templates[i].DeepCopy()
+		result := lsv1alpha1.DeployItemDryRunResult{
+			Name:          tmpl.Name,
+			Action:        lsv1alpha1.DryRunActionCreate,
+			Specification: tmpl,
+		}
+
+		for j := range deployItems {
+			di := &deployItems[j]
+			if di.Labels[lsv1alpha1.ExecutionManagedNameLabel] != tmpl.Name {
+				continue
+			}
+
+			matched[di.Name] = true
+			result.DeployItemReference = &lsv1alpha1.ObjectReference{Name: di.Name, Namespace: di.Namespace}
+
+			diff, err := computeConfigDiff(di.Spec.Configuration, tmpl.Configuration)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compute configuration diff of deploy item %q: %w", tmpl.Name, err)
+			}
+			result.ConfigDiff = diff
+
+			if len(diff) > 0 || isDeployItemSpecChanged(di, tmpl) {
+				result.Action = lsv1alpha1.DryRunActionUpdate
+			} else {
+				result.Action = lsv1alpha1.DryRunActionNone
+			}
+			break
+		}
+
+		results = append(results, result)
+	}
+
+	// deploy items that are no longer rendered would be deleted
+	for j := range deployItems {
+		di := &deployItems[j]
+		if matched[di.Name] {
+			continue
+		}
+		results = append(results, lsv1alpha1.DeployItemDryRunResult{
+			Name:                di.Labels[lsv1alpha1.ExecutionManagedNameLabel],
+			Action:              lsv1alpha1.DryRunActionDelete,
+			DeployItemReference: &lsv1alpha1.ObjectReference{Name: di.Name, Namespace: di.Namespace},
+		})
+	}
+
+	return results, nil
+}
+
+// isDeployItemSpecChanged checks whether the fields of a deploy item spec apart from the configuration differ
+// from the template.
+func isDeployItemSpecChanged(di *lsv1alpha1.DeployItem, tmpl *lsv1alpha1.DeployItemTemplate) bool {
+	return di.Spec.Type != tmpl.Type ||
+		!reflect.DeepEqual(di.Spec.Target, tmpl.Target) ||
+		!reflect.DeepEqual(di.Spec.Timeout, tmpl.Timeout) ||
+		di.Spec.UpdateOnChangeOnly != tmpl.UpdateOnChangeOnly ||
+		!reflect.DeepEqual(di.Spec.OnDelete, tmpl.OnDelete)
+}
+
+// computeConfigDiff returns the JSON patch operations that transform the old configuration into the new one.
+func computeConfigDiff(oldConfig, newConfig *runtime.RawExtension) ([]lsv1alpha1.JSONPatchOperation, error) {
+	patch, err := jsonpatch.CreatePatch(rawConfig(oldConfig), rawConfig(newConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(patch, func(i, j int) bool {
+		return patch[i].Path < patch[j].Path
+	})
+
+	diff := make([]lsv1alpha1.JSONPatchOperation, len(patch))
+	for i, next := range patch {
+		diff[i] = lsv1alpha1.JSONPatchOperation{
+			Operation: next.Operation,
+			Path:      next.Path,
+		}
+		if next.Operation != "remove" {
+			value, err := json.Marshal(next.Value)
+			if err != nil {
+				return nil, err
+			}
+			diff[i].Value = lsv1alpha1.NewAnyJSONPointer(value)
+		}
+	}
+	return diff, nil
+}
+
+func rawConfig(config *runtime.RawExtension) []byte {
+	if config == nil || len(config.Raw) == 0 {
+		return []byte("{}")
+	}
+	return config.Raw
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+)
+
+var _ = Describe("Dry-run", func() {
+
+	deployItem := func(name, managedName, config string) lsv1alpha1.DeployItem {
+		di := lsv1alpha1.DeployItem{}
+		di.Name = name
+		di.Namespace = "test"
+		di.Labels = map[string]string{lsv1alpha1.ExecutionManagedNameLabel: managedName}
+		di.Spec.Type = "mock"
+		di.Spec.Configuration = &runtime.RawExtension{Raw: []byte(config)}
+		return di
+	}
+
+	template := func(name, config string) lsv1alpha1.DeployItemTemplate {
+		return lsv1alpha1.DeployItemTemplate{
+			Name:          name,
+			Type:          "mock",
+			Configuration: &runtime.RawExtension{Raw: []byte(config)},
+		}
+	}
+
+	It("should compute the actions and configuration diffs of the deploy items", func() {
+		templates := lsv1alpha1.DeployItemTemplateList{
+			template("unchanged", `{"a": 1}`),
+			template("changed", `{"a": 2, "b": "x"}`),
+			template("new", `{"a": 1}`),
+		}
+		deployItems := []lsv1alpha1.DeployItem{
+			deployItem("di-unchanged", "unchanged", `{"a": 1}`),
+			deployItem("di-changed", "changed", `{"a": 1, "c": true}`),
+			deployItem("di-removed", "removed", `{"a": 1}`),
+		}
+
+		results, err := executions.ComputeDryRunResults(templates, deployItems)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(4))
+
+		Expect(results[0].Name).To(Equal("unchanged"))
+		Expect(results[0].Action).To(Equal(lsv1alpha1.DryRunActionNone))
+		Expect(results[0].DeployItemReference.Name).To(Equal("di-unchanged"))
+		Expect(results[0].ConfigDiff).To(BeEmpty())
+
+		Expect(results[1].Name).To(Equal("changed"))
+		Expect(results[1].Action).To(Equal(lsv1alpha1.DryRunActionUpdate))
+		Expect(results[1].Specification).ToNot(BeNil())
+		Expect(results[1].ConfigDiff).To(HaveLen(3))
+		Expect(results[1].ConfigDiff[0].Operation).To(Equal("replace"))
+		Expect(results[1].ConfigDiff[0].Path).To(Equal("/a"))
+		Expect(string(results[1].ConfigDiff[0].Value.RawMessage)).To(Equal("2"))
+		Expect(results[1].ConfigDiff[1].Operation).To(Equal("add"))
+		Expect(results[1].ConfigDiff[1].Path).To(Equal("/b"))
+		Expect(results[1].ConfigDiff[2].Operation).To(Equal("remove"))
+		Expect(results[1].ConfigDiff[2].Path).To(Equal("/c"))
+		Expect(results[1].ConfigDiff[2].Value).To(BeNil())
+
+		Expect(results[2].Name).To(Equal("new"))
+		Expect(results[2].Action).To(Equal(lsv1alpha1.DryRunActionCreate))
+		Expect(results[2].DeployItemReference).To(BeNil())
+
+		Expect(results[3].Name).To(Equal("removed"))
+		Expect(results[3].Action).To(Equal(lsv1alpha1.DryRunActionDelete))
+		Expect(results[3].DeployItemReference.Name).To(Equal("di-removed"))
+		Expect(results[3].Specification).To(BeNil())
+	})
+
+	It("should detect changes of the deploy item spec apart from the configuration", func() {
+		templates := lsv1alpha1.DeployItemTemplateList{template("item", `{"a": 1}`)}
+		templates[0].UpdateOnChangeOnly = true
+		deployItems := []lsv1alpha1.DeployItem{deployItem("di-item", "item", `{"a": 1}`)}
+
+		results, err := executions.ComputeDryRunResults(templates, deployItems)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Action).To(Equal(lsv1alpha1.DryRunActionUpdate))
+		Expect(results[0].ConfigDiff).To(BeEmpty())
+	})
+})
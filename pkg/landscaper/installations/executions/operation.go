@@ -58,14 +58,21 @@ func New(op *installations.Operation) *ExecutionOperation {
 func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	inst *installations.InstallationImportsAndBlueprint) (core.DeployItemTemplateList, error) {
 
-	op := "RenderDeployItemTemplates"
-
-	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
-
 	templateStateHandler := template.KubernetesStateHandler{
 		KubeClient: o.LsUncachedClient(),
 		Inst:       inst.GetInstallation(),
 	}
+	return o.renderDeployItemTemplates(ctx, inst, templateStateHandler)
+}
+
+func (o *ExecutionOperation) renderDeployItemTemplates(ctx context.Context,
+	inst *installations.InstallationImportsAndBlueprint,
+	templateStateHandler template.GenericStateHandler) (core.DeployItemTemplateList, error) {
+
+	op := "RenderDeployItemTemplates"
+
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
+
 	targetResolver := genericresolver.New(o.LsUncachedClient())
 	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
 	executions, err := tmpl.TemplateDeployExecutions(
@@ -152,6 +159,8 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			Timeout:            timeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
+			Rollout:            elem.Rollout,
+			RequiresApproval:   elem.RequiresApproval,
 		}
 	}
 
@@ -199,6 +208,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.GetInstallation().ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		lsv1alpha1helper.SetRootInstallationAnnotation(&exec.ObjectMeta, installations.GetRootInstallationName(inst.GetInstallation()))
 
 		if exec.CreationTimestamp.IsZero() && exec.DeletionTimestamp.IsZero() {
 			controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
@@ -119,3 +119,33 @@ func (m MemoryStateHandler) Get(_ context.Context, name string) ([]byte, error)
 	}
 	return data, nil
 }
+
+// ReadOnlyStateHandler implements the GenericStateHandler interface.
+// It reads the state from an underlying state handler but keeps all stored state in memory,
+// so that templating does not modify any persisted state, e.g. during a dry-run.
+type ReadOnlyStateHandler struct {
+	StateHandler GenericStateHandler
+	memory       MemoryStateHandler
+}
+
+var _ GenericStateHandler = &ReadOnlyStateHandler{}
+
+// NewReadOnlyStateHandler creates a new read-only state handler that reads from the given state handler.
+func NewReadOnlyStateHandler(stateHandler GenericStateHandler) *ReadOnlyStateHandler {
+	return &ReadOnlyStateHandler{
+		StateHandler: stateHandler,
+		memory:       NewMemoryStateHandler(),
+	}
+}
+
+func (s *ReadOnlyStateHandler) Store(ctx context.Context, name string, data []byte) error {
+	return s.memory.Store(ctx, name, data)
+}
+
+func (s *ReadOnlyStateHandler) Get(ctx context.Context, name string) ([]byte, error) {
+	data, err := s.memory.Get(ctx, name)
+	if err == nil {
+		return data, nil
+	}
+	return s.StateHandler.Get(ctx, name)
+}
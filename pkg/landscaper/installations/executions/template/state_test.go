@@ -63,4 +63,29 @@ var _ = Describe("State", func() {
 
 	})
 
+	Context("read-only handler", func() {
+
+		It("should read state from the underlying handler but not store state in it", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+			memHdlr := NewMemoryStateHandler()
+			Expect(memHdlr.Store(ctx, "my-exec", []byte("old data"))).To(Succeed())
+			stateHdlr := NewReadOnlyStateHandler(memHdlr)
+
+			res, err := stateHdlr.Get(ctx, "my-exec")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("old data")))
+
+			Expect(stateHdlr.Store(ctx, "my-exec", []byte("new data"))).To(Succeed())
+			res, err = stateHdlr.Get(ctx, "my-exec")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("new data")))
+			Expect(memHdlr["my-exec"]).To(Equal([]byte("old data")))
+
+			_, err = stateHdlr.Get(ctx, "other-exec")
+			Expect(err).To(MatchError(StateNotFoundErr))
+		})
+
+	})
+
 })
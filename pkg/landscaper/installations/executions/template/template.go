@@ -158,6 +158,15 @@ type DeployItemSpecification struct {
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
 	OnDelete *core.OnDeleteConfig
+
+	// Rollout assigns the deploy item to a rollout, which starts the deploy items of the rollout in waves.
+	// +optional
+	Rollout *core.RolloutPolicy `json:"rollout,omitempty"`
+
+	// RequiresApproval specifies that the deploy item is only started after the current job of the execution
+	// has been approved.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
 }
 
 // DeployExecutorOutput describes the output of deploy executor.
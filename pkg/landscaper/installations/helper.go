@@ -45,6 +45,15 @@ func IsRootInstallation(inst *lsv1alpha1.Installation) bool {
 	return !isOwned
 }
 
+// GetRootInstallationName returns the name of the root installation of the tree the given installation belongs to.
+// For subinstallations the name is taken from the root installation annotation that is propagated by the parent.
+func GetRootInstallationName(inst *lsv1alpha1.Installation) string {
+	if IsRootInstallation(inst) {
+		return inst.Name
+	}
+	return lsv1alpha1helper.GetRootInstallationName(inst.ObjectMeta)
+}
+
 // GetParentInstallationName returns the name of parent installation that encompasses the given installation.
 func GetParentInstallationName(inst *lsv1alpha1.Installation) string {
 	name, _ := kubernetes.OwnerOfGVK(inst.OwnerReferences, componentInstallationGVK)
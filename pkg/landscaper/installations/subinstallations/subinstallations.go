@@ -20,6 +20,7 @@ import (
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
@@ -230,7 +231,7 @@ func (o *Operation) createOrUpdateSubinstallations(ctx context.Context,
 		return nil, nil
 	}
 
-	for _, subInstTmpl := range installationTmpl {
+	for i, subInstTmpl := range installationTmpl {
 		subInst := subInstallations[subInstTmpl.Name]
 		if subInst != nil && !subInst.DeletionTimestamp.IsZero() {
 			// if a subinstallation was deleted, the deletion failed and it should be created again
@@ -238,7 +239,7 @@ func (o *Operation) createOrUpdateSubinstallations(ctx context.Context,
 			return nil, fmt.Errorf("an installation %s should be created which is currently under deletion", subInst.Name)
 		}
 
-		subInst, err := o.createOrUpdateNewInstallation(ctx, o.Inst.GetInstallation(), subInstTmpl, subInst)
+		subInst, err := o.createOrUpdateNewInstallation(ctx, o.Inst.GetInstallation(), subInstTmpl, i, subInst)
 		if err != nil {
 			err = fmt.Errorf("unable to create installation for %s: %w", subInstTmpl.Name, err)
 			return nil, o.NewError(err, "CreateOrUpdateInstallation", err.Error())
@@ -256,6 +257,7 @@ func (o *Operation) createOrUpdateSubinstallations(ctx context.Context,
 func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 	inst *lsv1alpha1.Installation,
 	subInstTmpl *lsv1alpha1.InstallationTemplate,
+	subInstTmplIndex int,
 	subInst *lsv1alpha1.Installation) (*lsv1alpha1.Installation, error) {
 	cond := lsv1alpha1helper.GetOrInitCondition(inst.Status.Conditions, lsv1alpha1.EnsureSubInstallationsCondition)
 
@@ -291,6 +293,13 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		subInst.Annotations = map[string]string{
 			lsv1alpha1.SubinstallationNameAnnotation: subInstTmpl.Name,
 		}
+		lsv1alpha1helper.SetRootInstallationAnnotation(&subInst.ObjectMeta, installations.GetRootInstallationName(inst))
+		if err := lsv1alpha1helper.SetSubinstallationRolloutPolicy(&subInst.ObjectMeta, subInstTmpl.Rollout, subInstTmplIndex); err != nil {
+			return errors.Wrapf(err, "unable to set rollout policy")
+		}
+		if subInstTmpl.RequiresApproval {
+			metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, lsv1alpha1.RequiresApprovalAnnotation, "true")
+		}
 
 		lsv1alpha1helper.DeleteCacheHelmChartsAnnotation(&subInst.ObjectMeta)
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.ObjectMeta) {
@@ -103,6 +103,22 @@ var _ = Describe("jsonschema", func() {
 		Expect(jsonschema.ValidateBytes(schemaBytes, data, nil)).To(HaveOccurred())
 	})
 
+	It("should omit the values of the selected invalid fields", func() {
+		v := jsonschema.NewValidator(nil)
+		Expect(v.CompileSchema([]byte(`{ "type": "object", "properties": { "user": { "type": "integer" }, "password": { "type": "integer" } } }`))).To(Succeed())
+
+		err := v.ValidateGoStructOmitValues(map[string]interface{}{
+			"user":     "admin",
+			"password": "secret-value",
+		}, func(field string) bool {
+			return field == "password"
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("admin"))
+		Expect(err.Error()).To(ContainSubstring("password"))
+		Expect(err.Error()).ToNot(ContainSubstring("secret-value"))
+	})
+
 	Context("BlueprintReferenceTemplate", func() {
 		var config *jsonschema.ReferenceContext
 		BeforeEach(func() {
@@ -75,7 +75,19 @@ func (v *Validator) ValidateBytes(data []byte) error {
 	return v.validate(gojsonschema.NewBytesLoader(data))
 }
 
+// ValidateGoStructOmitValues validates the given data like ValidateGoStruct.
+// The values of invalid fields for which omitValue returns true are not part of the returned errors,
+// so that sensitive data does not end up in error messages.
+// The field is given as path with the root "(root)" as returned by gojsonschema.
+func (v *Validator) ValidateGoStructOmitValues(data interface{}, omitValue func(field string) bool) error {
+	return v.validateWithOmit(gojsonschema.NewGoLoader(data), omitValue)
+}
+
 func (v *Validator) validate(documentLoader gojsonschema.JSONLoader) error {
+	return v.validateWithOmit(documentLoader, nil)
+}
+
+func (v *Validator) validateWithOmit(documentLoader gojsonschema.JSONLoader, omitValue func(field string) bool) error {
 	if v.Schema == nil {
 		return errors.New("internal error: schema has not been compiled")
 	}
@@ -87,7 +99,11 @@ func (v *Validator) validate(documentLoader gojsonschema.JSONLoader) error {
 	if !res.Valid() {
 		var allErrs field.ErrorList
 		for _, err := range res.Errors() {
-			allErrs = append(allErrs, field.Invalid(field.NewPath(err.Field()), err.Value(), err.Description()))
+			var value interface{} = err.Value()
+			if omitValue != nil && omitValue(err.Field()) {
+				value = field.OmitValueType{}
+			}
+			allErrs = append(allErrs, field.Invalid(field.NewPath(err.Field()), value, err.Description()))
 		}
 		return allErrs.ToAggregate()
 	}
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// ItemState is the state of an item of a rollout in the current job.
+type ItemState int
+
+const (
+	// NotStarted items have not yet been started in the current job.
+	NotStarted ItemState = iota
+	// Running items have been started, but are not yet finished.
+	Running
+	// Succeeded items have finished successfully.
+	Succeeded
+	// Failed items have finished with an error.
+	Failed
+)
+
+// Decision describes whether a not started item may be started.
+type Decision int
+
+const (
+	// Allowed items may be started.
+	Allowed Decision = iota
+	// Blocked items must wait until the previous waves of their rollout have finished.
+	Blocked
+	// Waiting items belong to the next wave of their rollout, which waits for the pause between waves.
+	Waiting
+	// Stopped items are not started, because the failure budget of their rollout is exceeded.
+	Stopped
+)
+
+// Item is a deploy item or subinstallation that can be part of a rollout.
+type Item struct {
+	// Name identifies the item.
+	Name string
+	// Index is the position of the item in the rendered templates. The items of a rollout are ordered by index,
+	// so that the waves follow the order of the templates. Items with the same index are ordered by name.
+	Index int
+	// Policy is the rollout policy of the item. Items without policy do not belong to a rollout.
+	Policy *lsv1alpha1.RolloutPolicy
+	// State is the state of the item in the current job.
+	State ItemState
+	// FinishedTime is the time when the item finished the current job.
+	FinishedTime *metav1.Time
+}
+
+// Plan contains the decisions of all rollouts of a set of items.
+type Plan struct {
+	decisions map[string]Decision
+	tolerated map[string]bool
+	status    []lsv1alpha1.RolloutStatus
+}
+
+// NewPlan divides the items with a rollout policy into rollouts and waves, and decides which items may be started.
+func NewPlan(items []Item, now time.Time) *Plan {
+	p := &Plan{
+		decisions: map[string]Decision{},
+		tolerated: map[string]bool{},
+	}
+
+	items = append([]Item(nil), items...)
+	sortItems(items)
+
+	// the groups are planned in the order of their first item
+	groups := map[string][]Item{}
+	groupNames := []string{}
+	for _, item := range items {
+		if item.Policy == nil {
+			continue
+		}
+		if _, ok := groups[item.Policy.Group]; !ok {
+			groupNames = append(groupNames, item.Policy.Group)
+		}
+		groups[item.Policy.Group] = append(groups[item.Policy.Group], item)
+	}
+
+	for _, name := range groupNames {
+		p.planGroup(name, groups[name], now)
+	}
+
+	return p
+}
+
+// planGroup divides the items of a rollout, which are ordered by index, into waves.
+func (p *Plan) planGroup(group string, items []Item, now time.Time) {
+	policy := items[0].Policy
+
+	size := WaveSize(policy, len(items))
+	waves := (len(items) + size - 1) / size
+
+	status := lsv1alpha1.RolloutStatus{
+		Group: group,
+		Total: int32(len(items)),
+		Waves: int32(waves),
+	}
+	for _, item := range items {
+		switch item.State {
+		case Succeeded:
+			status.Succeeded++
+		case Failed:
+			status.Failed++
+		}
+	}
+
+	budget := int32(0)
+	if policy.FailureBudget != nil {
+		budget = *policy.FailureBudget
+	}
+	status.Stopped = status.Failed > budget
+
+	// the current wave is the first wave with unfinished items
+	currentWave := waves
+	for i, item := range items {
+		if item.State == NotStarted || item.State == Running {
+			currentWave = i / size
+			break
+		}
+	}
+	status.CurrentWave = int32(min(currentWave+1, waves))
+
+	var nextWaveTime *metav1.Time
+	if !status.Stopped && currentWave > 0 && currentWave < waves && !isWaveStarted(waveItems(items, currentWave, size)) {
+		nextWaveTime = getNextWaveTime(policy, waveItems(items, currentWave-1, size))
+		if nextWaveTime != nil && now.Before(nextWaveTime.Time) {
+			status.NextWaveTime = nextWaveTime
+		}
+	}
+
+	for i, item := range items {
+		wave := i / size
+		switch {
+		case item.State == Failed:
+			p.tolerated[item.Name] = !status.Stopped
+			p.decisions[item.Name] = Allowed
+		case item.State != NotStarted:
+			p.decisions[item.Name] = Allowed
+		case status.Stopped:
+			p.decisions[item.Name] = Stopped
+		case wave > currentWave:
+			p.decisions[item.Name] = Blocked
+		case status.NextWaveTime != nil:
+			p.decisions[item.Name] = Waiting
+		default:
+			p.decisions[item.Name] = Allowed
+		}
+	}
+
+	p.status = append(p.status, status)
+}
+
+// Decision returns whether the item with the given name may be started.
+// Items that do not belong to a rollout are always allowed.
+func (p *Plan) Decision(name string) Decision {
+	if decision, ok := p.decisions[name]; ok {
+		return decision
+	}
+	return Allowed
+}
+
+// IsFailureTolerated returns true if the item with the given name belongs to a rollout and its failure is within
+// the failure budget of the rollout.
+func (p *Plan) IsFailureTolerated(name string) bool {
+	return p.tolerated[name]
+}
+
+// Status returns the status of all rollouts, in the order of the first item of each rollout.
+func (p *Plan) Status() []lsv1alpha1.RolloutStatus {
+	if len(p.status) == 0 {
+		return nil
+	}
+	return p.status
+}
+
+// WaveSize returns the number of items of a wave of a rollout with the given number of items.
+func WaveSize(policy *lsv1alpha1.RolloutPolicy, total int) int {
+	size := total
+	if policy.MaxParallel != nil && int(*policy.MaxParallel) < size {
+		size = int(*policy.MaxParallel)
+	}
+	if policy.MaxParallelPercentage != nil {
+		percentageSize := (total*int(*policy.MaxParallelPercentage) + 99) / 100
+		if percentageSize < size {
+			size = percentageSize
+		}
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// RequeueAfter returns the time until the next wave of a rollout is started, or 0 if no rollout waits for a pause.
+func RequeueAfter(status []lsv1alpha1.RolloutStatus, now time.Time) time.Duration {
+	var result time.Duration
+	for _, s := range status {
+		if s.NextWaveTime == nil {
+			continue
+		}
+		d := s.NextWaveTime.Sub(now)
+		if d <= 0 {
+			d = time.Second
+		}
+		if result == 0 || d < result {
+			result = d
+		}
+	}
+	return result
+}
+
+// sortItems orders the items by index, and items with the same index by name.
+func sortItems(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Index != items[j].Index {
+			return items[i].Index < items[j].Index
+		}
+		return items[i].Name < items[j].Name
+	})
+}
+
+func waveItems(items []Item, wave, size int) []Item {
+	start := wave * size
+	end := min(start+size, len(items))
+	return items[start:end]
+}
+
+func isWaveStarted(items []Item) bool {
+	for _, item := range items {
+		if item.State != NotStarted {
+			return true
+		}
+	}
+	return false
+}
+
+// getNextWaveTime returns the time when the pause after the given wave ends.
+func getNextWaveTime(policy *lsv1alpha1.RolloutPolicy, items []Item) *metav1.Time {
+	if policy.PauseBetweenWaves == nil || policy.PauseBetweenWaves.Duration <= 0 {
+		return nil
+	}
+
+	var finished *metav1.Time
+	for _, item := range items {
+		if item.FinishedTime != nil && (finished == nil || finished.Before(item.FinishedTime)) {
+			finished = item.FinishedTime
+		}
+	}
+	if finished == nil {
+		return nil
+	}
+
+	next := metav1.NewTime(finished.Add(policy.PauseBetweenWaves.Duration))
+	return &next
+}
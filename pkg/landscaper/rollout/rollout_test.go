@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/rollout"
+)
+
+var _ = Describe("Rollout", func() {
+
+	now := time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	newItems := func(n int, policy *lsv1alpha1.RolloutPolicy, states ...rollout.ItemState) []rollout.Item {
+		items := make([]rollout.Item, n)
+		for i := range items {
+			items[i] = rollout.Item{Name: fmt.Sprintf("item-%02d", i), Index: i, Policy: policy}
+			if i < len(states) {
+				items[i].State = states[i]
+			}
+		}
+		return items
+	}
+
+	decisions := func(plan *rollout.Plan, items []rollout.Item) []rollout.Decision {
+		result := make([]rollout.Decision, len(items))
+		for i := range items {
+			result[i] = plan.Decision(items[i].Name)
+		}
+		return result
+	}
+
+	DescribeTable("wave size",
+		func(maxParallel, percentage *int32, total, expected int) {
+			policy := &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: maxParallel, MaxParallelPercentage: percentage}
+			Expect(rollout.WaveSize(policy, total)).To(Equal(expected))
+		},
+		Entry("without limits", nil, nil, 10, 10),
+		Entry("with max parallel", ptr.To[int32](3), nil, 10, 3),
+		Entry("with percentage", nil, ptr.To[int32](25), 10, 3),
+		Entry("with small percentage", nil, ptr.To[int32](1), 10, 1),
+		Entry("with both limits", ptr.To[int32](2), ptr.To[int32](50), 10, 2),
+	)
+
+	It("should only allow the first wave to start", func() {
+		items := newItems(5, &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](2)})
+
+		plan := rollout.NewPlan(items, now)
+		Expect(decisions(plan, items)).To(Equal([]rollout.Decision{
+			rollout.Allowed, rollout.Allowed, rollout.Blocked, rollout.Blocked, rollout.Blocked,
+		}))
+		Expect(plan.Status()).To(ConsistOf(lsv1alpha1.RolloutStatus{
+			Group: "g", Total: 5, Waves: 3, CurrentWave: 1,
+		}))
+	})
+
+	It("should start the next wave if the previous wave has finished", func() {
+		items := newItems(5, &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](2)},
+			rollout.Succeeded, rollout.Succeeded)
+
+		plan := rollout.NewPlan(items, now)
+		Expect(decisions(plan, items)[2:]).To(Equal([]rollout.Decision{rollout.Allowed, rollout.Allowed, rollout.Blocked}))
+		Expect(plan.Status()[0].CurrentWave).To(Equal(int32(2)))
+		Expect(plan.Status()[0].Succeeded).To(Equal(int32(2)))
+	})
+
+	It("should wait for the pause between waves", func() {
+		policy := &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](1),
+			PauseBetweenWaves: &lsv1alpha1.Duration{Duration: 10 * time.Minute}}
+		items := newItems(2, policy, rollout.Succeeded)
+		finished := metav1.NewTime(now.Add(-5 * time.Minute))
+		items[0].FinishedTime = &finished
+
+		plan := rollout.NewPlan(items, now)
+		Expect(plan.Decision(items[1].Name)).To(Equal(rollout.Waiting))
+		Expect(plan.Status()[0].NextWaveTime).ToNot(BeNil())
+		Expect(rollout.RequeueAfter(plan.Status(), now)).To(Equal(5 * time.Minute))
+
+		plan = rollout.NewPlan(items, now.Add(6*time.Minute))
+		Expect(plan.Decision(items[1].Name)).To(Equal(rollout.Allowed))
+		Expect(plan.Status()[0].NextWaveTime).To(BeNil())
+	})
+
+	It("should continue the rollout if the failures are within the failure budget", func() {
+		items := newItems(4, &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](2), FailureBudget: ptr.To[int32](1)},
+			rollout.Failed, rollout.Succeeded)
+
+		plan := rollout.NewPlan(items, now)
+		Expect(plan.IsFailureTolerated(items[0].Name)).To(BeTrue())
+		Expect(decisions(plan, items)[2:]).To(Equal([]rollout.Decision{rollout.Allowed, rollout.Allowed}))
+		Expect(plan.Status()[0].Stopped).To(BeFalse())
+	})
+
+	It("should stop the rollout if the failure budget is exceeded", func() {
+		items := newItems(4, &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](2)},
+			rollout.Failed, rollout.Running)
+
+		plan := rollout.NewPlan(items, now)
+		Expect(plan.IsFailureTolerated(items[0].Name)).To(BeFalse())
+		Expect(decisions(plan, items)[2:]).To(Equal([]rollout.Decision{rollout.Stopped, rollout.Stopped}))
+		Expect(plan.Status()[0].Stopped).To(BeTrue())
+		Expect(plan.Status()[0].Failed).To(Equal(int32(1)))
+	})
+
+	It("should build the waves in the order of the templates", func() {
+		policy := &lsv1alpha1.RolloutPolicy{Group: "g", MaxParallel: ptr.To[int32](2)}
+		items := make([]rollout.Item, 12)
+		for i := range items {
+			items[i] = rollout.Item{Name: fmt.Sprintf("x-%d", i), Index: i, Policy: policy}
+		}
+		// the order of the items passed to the plan does not matter
+		shuffled := append([]rollout.Item{}, items[10:]...)
+		shuffled = append(shuffled, items[:10]...)
+
+		plan := rollout.NewPlan(shuffled, now)
+		Expect(decisions(plan, items)).To(Equal([]rollout.Decision{
+			rollout.Allowed, rollout.Allowed, rollout.Blocked, rollout.Blocked, rollout.Blocked, rollout.Blocked,
+			rollout.Blocked, rollout.Blocked, rollout.Blocked, rollout.Blocked, rollout.Blocked, rollout.Blocked,
+		}))
+	})
+
+	It("should order the status of the rollouts by their first item", func() {
+		items := []rollout.Item{
+			{Name: "b-0", Index: 0, Policy: &lsv1alpha1.RolloutPolicy{Group: "b"}},
+			{Name: "a-0", Index: 1, Policy: &lsv1alpha1.RolloutPolicy{Group: "a"}},
+			{Name: "b-1", Index: 2, Policy: &lsv1alpha1.RolloutPolicy{Group: "b"}},
+		}
+
+		plan := rollout.NewPlan(items, now)
+		Expect(plan.Status()).To(HaveLen(2))
+		Expect(plan.Status()[0].Group).To(Equal("b"))
+		Expect(plan.Status()[0].Total).To(Equal(int32(2)))
+		Expect(plan.Status()[1].Group).To(Equal("a"))
+	})
+
+	It("should always allow items without rollout", func() {
+		items := []rollout.Item{{Name: "a"}, {Name: "b"}}
+
+		plan := rollout.NewPlan(items, now)
+		Expect(decisions(plan, items)).To(Equal([]rollout.Decision{rollout.Allowed, rollout.Allowed}))
+		Expect(plan.Status()).To(BeNil())
+	})
+})
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	helmChartCacheSubsystemName = "helm_chart_cache"
+
+	// LabelCacheTier is the label for the tier of the helm chart cache.
+	LabelCacheTier = "tier"
+	// LabelCacheResult is the label for the result of a lookup in the helm chart cache.
+	LabelCacheResult = "result"
+
+	// CacheTierMemory is the in-memory tier of the helm chart cache.
+	CacheTierMemory = "memory"
+	// CacheTierDisk is the filesystem tier of the helm chart cache.
+	CacheTierDisk = "disk"
+
+	// CacheResultHit is used for lookups that found a chart.
+	CacheResultHit = "hit"
+	// CacheResultMiss is used for lookups that did not find a chart.
+	CacheResultMiss = "miss"
+)
+
+var (
+	// HelmChartCacheLookups counts the lookups in the helm chart cache, by tier and result.
+	HelmChartCacheLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: helmChartCacheSubsystemName,
+			Name:      "lookups_total",
+			Help:      "Total number of lookups in the helm chart cache, by tier and result.",
+		},
+		[]string{LabelCacheTier, LabelCacheResult},
+	)
+
+	// HelmChartCacheDiskItems discloses the number of files in the filesystem tier of the helm chart cache.
+	HelmChartCacheDiskItems = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: helmChartCacheSubsystemName,
+			Name:      "disk_items",
+			Help:      "Number of files in the filesystem tier of the helm chart cache.",
+		},
+	)
+
+	// HelmChartCacheDiskUsage discloses the size of the filesystem tier of the helm chart cache.
+	HelmChartCacheDiskUsage = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: helmChartCacheSubsystemName,
+			Name:      "disk_usage_bytes",
+			Help:      "Size in bytes of the files in the filesystem tier of the helm chart cache.",
+		},
+	)
+)
+
+// RegisterHelmChartCacheMetrics registers the helm chart cache metrics with a given prometheus registerer.
+func RegisterHelmChartCacheMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(HelmChartCacheLookups)
+	reg.MustRegister(HelmChartCacheDiskItems)
+	reg.MustRegister(HelmChartCacheDiskUsage)
+}
+
+// RecordHelmChartCacheLookup records a lookup in a tier of the helm chart cache.
+func RecordHelmChartCacheLookup(tier string, hit bool) {
+	result := CacheResultMiss
+	if hit {
+		result = CacheResultHit
+	}
+	HelmChartCacheLookups.WithLabelValues(tier, result).Inc()
+}
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+const (
+	installationSubsystemName = "installation"
+	executionSubsystemName    = "execution"
+	deployItemSubsystemName   = "deployitem"
+
+	// LabelNamespace is the label for the namespace of the reconciled object.
+	LabelNamespace = "namespace"
+	// LabelRootInstallation is the label for the name of the root installation the reconciled object belongs to.
+	LabelRootInstallation = "root_installation"
+	// LabelDeployerType is the label for the type of a deploy item.
+	LabelDeployerType = "deployer_type"
+	// LabelPhase is the label for the phase of the reconciled object.
+	LabelPhase = "phase"
+	// LabelErrorCode is the label for the error code of a failed object.
+	LabelErrorCode = "error_code"
+	// LabelRetryReason is the label that describes whether a retry was triggered for a failed or a succeeded installation.
+	LabelRetryReason = "reason"
+	// LabelTimeout is the label for the kind of timeout of a deploy item.
+	LabelTimeout = "timeout"
+
+	// RetryReasonFailed is used for retries of failed installations.
+	RetryReasonFailed = "failed"
+	// RetryReasonSucceeded is used for periodic reconciles of succeeded installations.
+	RetryReasonSucceeded = "succeeded"
+
+	// TimeoutPickup is used if no deployer picked up a deploy item in time.
+	TimeoutPickup = "pickup"
+	// TimeoutProgressing is used if a deployer did not finish a deploy item in time.
+	TimeoutProgressing = "progressing"
+
+	// noErrorCode is used as error code label if a failed object has no error code.
+	noErrorCode = "none"
+)
+
+var (
+	// reconcileDurationBuckets covers durations from a few milliseconds up to several minutes.
+	reconcileDurationBuckets = prometheus.ExponentialBuckets(0.005, 2, 16)
+	// jobDurationBuckets covers durations from a second up to several hours.
+	jobDurationBuckets = prometheus.ExponentialBuckets(1, 2, 16)
+
+	installationLabels = []string{LabelNamespace, LabelRootInstallation}
+	executionLabels    = []string{LabelNamespace, LabelRootInstallation}
+	deployItemLabels   = []string{LabelNamespace, LabelDeployerType, LabelRootInstallation}
+)
+
+var (
+	// InstallationPhaseTransitions counts the phase transitions of installations.
+	InstallationPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of installations.",
+		},
+		append(installationLabels, LabelPhase),
+	)
+
+	// InstallationReconcileDuration discloses the duration of single reconcile runs of the installation controller.
+	InstallationReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of single reconcile runs of the installation controller.",
+			Buckets:   reconcileDurationBuckets,
+		},
+		installationLabels,
+	)
+
+	// InstallationJobDuration discloses the time from triggering an installation until it reaches a final phase.
+	InstallationJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time from the trigger of an installation job until the installation reaches a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		append(installationLabels, LabelPhase),
+	)
+
+	// InstallationFailures counts installations that ended in a failed phase, by error code.
+	InstallationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of installations that ended in a failed phase, by error code.",
+		},
+		append(installationLabels, LabelErrorCode),
+	)
+
+	// InstallationRetries counts the automatic reconciles triggered by the retry mechanism of installations.
+	InstallationRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "retries_total",
+			Help:      "Total number of automatic reconciles triggered for failed or succeeded installations.",
+		},
+		append(installationLabels, LabelRetryReason),
+	)
+
+	// ExecutionPhaseTransitions counts the phase transitions of executions.
+	ExecutionPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of executions.",
+		},
+		append(executionLabels, LabelPhase),
+	)
+
+	// ExecutionReconcileDuration discloses the duration of single reconcile runs of the execution controller.
+	ExecutionReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of single reconcile runs of the execution controller.",
+			Buckets:   reconcileDurationBuckets,
+		},
+		executionLabels,
+	)
+
+	// ExecutionJobDuration discloses the time from triggering an execution until it reaches a final phase.
+	ExecutionJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time from the trigger of an execution job until the execution reaches a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		append(executionLabels, LabelPhase),
+	)
+
+	// ExecutionFailures counts executions that ended in a failed phase, by error code.
+	ExecutionFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of executions that ended in a failed phase, by error code.",
+		},
+		append(executionLabels, LabelErrorCode),
+	)
+
+	// DeployItemPhaseTransitions counts the phase transitions of deploy items.
+	DeployItemPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of deploy items.",
+		},
+		append(deployItemLabels, LabelPhase),
+	)
+
+	// DeployItemReconcileDuration discloses the duration of single reconcile runs of a deployer.
+	DeployItemReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of single reconcile runs of a deployer.",
+			Buckets:   reconcileDurationBuckets,
+		},
+		deployItemLabels,
+	)
+
+	// DeployItemJobDuration discloses the time from triggering a deploy item until it reaches a final phase.
+	DeployItemJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Time from the trigger of a deploy item job until the deploy item reaches a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		append(deployItemLabels, LabelPhase),
+	)
+
+	// DeployItemFailures counts deploy items that ended in a failed phase, by error code.
+	DeployItemFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "failures_total",
+			Help:      "Total number of deploy items that ended in a failed phase, by error code.",
+		},
+		append(deployItemLabels, LabelErrorCode),
+	)
+
+	// DeployItemTimeouts counts pickup and progressing timeouts of deploy items.
+	DeployItemTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "timeouts_total",
+			Help:      "Total number of deploy items that failed because of a pickup or progressing timeout.",
+		},
+		append(deployItemLabels, LabelTimeout),
+	)
+)
+
+// RegisterLifecycleMetrics registers the installation and execution metrics with a given prometheus registerer.
+func RegisterLifecycleMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(InstallationPhaseTransitions)
+	reg.MustRegister(InstallationReconcileDuration)
+	reg.MustRegister(InstallationJobDuration)
+	reg.MustRegister(InstallationFailures)
+	reg.MustRegister(InstallationRetries)
+	reg.MustRegister(ExecutionPhaseTransitions)
+	reg.MustRegister(ExecutionReconcileDuration)
+	reg.MustRegister(ExecutionJobDuration)
+	reg.MustRegister(ExecutionFailures)
+	RegisterDeployItemMetrics(reg)
+}
+
+// RegisterDeployItemMetrics registers the deploy item metrics with a given prometheus registerer.
+// The deploy item metrics are exposed by the landscaper controller (pickup timeouts) and by the deployers.
+func RegisterDeployItemMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(DeployItemPhaseTransitions)
+	reg.MustRegister(DeployItemReconcileDuration)
+	reg.MustRegister(DeployItemJobDuration)
+	reg.MustRegister(DeployItemFailures)
+	reg.MustRegister(DeployItemTimeouts)
+}
+
+// RecordInstallationPhase records the transition of an installation into its current phase.
+// It has to be called after the phase has been changed, i.e. when the status is written.
+// rootInstallation is the name of the root installation of the installation tree.
+func RecordInstallationPhase(inst *lsv1alpha1.Installation, rootInstallation string) {
+	phase := string(inst.Status.InstallationPhase)
+	InstallationPhaseTransitions.WithLabelValues(inst.Namespace, rootInstallation, phase).Inc()
+
+	if !inst.Status.InstallationPhase.IsFinal() {
+		return
+	}
+
+	if d, ok := jobDuration(inst.Status.TransitionTimes); ok {
+		InstallationJobDuration.WithLabelValues(inst.Namespace, rootInstallation, phase).Observe(d.Seconds())
+	}
+
+	if inst.Status.InstallationPhase.IsFailed() {
+		for _, code := range errorCodes(inst.Status.LastError) {
+			InstallationFailures.WithLabelValues(inst.Namespace, rootInstallation, code).Inc()
+		}
+	}
+}
+
+// ObserveInstallationReconcile records the duration of a reconcile run of an installation.
+func ObserveInstallationReconcile(inst *lsv1alpha1.Installation, rootInstallation string, d time.Duration) {
+	InstallationReconcileDuration.WithLabelValues(inst.Namespace, rootInstallation).Observe(d.Seconds())
+}
+
+// RecordInstallationRetry records an automatic reconcile that was triggered for an installation.
+func RecordInstallationRetry(inst *lsv1alpha1.Installation, rootInstallation string, onFailed bool) {
+	reason := RetryReasonSucceeded
+	if onFailed {
+		reason = RetryReasonFailed
+	}
+	InstallationRetries.WithLabelValues(inst.Namespace, rootInstallation, reason).Inc()
+}
+
+// RecordExecutionPhase records the transition of an execution into its current phase.
+// It has to be called after the phase has been changed, i.e. when the status is written.
+func RecordExecutionPhase(exec *lsv1alpha1.Execution) {
+	rootInstallation := lsv1alpha1helper.GetRootInstallationName(exec.ObjectMeta)
+	phase := string(exec.Status.ExecutionPhase)
+	ExecutionPhaseTransitions.WithLabelValues(exec.Namespace, rootInstallation, phase).Inc()
+
+	if !exec.Status.ExecutionPhase.IsFinal() {
+		return
+	}
+
+	if d, ok := jobDuration(exec.Status.TransitionTimes); ok {
+		ExecutionJobDuration.WithLabelValues(exec.Namespace, rootInstallation, phase).Observe(d.Seconds())
+	}
+
+	if exec.Status.ExecutionPhase.IsFailed() {
+		for _, code := range errorCodes(exec.Status.LastError) {
+			ExecutionFailures.WithLabelValues(exec.Namespace, rootInstallation, code).Inc()
+		}
+	}
+}
+
+// ObserveExecutionReconcile records the duration of a reconcile run of an execution.
+func ObserveExecutionReconcile(exec *lsv1alpha1.Execution, d time.Duration) {
+	rootInstallation := lsv1alpha1helper.GetRootInstallationName(exec.ObjectMeta)
+	ExecutionReconcileDuration.WithLabelValues(exec.Namespace, rootInstallation).Observe(d.Seconds())
+}
+
+// RecordDeployItemPhase records the transition of a deploy item into its current phase.
+// It has to be called after the phase has been changed, i.e. when the status is written.
+func RecordDeployItemPhase(di *lsv1alpha1.DeployItem) {
+	labels := deployItemLabelValues(di)
+	phase := string(di.Status.Phase)
+	DeployItemPhaseTransitions.WithLabelValues(append(labels, phase)...).Inc()
+
+	if !di.Status.Phase.IsFinal() {
+		return
+	}
+
+	if d, ok := jobDuration(di.Status.TransitionTimes); ok {
+		DeployItemJobDuration.WithLabelValues(append(labels, phase)...).Observe(d.Seconds())
+	}
+
+	if di.Status.Phase.IsFailed() {
+		for _, code := range errorCodes(di.Status.LastError) {
+			DeployItemFailures.WithLabelValues(append(labels, code)...).Inc()
+		}
+	}
+}
+
+// ObserveDeployItemReconcile records the duration of a reconcile run of a deploy item.
+func ObserveDeployItemReconcile(di *lsv1alpha1.DeployItem, d time.Duration) {
+	DeployItemReconcileDuration.WithLabelValues(deployItemLabelValues(di)...).Observe(d.Seconds())
+}
+
+// RecordDeployItemTimeout records a pickup or progressing timeout of a deploy item.
+func RecordDeployItemTimeout(di *lsv1alpha1.DeployItem, timeout string) {
+	DeployItemTimeouts.WithLabelValues(append(deployItemLabelValues(di), timeout)...).Inc()
+}
+
+func deployItemLabelValues(di *lsv1alpha1.DeployItem) []string {
+	return []string{di.Namespace, string(di.Spec.Type), lsv1alpha1helper.GetRootInstallationName(di.ObjectMeta)}
+}
+
+// jobDuration returns the time between the trigger and the finished time of a job.
+func jobDuration(times *lsv1alpha1.TransitionTimes) (time.Duration, bool) {
+	if times == nil || times.TriggerTime == nil || times.FinishedTime == nil {
+		return 0, false
+	}
+	return times.FinishedTime.Sub(times.TriggerTime.Time), true
+}
+
+func errorCodes(lastError *lsv1alpha1.Error) []string {
+	if lastError == nil || len(lastError.Codes) == 0 {
+		return []string{noErrorCode}
+	}
+	codes := make([]string, len(lastError.Codes))
+	for i, code := range lastError.Codes {
+		codes[i] = string(code)
+	}
+	return codes
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/metrics"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Metrics Test Suite")
+}
+
+// value returns the value of the counter or the sample count of the histogram with the given name and label values.
+func value(reg *prometheus.Registry, name string, labels map[string]string) float64 {
+	families, err := reg.Gather()
+	Expect(err).ToNot(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metricLoop:
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metricLoop
+				}
+			}
+			if m.GetHistogram() != nil {
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+			return m.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+// with returns a copy of the given labels extended by one label.
+func with(labels map[string]string, name, value string) map[string]string {
+	res := map[string]string{name: value}
+	for k, v := range labels {
+		res[k] = v
+	}
+	return res
+}
+
+var _ = Describe("Lifecycle Metrics", func() {
+
+	var reg *prometheus.Registry
+
+	BeforeEach(func() {
+		reg = prometheus.NewRegistry()
+		metrics.RegisterLifecycleMetrics(reg)
+	})
+
+	transitionTimes := func(duration time.Duration) *lsv1alpha1.TransitionTimes {
+		trigger := metav1.NewTime(time.Now().Add(-duration))
+		finished := metav1.Now()
+		return &lsv1alpha1.TransitionTimes{TriggerTime: &trigger, FinishedTime: &finished}
+	}
+
+	It("should record phase transitions, job durations and failures of installations", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Namespace = "inst-test"
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Init
+		metrics.RecordInstallationPhase(inst, "root")
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Failed
+		inst.Status.TransitionTimes = transitionTimes(time.Minute)
+		inst.Status.LastError = &lsv1alpha1.Error{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}}
+		metrics.RecordInstallationPhase(inst, "root")
+
+		labels := map[string]string{metrics.LabelNamespace: "inst-test", metrics.LabelRootInstallation: "root"}
+		Expect(value(reg, "ociclient_installation_phase_transitions_total", with(labels, metrics.LabelPhase, "Init"))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_installation_phase_transitions_total", with(labels, metrics.LabelPhase, "Failed"))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_installation_failures_total", with(labels, metrics.LabelErrorCode, string(lsv1alpha1.ErrorConfigurationProblem)))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_installation_job_duration_seconds", with(labels, metrics.LabelPhase, "Failed"))).To(Equal(1.0))
+	})
+
+	It("should record retries of installations", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Namespace = "retry-test"
+		metrics.RecordInstallationRetry(inst, "root", true)
+		metrics.RecordInstallationRetry(inst, "root", false)
+		metrics.RecordInstallationRetry(inst, "root", false)
+
+		labels := map[string]string{metrics.LabelNamespace: "retry-test", metrics.LabelRootInstallation: "root"}
+		Expect(value(reg, "ociclient_installation_retries_total", with(labels, metrics.LabelRetryReason, metrics.RetryReasonFailed))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_installation_retries_total", with(labels, metrics.LabelRetryReason, metrics.RetryReasonSucceeded))).To(Equal(2.0))
+	})
+
+	It("should label deploy item metrics with the deployer type and the root installation", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Namespace = "di-test"
+		di.Annotations = map[string]string{lsv1alpha1.RootInstallationAnnotation: "root"}
+		di.Spec.Type = "landscaper.gardener.cloud/helm"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Failed
+		di.Status.TransitionTimes = transitionTimes(time.Second)
+		metrics.RecordDeployItemPhase(di)
+		metrics.RecordDeployItemTimeout(di, metrics.TimeoutPickup)
+
+		labels := map[string]string{
+			metrics.LabelNamespace:        "di-test",
+			metrics.LabelDeployerType:     "landscaper.gardener.cloud/helm",
+			metrics.LabelRootInstallation: "root",
+		}
+		Expect(value(reg, "ociclient_deployitem_phase_transitions_total", with(labels, metrics.LabelPhase, "Failed"))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_deployitem_failures_total", with(labels, metrics.LabelErrorCode, "none"))).To(Equal(1.0))
+		Expect(value(reg, "ociclient_deployitem_timeouts_total", with(labels, metrics.LabelTimeout, metrics.TimeoutPickup))).To(Equal(1.0))
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Reasons of the events that are emitted for the lifecycle of installations, executions and deploy items.
+const (
+	// PhaseChangedEventReason is the reason of the events for phase transitions.
+	PhaseChangedEventReason = "PhaseChanged"
+	// ImportsNotSatisfiedEventReason is the reason of the events for imports of an installation that are not available.
+	ImportsNotSatisfiedEventReason = "ImportsNotSatisfied"
+	// BlueprintResolutionFailedEventReason is the reason of the events for blueprints that could not be resolved.
+	BlueprintResolutionFailedEventReason = "BlueprintResolutionFailed"
+	// SchemaValidationFailedEventReason is the reason of the events for imports that do not match their schema.
+	SchemaValidationFailedEventReason = "SchemaValidationFailed"
+	// PickupTimeoutEventReason is the reason of the events for deploy items that were not picked up by a deployer.
+	PickupTimeoutEventReason = lsv1alpha1.PickupTimeoutReason
+	// ProgressingTimeoutEventReason is the reason of the events for deploy item jobs that failed due to a progressing timeout.
+	ProgressingTimeoutEventReason = lsv1alpha1.ProgressingTimeoutReason
+	// DeletionBlockedEventReason is the reason of the events for installations whose deletion waits for successors.
+	DeletionBlockedEventReason = "DeletionBlocked"
+	// RetryEventReason is the reason of the events for automatic retries of installations.
+	RetryEventReason = "Retry"
+	// RevisionFailedEventReason is the reason of the events for revisions of installations that could not be recorded.
+	RevisionFailedEventReason = "RevisionFailed"
+	// PruneProtectedEventReason is the reason of the events for orphaned resources that are not deleted due to a prune protection.
+	PruneProtectedEventReason = "PruneProtected"
+)
+
+// Phase is implemented by the phases of installations, executions and deploy items.
+type Phase interface {
+	String() string
+	IsFailed() bool
+}
+
+// RecordPhaseChangedEvent emits an event for the transition of an object from one phase into another.
+// Transitions into a failed phase are emitted as warnings.
+func RecordPhaseChangedEvent(recorder record.EventRecorder, obj runtime.Object, oldPhase, newPhase Phase) {
+	if recorder == nil || oldPhase.String() == newPhase.String() {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if newPhase.IsFailed() {
+		eventType = corev1.EventTypeWarning
+	}
+
+	if len(oldPhase.String()) == 0 {
+		recorder.Eventf(obj, eventType, PhaseChangedEventReason, "phase changed to %s", newPhase.String())
+		return
+	}
+	recorder.Eventf(obj, eventType, PhaseChangedEventReason, "phase changed from %s to %s", oldPhase.String(), newPhase.String())
+}
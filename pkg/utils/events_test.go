@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("Events", func() {
+
+	var (
+		recorder *record.FakeRecorder
+		inst     *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		inst = &lsv1alpha1.Installation{}
+	})
+
+	It("should record a phase transition", func() {
+		lsutil.RecordPhaseChangedEvent(recorder, inst, lsv1alpha1.InstallationPhases.Init, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(recorder.Events).To(Receive(Equal("Normal PhaseChanged phase changed from Init to Progressing")))
+	})
+
+	It("should record a transition into a failed phase as warning", func() {
+		lsutil.RecordPhaseChangedEvent(recorder, inst, lsv1alpha1.InstallationPhases.Progressing, lsv1alpha1.InstallationPhases.Failed)
+		Expect(recorder.Events).To(Receive(Equal("Warning PhaseChanged phase changed from Progressing to Failed")))
+	})
+
+	It("should record the first phase without previous phase", func() {
+		lsutil.RecordPhaseChangedEvent(recorder, inst, lsv1alpha1.InstallationPhase(""), lsv1alpha1.InstallationPhases.Init)
+		Expect(recorder.Events).To(Receive(Equal("Normal PhaseChanged phase changed to Init")))
+	})
+
+	It("should not record an event if the phase is unchanged", func() {
+		lsutil.RecordPhaseChangedEvent(recorder, inst, lsv1alpha1.InstallationPhases.Succeeded, lsv1alpha1.InstallationPhases.Succeeded)
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("should ignore a missing recorder", func() {
+		Expect(func() {
+			lsutil.RecordPhaseChangedEvent(nil, inst, lsv1alpha1.InstallationPhases.Init, lsv1alpha1.InstallationPhases.Succeeded)
+		}).ToNot(Panic())
+	})
+})
@@ -44,6 +44,12 @@ func EmptyDeployItemMetadata() *metav1.PartialObjectMetadata {
 	return metadata
 }
 
+func EmptyDeployItemMetadataList() *metav1.PartialObjectMetadataList {
+	metadata := &metav1.PartialObjectMetadataList{}
+	metadata.SetGroupVersionKind(DeployItemGVK)
+	return metadata
+}
+
 func EmptyExecutionMetadata() *metav1.PartialObjectMetadata {
 	metadata := &metav1.PartialObjectMetadata{}
 	metadata.SetGroupVersionKind(ExecutionGVK)
@@ -153,6 +153,35 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
+	W000159 WriteID = "w000159"
+	W000160 WriteID = "w000160"
+	W000161 WriteID = "w000161"
+	W000162 WriteID = "w000162"
+	W000163 WriteID = "w000163"
+	W000164 WriteID = "w000164"
+	W000165 WriteID = "w000165"
+	W000166 WriteID = "w000166"
+	W000167 WriteID = "w000167"
+	W000168 WriteID = "w000168"
+	W000169 WriteID = "w000169"
+	W000170 WriteID = "w000170"
+	W000171 WriteID = "w000171"
+	W000172 WriteID = "w000172"
+	W000173 WriteID = "w000173"
+	W000174 WriteID = "w000174"
+	W000175 WriteID = "w000175"
+	W000176 WriteID = "w000176"
+	W000177 WriteID = "w000177"
+	W000178 WriteID = "w000178"
 )
 
 type ReadID string
@@ -270,6 +299,33 @@ const (
 	R000108 ReadID = "r000108"
 	R000109 ReadID = "r000109"
 	R000110 ReadID = "r000110"
+	R000111 ReadID = "r000111"
+	R000112 ReadID = "r000112"
+	R000113 ReadID = "r000113"
+	R000114 ReadID = "r000114"
+	R000115 ReadID = "r000115"
+	R000116 ReadID = "r000116"
+	R000117 ReadID = "r000117"
+	R000118 ReadID = "r000118"
+	R000119 ReadID = "r000119"
+	R000120 ReadID = "r000120"
+	R000121 ReadID = "r000121"
+	R000122 ReadID = "r000122"
+	R000123 ReadID = "r000123"
+	R000124 ReadID = "r000124"
+	R000125 ReadID = "r000125"
+	R000126 ReadID = "r000126"
+	R000127 ReadID = "r000127"
+	R000128 ReadID = "r000128"
+	R000129 ReadID = "r000129"
+	R000130 ReadID = "r000130"
+	R000131 ReadID = "r000131"
+	R000132 ReadID = "r000132"
+	R000133 ReadID = "r000133"
+	R000134 ReadID = "r000134"
+	R000135 ReadID = "r000135"
+	R000136 ReadID = "r000136"
+	R000137 ReadID = "r000137"
 )
 
 const (
@@ -8,6 +8,7 @@ import (
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -129,6 +130,11 @@ func ListPods(ctx context.Context, c client.Reader, pods *v1.PodList, readID Rea
 	return list(ctx, c, pods, readID, "pods", opts...)
 }
 
+// read methods for jobs
+func ListJobs(ctx context.Context, c client.Reader, jobs *batchv1.JobList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, jobs, readID, "jobs", opts...)
+}
+
 // read methods for namespaces
 func ListNamespaces(ctx context.Context, c client.Reader, namespaces *v1.NamespaceList, readID ReadID, opts ...client.ListOption) error {
 	return list(ctx, c, namespaces, readID, "namespaces", opts...)
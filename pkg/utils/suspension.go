@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+const (
+	// SuspendedReason is the reason of a Suspended condition with status true.
+	SuspendedReason = "Suspended"
+	// ResumedReason is the reason of a Suspended condition with status false.
+	ResumedReason = "Resumed"
+)
+
+// SetSuspendedLabel sets or removes the suspended label. It returns whether the label has changed.
+func SetSuspendedLabel(obj *metav1.ObjectMeta, suspended bool) bool {
+	if (obj.Labels[lsv1alpha1.SuspendedLabel] == "true") == suspended {
+		return false
+	}
+	if suspended {
+		metav1.SetMetaDataLabel(obj, lsv1alpha1.SuspendedLabel, "true")
+	} else {
+		delete(obj.Labels, lsv1alpha1.SuspendedLabel)
+	}
+	return true
+}
+
+// IsSuspendedConditionTrue returns whether the conditions contain a Suspended condition with status true.
+func IsSuspendedConditionTrue(conditions []lsv1alpha1.Condition) bool {
+	cond := lsv1alpha1helper.GetCondition(conditions, lsv1alpha1.SuspendedCondition)
+	return cond != nil && cond.Status == lsv1alpha1.ConditionTrue
+}
+
+// UpdateSuspendedCondition computes the Suspended condition. A condition with status false is only set if the
+// conditions already contain a Suspended condition.
+// It returns the updated conditions and whether the condition has changed.
+func UpdateSuspendedCondition(conditions []lsv1alpha1.Condition, suspended bool) ([]lsv1alpha1.Condition, bool) {
+	oldCondition := lsv1alpha1helper.GetCondition(conditions, lsv1alpha1.SuspendedCondition)
+	if oldCondition == nil && !suspended {
+		return conditions, false
+	}
+
+	newCondition := lsv1alpha1helper.GetOrInitCondition(conditions, lsv1alpha1.SuspendedCondition)
+	if suspended {
+		newCondition = lsv1alpha1helper.UpdatedCondition(newCondition, lsv1alpha1.ConditionTrue, SuspendedReason,
+			"the processing is suspended")
+	} else {
+		newCondition = lsv1alpha1helper.UpdatedCondition(newCondition, lsv1alpha1.ConditionFalse, ResumedReason,
+			"the processing has been resumed")
+	}
+
+	if oldCondition != nil && reflect.DeepEqual(*oldCondition, newCondition) {
+		return conditions, false
+	}
+	return lsv1alpha1helper.MergeConditions(conditions, newCondition), true
+}
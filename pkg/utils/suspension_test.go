@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("Suspension", func() {
+
+	It("should set and remove the suspended label", func() {
+		obj := &metav1.ObjectMeta{}
+		Expect(lsutil.SetSuspendedLabel(obj, false)).To(BeFalse())
+
+		Expect(lsutil.SetSuspendedLabel(obj, true)).To(BeTrue())
+		Expect(lsv1alpha1helper.IsSuspended(*obj)).To(BeTrue())
+		Expect(lsutil.SetSuspendedLabel(obj, true)).To(BeFalse())
+
+		Expect(lsutil.SetSuspendedLabel(obj, false)).To(BeTrue())
+		Expect(lsv1alpha1helper.IsSuspended(*obj)).To(BeFalse())
+	})
+
+	It("should only add a Suspended condition with status false if it already exists", func() {
+		conditions, changed := lsutil.UpdateSuspendedCondition(nil, false)
+		Expect(changed).To(BeFalse())
+		Expect(conditions).To(BeEmpty())
+
+		conditions, changed = lsutil.UpdateSuspendedCondition(conditions, true)
+		Expect(changed).To(BeTrue())
+		Expect(lsutil.IsSuspendedConditionTrue(conditions)).To(BeTrue())
+
+		_, changed = lsutil.UpdateSuspendedCondition(conditions, true)
+		Expect(changed).To(BeFalse())
+
+		conditions, changed = lsutil.UpdateSuspendedCondition(conditions, false)
+		Expect(changed).To(BeTrue())
+		Expect(lsutil.IsSuspendedConditionTrue(conditions)).To(BeFalse())
+		cond := lsv1alpha1helper.GetCondition(conditions, lsv1alpha1.SuspendedCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(lsutil.ResumedReason))
+	})
+})